@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// manifest is the declarative YAML schema for apply: a set of named jobs
+// the server should be running. Apply diffs it against live state — jobs
+// are matched by name — and starts what is missing, replaces what has
+// drifted, and with -prune stops named jobs the manifest no longer lists.
+type manifest struct {
+	Jobs []manifestJob `yaml:"jobs"`
+}
+
+// manifestJob mirrors the start options that the server echoes back on a
+// job, so a manifest entry can be compared against a running job.
+type manifestJob struct {
+	Name             string            `yaml:"name"`
+	Command          string            `yaml:"command"`
+	Args             []string          `yaml:"args"`
+	NodeSelector     map[string]string `yaml:"node_selector"`
+	RestartPolicy    string            `yaml:"restart_policy"`
+	MaxRestarts      int               `yaml:"max_restarts"`
+	Nice             int               `yaml:"nice"`
+	Priority         int               `yaml:"priority"`
+	Gpus             int               `yaml:"gpus"`
+	Ports            int               `yaml:"ports"`
+	CPUBudgetSeconds int64             `yaml:"cpu_budget_seconds"`
+	MaxOutputBytes   int64             `yaml:"max_output_bytes"`
+}
+
+// loadManifest reads and validates a manifest file. Unknown keys are
+// rejected so typos fail loudly instead of silently applying defaults.
+func loadManifest(path string) (*manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	var m manifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	seen := map[string]bool{}
+	for i, j := range m.Jobs {
+		if j.Name == "" {
+			return nil, fmt.Errorf("%s: job %d has no name; apply manages jobs by name", path, i)
+		}
+		if seen[j.Name] {
+			return nil, fmt.Errorf("%s: job %q listed twice", path, j.Name)
+		}
+		seen[j.Name] = true
+		if j.Command == "" {
+			return nil, fmt.Errorf("%s: job %q has no command", path, j.Name)
+		}
+	}
+	return &m, nil
+}
+
+// applyAction is one step of an apply plan.
+type applyAction struct {
+	verb    string // "create", "replace", "remove", or "unchanged"
+	job     manifestJob
+	current *jobworkerv1.Job // nil for "create"
+}
+
+func runApply(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	file := fs.String("f", "", "manifest file to apply (required)")
+	prune := fs.Bool("prune", false, "also stop named running jobs the manifest does not list")
+	dryRun := fs.Bool("dry-run", false, "validate and print the plan without changing anything")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("usage: apply -f manifest.yaml [-prune] [-dry-run]")
+	}
+	m, err := loadManifest(*file)
+	if err != nil {
+		return err
+	}
+	live, err := liveNamedJobs(ctx, c)
+	if err != nil {
+		return err
+	}
+	plan := planApply(m, live, *prune)
+	if *dryRun {
+		printPlan(plan)
+		return nil
+	}
+
+	for _, a := range plan {
+		switch a.verb {
+		case "create":
+			id, err := c.Start(ctx, a.job.startSpec())
+			if err != nil {
+				return fmt.Errorf("creating job %q: %w", a.job.Name, err)
+			}
+			fmt.Printf("created   %-20s %s\n", a.job.Name, id)
+		case "replace":
+			if err := c.StopJobReason(ctx, a.current.Id, "replaced by apply"); err != nil {
+				return fmt.Errorf("stopping job %q for replacement: %w", a.job.Name, err)
+			}
+			id, err := c.Start(ctx, a.job.startSpec())
+			if err != nil {
+				return fmt.Errorf("recreating job %q: %w", a.job.Name, err)
+			}
+			fmt.Printf("replaced  %-20s %s (was %s)\n", a.job.Name, id, a.current.Id)
+		case "remove":
+			if err := c.StopJobReason(ctx, a.current.Id, "pruned by apply"); err != nil {
+				return fmt.Errorf("pruning job %q: %w", a.current.Name, err)
+			}
+			fmt.Printf("removed   %-20s %s\n", a.current.Name, a.current.Id)
+		case "unchanged":
+			fmt.Printf("unchanged %-20s %s\n", a.job.Name, a.current.Id)
+		}
+	}
+	return nil
+}
+
+// planApply diffs the manifest against live state into an ordered list of
+// actions; manifest order first, then prunes sorted by name for stable
+// output.
+func planApply(m *manifest, live map[string]*jobworkerv1.Job, prune bool) []applyAction {
+	var plan []applyAction
+	for _, mj := range m.Jobs {
+		j, running := live[mj.Name]
+		switch {
+		case !running:
+			plan = append(plan, applyAction{verb: "create", job: mj})
+		case mj.differs(j):
+			plan = append(plan, applyAction{verb: "replace", job: mj, current: j})
+		default:
+			plan = append(plan, applyAction{verb: "unchanged", job: mj, current: j})
+		}
+	}
+	if prune {
+		inManifest := map[string]bool{}
+		for _, mj := range m.Jobs {
+			inManifest[mj.Name] = true
+		}
+		var names []string
+		for name := range live {
+			if !inManifest[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			plan = append(plan, applyAction{verb: "remove", current: live[name]})
+		}
+	}
+	return plan
+}
+
+// printPlan renders a dry run: every action with "would", and a summary
+// count, without touching the server.
+func printPlan(plan []applyAction) {
+	counts := map[string]int{}
+	for _, a := range plan {
+		counts[a.verb]++
+		switch a.verb {
+		case "create":
+			fmt.Printf("would create  %-20s %s %v\n", a.job.Name, a.job.Command, a.job.Args)
+		case "replace":
+			fmt.Printf("would replace %-20s %s\n", a.job.Name, a.current.Id)
+		case "remove":
+			fmt.Printf("would remove  %-20s %s\n", a.current.Name, a.current.Id)
+		case "unchanged":
+			fmt.Printf("unchanged     %-20s %s\n", a.job.Name, a.current.Id)
+		}
+	}
+	fmt.Printf("plan: %d to create, %d to replace, %d to remove, %d unchanged\n",
+		counts["create"], counts["replace"], counts["remove"], counts["unchanged"])
+}
+
+// liveNamedJobs returns the caller's running or paused named jobs, keyed
+// by name; the most recently started wins if a name is reused.
+func liveNamedJobs(ctx context.Context, c *client.Client) (map[string]*jobworkerv1.Job, error) {
+	jobs, err := c.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]*jobworkerv1.Job)
+	for _, j := range jobs {
+		if j.Name == "" {
+			continue
+		}
+		switch j.Status {
+		case jobworkerv1.JobStatus_JOB_STATUS_RUNNING, jobworkerv1.JobStatus_JOB_STATUS_PAUSED:
+		default:
+			continue
+		}
+		if prev, ok := live[j.Name]; !ok || j.StartedAtUnix > prev.StartedAtUnix {
+			live[j.Name] = j
+		}
+	}
+	return live, nil
+}
+
+// startSpec converts a manifest entry into a start request.
+func (mj manifestJob) startSpec() client.StartSpec {
+	return client.StartSpec{
+		Command:        mj.Command,
+		Args:           mj.Args,
+		Name:           mj.Name,
+		NodeSelector:   mj.NodeSelector,
+		RestartPolicy:  mj.RestartPolicy,
+		MaxRestarts:    mj.MaxRestarts,
+		Nice:           mj.Nice,
+		Priority:       mj.Priority,
+		GPUs:           mj.Gpus,
+		Ports:          mj.Ports,
+		CPUBudget:      time.Duration(mj.CPUBudgetSeconds) * time.Second,
+		MaxOutputBytes: mj.MaxOutputBytes,
+	}
+}
+
+// differs reports whether the running job has drifted from the manifest
+// entry, over the fields the server echoes back.
+func (mj manifestJob) differs(j *jobworkerv1.Job) bool {
+	if mj.Command != j.Command || !equalStrings(mj.Args, j.Args) {
+		return true
+	}
+	policy := mj.RestartPolicy
+	if policy == "" {
+		policy = "never"
+	}
+	// MaxRestarts is not echoed on the job, so drift in it alone is not
+	// detected; everything else the server reports back is compared.
+	if policy != j.RestartPolicy {
+		return true
+	}
+	return int32(mj.Nice) != j.Nice ||
+		int32(mj.Priority) != j.Priority ||
+		mj.Gpus != len(j.Gpus) ||
+		mj.Ports != len(j.Ports) ||
+		mj.CPUBudgetSeconds != j.CpuBudgetSeconds ||
+		mj.MaxOutputBytes != j.MaxOutputBytes
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// commandsTakingJobID lists the subcommands whose first positional
+// argument is a job ID; the completion scripts complete it with live job
+// IDs via the hidden __complete-jobs helper.
+var commandsTakingJobID = []string{
+	"annotate", "artifacts", "attach", "describe", "export", "grep",
+	"logs", "manifest", "pause", "replay", "rerun", "resume", "status",
+	"stdin", "stop", "wait",
+}
+
+// commandList is every completable subcommand: the dispatch table in
+// main.go plus the commands handled before dialing.
+func commandList() []string {
+	names := make([]string, 0, len(commands)+2)
+	for name := range commands {
+		names = append(names, name)
+	}
+	names = append(names, "completion", "certgen")
+	sort.Strings(names)
+	return names
+}
+
+// runCompletion prints a completion script for the requested shell. The
+// scripts call back into the CLI (`client __complete-jobs`) to complete job
+// IDs from ListJobs, so connection flags must work with their defaults or be
+// baked into an alias.
+func runCompletion(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: completion <bash|zsh|fish>")
+	}
+	all := strings.Join(commandList(), " ")
+	switch args[0] {
+	case "bash":
+		fmt.Printf(bashCompletion, all, strings.Join(commandsTakingJobID, "|"))
+	case "zsh":
+		fmt.Printf(zshCompletion, all, strings.Join(commandsTakingJobID, "|"))
+	case "fish":
+		fmt.Printf(fishCompletion, all, strings.Join(commandsTakingJobID, " "))
+	default:
+		return fmt.Errorf("unsupported shell %q, want bash, zsh, or fish", args[0])
+	}
+	return nil
+}
+
+// runCompleteJobs is the hidden helper behind dynamic completion: it prints
+// the caller's job IDs, one per line, and stays quiet on any error so a
+// broken connection never garbles the shell prompt.
+func runCompleteJobs(cfg client.Config) error {
+	c, err := client.New(cfg)
+	if err != nil {
+		return nil
+	}
+	defer c.Close()
+	jobs, err := c.ListJobs(context.Background())
+	if err != nil {
+		return nil
+	}
+	for _, j := range jobs {
+		fmt.Fprintln(os.Stdout, j.Id)
+	}
+	return nil
+}
+
+const bashCompletion = `# bash completion for client
+# Install: source <(client completion bash)
+_client_complete() {
+    local cur prev commands
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    commands="%s"
+    case "$prev" in
+        %s)
+            COMPREPLY=( $(compgen -W "$(${COMP_WORDS[0]} __complete-jobs 2>/dev/null)" -- "$cur") )
+            return
+            ;;
+        completion)
+            COMPREPLY=( $(compgen -W "bash zsh fish" -- "$cur") )
+            return
+            ;;
+    esac
+    if [ "$COMP_CWORD" -eq 1 ]; then
+        COMPREPLY=( $(compgen -W "$commands" -- "$cur") )
+    fi
+}
+complete -F _client_complete client
+`
+
+const zshCompletion = `# zsh completion for client
+# Install: source <(client completion zsh)
+autoload -U +X bashcompinit && bashcompinit
+_client_complete() {
+    local cur prev commands
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[COMP_CWORD-1]}"
+    commands="%s"
+    case "$prev" in
+        %s)
+            COMPREPLY=( $(compgen -W "$(${COMP_WORDS[0]} __complete-jobs 2>/dev/null)" -- "$cur") )
+            return
+            ;;
+        completion)
+            COMPREPLY=( $(compgen -W "bash zsh fish" -- "$cur") )
+            return
+            ;;
+    esac
+    if [ "$COMP_CWORD" -eq 1 ]; then
+        COMPREPLY=( $(compgen -W "$commands" -- "$cur") )
+    fi
+}
+complete -F _client_complete client
+`
+
+const fishCompletion = `# fish completion for client
+# Install: client completion fish | source
+set -l commands %s
+complete -c client -f
+complete -c client -n "not __fish_seen_subcommand_from $commands" -a "$commands"
+complete -c client -n "__fish_seen_subcommand_from %s" -a "(client __complete-jobs 2>/dev/null)"
+complete -c client -n "__fish_seen_subcommand_from completion" -a "bash zsh fish"
+`
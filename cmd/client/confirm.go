@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// confirm asks the user to approve a destructive action before it runs.
+// In a non-interactive session there is nobody to ask, so the command
+// must be re-run with -force instead; anything but an explicit yes
+// aborts.
+func confirm(prompt string) error {
+	if !stdinIsTerminal() {
+		return errors.New("standard input is not a terminal; pass -force to proceed without confirmation")
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", prompt)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return nil
+	}
+	return errors.New("aborted")
+}
+
+// stdinIsTerminal reports whether standard input is attached to a
+// terminal, as opposed to a pipe or file.
+func stdinIsTerminal() bool {
+	fi, err := os.Stdin.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// stdoutIsTerminal is stdinIsTerminal for standard output, gating
+// decoration like colored log levels.
+func stdoutIsTerminal() bool {
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
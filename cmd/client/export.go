@@ -0,0 +1,180 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// Archive layout of an export: job.json is the job's metadata, logs.jsonl
+// holds one output line per row, and artifacts/ mirrors the collected
+// result files. Everything a bug report or another server needs.
+const (
+	exportJobFile  = "job.json"
+	exportLogsFile = "logs.jsonl"
+	exportArtDir   = "artifacts"
+)
+
+func runExport(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	out := fs.String("o", "", "archive path (default <job-id>.tar.gz)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: export [-o file] <job-id>")
+	}
+	j, err := c.GetJobStatus(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	entries, err := c.GetOutputEntries(ctx, j.Id, client.OutputWindow{})
+	if err != nil {
+		return err
+	}
+
+	dest := *out
+	if dest == "" {
+		dest = j.Id + ".tar.gz"
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	jobJSON, err := protojson.MarshalOptions{Multiline: true}.Marshal(j)
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, exportJobFile, jobJSON); err != nil {
+		return err
+	}
+	var logs bytes.Buffer
+	for _, e := range entries {
+		line, err := protojson.Marshal(e)
+		if err != nil {
+			return err
+		}
+		logs.Write(line)
+		logs.WriteByte('\n')
+	}
+	if err := writeTarFile(tw, exportLogsFile, logs.Bytes()); err != nil {
+		return err
+	}
+
+	// Artifacts are included when the job has any; a worker without an
+	// artifact store reports none.
+	artifacts, err := c.ListArtifacts(ctx, j.Id)
+	if err == nil {
+		for _, a := range artifacts {
+			var buf bytes.Buffer
+			if _, err := c.DownloadArtifact(ctx, j.Id, a.Path, &buf); err != nil {
+				return err
+			}
+			if err := writeTarFile(tw, path.Join(exportArtDir, a.Path), buf.Bytes()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Println(dest)
+	return nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: time.Now()}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func runImport(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: import <archive.tar.gz>")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+
+	var job jobworkerv1.Job
+	var output []*jobworkerv1.OutputLine
+	sawJob := false
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch hdr.Name {
+		case exportJobFile:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if err := protojson.Unmarshal(data, &job); err != nil {
+				return fmt.Errorf("parsing %s: %w", exportJobFile, err)
+			}
+			sawJob = true
+		case exportLogsFile:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			for _, line := range strings.Split(string(data), "\n") {
+				if line == "" {
+					continue
+				}
+				e := &jobworkerv1.OutputLine{}
+				if err := protojson.Unmarshal([]byte(line), e); err != nil {
+					return fmt.Errorf("parsing %s: %w", exportLogsFile, err)
+				}
+				output = append(output, e)
+			}
+		}
+	}
+	if !sawJob {
+		return fmt.Errorf("%s has no %s", args[0], exportJobFile)
+	}
+	jobID, err := c.ImportJob(ctx, &job, output)
+	if err != nil {
+		return err
+	}
+	fmt.Println(jobID)
+	return nil
+}
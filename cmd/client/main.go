@@ -0,0 +1,1784 @@
+// Command client is the CLI for the job worker service.
+//
+// Usage:
+//
+//	client [connection flags] <command> [args]
+//
+// Commands:
+//
+//	start <command> [args...]   start a job
+//	run <command> [args...]     start a job, stream output, pass exit code through
+//	stop <job-id>               stop a running job
+//	status <job-id>             show a job's status
+//	list                        list your jobs
+//	logs [-follow] <job-id>     print a job's output
+//	grep <job-id> <pattern>     search a job's output server-side
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+func main() {
+	var cfg client.Config
+	flag.StringVar(&cfg.Addr, "addr", "localhost:8443", "server address")
+	flag.StringVar(&cfg.CACertFile, "ca-cert", "", "CA certificate used to verify the server")
+	flag.StringVar(&cfg.CertFile, "cert", "", "client TLS certificate")
+	flag.StringVar(&cfg.KeyFile, "key", "", "client TLS private key")
+	flag.DurationVar(&cfg.KeepaliveTime, "keepalive-time", 30*time.Second, "interval between client keepalive pings (0 = disabled)")
+	flag.DurationVar(&cfg.DialTimeout, "dial-timeout", 10*time.Second, "give up connecting after this long (0 = dial lazily)")
+	flag.IntVar(&cfg.MaxRecvMsgSize, "max-recv-msg-size", 0, "max gRPC receive message size in bytes (0 = gRPC default)")
+	flag.IntVar(&cfg.MaxSendMsgSize, "max-send-msg-size", 0, "max gRPC send message size in bytes (0 = gRPC default)")
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := run(cfg, args[0], args[1:]); err != nil {
+		var exitErr exitCodeError
+		if errors.As(err, &exitErr) {
+			// The remote job's exit code passes through unchanged so the
+			// CLI can stand in for the command in scripts.
+			os.Exit(exitErr.code)
+		}
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// exitCodeError carries a remote job's non-zero exit code to main.
+type exitCodeError struct {
+	code int
+}
+
+func (e exitCodeError) Error() string {
+	return fmt.Sprintf("job exited with code %d", e.code)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: client [flags] <command> [args]
+
+Commands:
+  start [-node-label k=v] [-upload local:dest] <command> [args...]
+                              start a job
+  start -file <manifest.json> [-atomic]
+                              start a batch of jobs from a manifest
+  run [-node-label k=v] <command> [args...]
+                              start a job, stream its output, and exit
+                              with the job's exit code
+  run-template [-param k=v] <name>
+                              start a job from a server-side template
+  rerun [-set flag=value] [-append-arg a] <job-id>
+                              resubmit a job's recorded start request as
+                              a fresh job and print the new ID; -set
+                              overrides one start flag, -append-arg adds
+                              trailing arguments
+  pipeline start -f pipeline.json [-name n] [-failure-policy p]
+                              submit a DAG of dependent stages under one
+                              pipeline ID; each stage starts once the
+                              stages it needs have completed
+  pipeline status <id>        aggregate pipeline status plus the state
+                              and job ID of each stage
+  pipeline logs <id>          the stages' combined output, each line
+                              prefixed with its stage name
+  pipeline stop <id>          stop the running stages and skip the
+                              pending ones
+  sweep start -matrix k=v1,v2 [-param k=v] <template>
+                              fan a template out over a parameter matrix,
+                              one child job per combination; matrix
+                              values are comma lists or ranges like 0..9
+  sweep status <id>           aggregate sweep progress and each child's
+                              parameters, job ID, and state
+  sweep stop <id>             stop every still-running child
+  templates                   list registered templates
+  nodes [name]                list worker nodes (capacity, headroom,
+                              heartbeat), or one node by name
+  stop [-reason text] [-force] <job-id>
+                              stop a running job; prompts for
+                              confirmation unless -force is given, which
+                              also overrides the protected=true label
+  stop -l k=v [-status s] [-all] [-force] [-reason text]
+                              stop every job matching the label selector
+                              and status; -all alone stops all your jobs
+  pause <job-id>              suspend a running job (SIGSTOP)
+  resume <job-id>             continue a paused job (SIGCONT)
+  status <job-id>             show a job's status
+  describe <job-id>           show a job's status plus its state timeline
+  manifest <job-id>           show a job's reproducibility record: the
+                              resolved environment, host, runner version,
+                              and resource settings at launch
+  wait [-timeout d] <job-id>  block until a job finishes
+  list [-group]               list your jobs, optionally one row per
+                              name/command with run counts
+  history [-l k=v] [-status s] [-since d] [-limit n]
+                              list your jobs from the server's job
+                              store, which outlives worker restarts
+  apply -f manifest.yaml [-prune] [-dry-run]
+                              reconcile named jobs against a declarative
+                              manifest: start missing, replace drifted,
+                              and with -prune stop unlisted ones;
+                              -dry-run prints the plan only
+  top [-interval d]           live dashboard of your jobs: CPU, memory,
+                              and durations; j/k select, s stop, l logs,
+                              q quit
+  stats [-group-by name|command] [-last n]
+                              success rates and duration percentiles per
+                              job group
+  quota                       resource consumption against your quota
+                              (admins see every owner)
+  maintenance                 whether a maintenance window is holding
+                              new job starts
+  logs [-follow] [-tail n] [-since d] [-timestamps] [-output-file f]
+       [-prefix-job-id] [-grep p] <job-id>...
+                              print output from one or more jobs
+  annotate <job-id> <text>    append a marker line to a running job's
+                              output timeline
+  stdin <job-id>              pipe this process's stdin to a running job
+  attach <job-id>             replay and follow output while forwarding
+                              stdin; a line of "~." detaches
+  replay [-speed n] [-cast file] <job-id>
+                              play back a job's output with its original
+                              timing, or export an asciicast v2 file
+  artifacts <job-id>          list a job's collected artifacts
+  artifacts get <job-id> <path> [-o file]
+                              download one artifact (default: stdout)
+  export [-o file] <job-id>   write a self-contained archive of the job:
+                              metadata, logs, and artifacts
+  import <archive.tar.gz>     load an exported job onto this server
+  admin pause [-reason text]  stop admitting new jobs (admin role)
+  admin resume                resume job admission
+  admin drain                 stop admission and report how many jobs are
+                              still running
+  admin reload                reload the server certificate and policy
+                              files from disk
+  admin verify-audit          check the audit trail's hash chain for
+                              tampering or truncation
+  admin diag [-o file]        dump goroutines and internal counters
+  admin debug [-tool strace|perf] [-duration 10s] <job>
+                              attach a diagnostic tool to a running job and
+                              store the capture as an artifact
+  version                     show client and server versions
+  certgen [-dir d]            generate a CA and client/server certificates
+  completion <bash|zsh|fish>  print a shell completion script
+  grep <job-id> <pattern>     search a job's output server-side
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+func run(cfg client.Config, command string, args []string) error {
+	// Deprecation warnings from the server go to stderr, once each, so
+	// they never pollute parseable stdout.
+	seenWarnings := map[string]bool{}
+	cfg.WarningHandler = func(w string) {
+		if !seenWarnings[w] {
+			seenWarnings[w] = true
+			fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+		}
+	}
+
+	// Completion and certificate bootstrap run before dialing: neither
+	// needs a connection (the job-ID helper manages its own).
+	switch command {
+	case "completion":
+		return runCompletion(args)
+	case "__complete-jobs":
+		return runCompleteJobs(cfg)
+	case "certgen":
+		return runCertgen(args)
+	}
+
+	c, err := client.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	ctx := context.Background()
+
+	handler, ok := commands[command]
+	if !ok {
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return handler(ctx, c, args)
+}
+
+// commands is the dispatch table for every subcommand that talks to the
+// server. The completion scripts derive their command list from it, so a
+// command added here completes without touching the shell scripts.
+var commands = map[string]func(context.Context, *client.Client, []string) error{
+	"start":        runStart,
+	"run":          runRun,
+	"run-template": runRunTemplate,
+	"rerun":        runRerun,
+	"pipeline":     runPipeline,
+	"sweep":        runSweep,
+	"templates":    func(ctx context.Context, c *client.Client, _ []string) error { return runTemplates(ctx, c) },
+	"stop":         runStop,
+	"pause":        runPause,
+	"resume":       runResume,
+	"status":       runStatus,
+	"describe":     runDescribe,
+	"manifest":     runManifest,
+	"wait":         runWait,
+	"list":         runList,
+	"history":      runHistory,
+	"top":          runTop,
+	"apply":        runApply,
+	"stats":        runStats,
+	"quota":        func(ctx context.Context, c *client.Client, _ []string) error { return runQuota(ctx, c) },
+	"maintenance":  func(ctx context.Context, c *client.Client, _ []string) error { return runMaintenance(ctx, c) },
+	"admin":        runAdmin,
+	"nodes":        runNodes,
+	"version":      func(ctx context.Context, c *client.Client, _ []string) error { return runVersion(ctx, c) },
+	"logs":         runLogs,
+	"grep":         runGrep,
+	"annotate":     runAnnotate,
+	"artifacts":    runArtifacts,
+	"stdin":        runStdin,
+	"attach":       runAttach,
+	"replay":       runReplay,
+	"export":       runExport,
+	"import":       runImport,
+}
+
+// labelFlags collects repeated -node-label key=value flags.
+type labelFlags map[string]string
+
+func (l labelFlags) String() string { return "" }
+
+func (l labelFlags) Set(kv string) error {
+	k, v, ok := strings.Cut(kv, "=")
+	if !ok {
+		return fmt.Errorf("invalid label %q, want key=value", kv)
+	}
+	l[k] = v
+	return nil
+}
+
+// exitStatusFlags collects repeated -exit-status code=label flags.
+type exitStatusFlags map[int]string
+
+func (e exitStatusFlags) String() string { return "" }
+
+func (e exitStatusFlags) Set(v string) error {
+	codeStr, label, ok := strings.Cut(v, "=")
+	if !ok || label == "" {
+		return fmt.Errorf("invalid exit status %q, want code=label", v)
+	}
+	code, err := strconv.Atoi(codeStr)
+	if err != nil || code < 0 || code > 255 {
+		return fmt.Errorf("invalid exit code %q, want 0-255", codeStr)
+	}
+	e[code] = label
+	return nil
+}
+
+// listFlags collects a repeated string flag, like -artifact.
+type listFlags []string
+
+func (l *listFlags) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlags) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+func runStart(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("start", flag.ContinueOnError)
+	selector := labelFlags{}
+	fs.Var(selector, "node-label", "schedule only on nodes with this key=value label (repeatable)")
+	labels := labelFlags{}
+	fs.Var(labels, "label", "tag the job with this key=value label, for selecting it in bulk operations (repeatable)")
+	name := fs.String("name", "", "human-friendly job name, usable wherever a job ID is accepted")
+	idemKey := fs.String("idempotency-key", "", "make the start safe to retry: replaying the same key returns the existing job")
+	var artifacts listFlags
+	fs.Var(&artifacts, "artifact", "glob pattern of result files to collect when the job finishes (repeatable)")
+	var uploads listFlags
+	fs.Var(&uploads, "upload", "ship a local file or directory into the job workspace, as local:dest (repeatable)")
+	cpuBudget := fs.Duration("cpu-budget", 0, "cap the job's CPU time, e.g. 10m (0 = no budget)")
+	cpuBudgetPolicy := fs.String("cpu-budget-policy", "", "what to do when -cpu-budget is reached: pause (default) or kill")
+	capture := fs.String("capture", "", "output retention: full, tail:N, sample:N, or discard (default full)")
+	stripANSI := fs.Bool("strip-ansi", false, "strip ANSI escape sequences from captured output")
+	maxOutput := fs.Int64("max-output-bytes", 0, "cap total captured output (0 = unlimited)")
+	maxCore := fs.Int64("max-core-bytes", 0, "let a crash dump a core file up to this size, collected as an artifact (0 = no cores)")
+	outputLimit := fs.String("output-limit-policy", "", "when the output cap is hit: truncate (default) or kill")
+	nice := fs.Int("nice", 0, "scheduling priority, -20 (highest) to 19 (lowest)")
+	priority := fs.Int("priority", 0, "preemption priority; higher may preempt lower on workers that allow it")
+	ioClass := fs.String("io-class", "", "IO scheduling class: realtime, best-effort, or idle")
+	ioPriority := fs.Int("io-priority", 0, "IO priority within the class, 0 (highest) to 7")
+	cpuAffinity := fs.String("cpu-affinity", "", "comma-separated CPU indices to pin the job to, e.g. 0,1")
+	gpus := fs.Int("gpus", 0, "number of GPU devices the job needs (queues until free)")
+	ports := fs.Int("ports", 0, "number of TCP ports to allocate, injected as PORT0..PORTn-1")
+	restart := fs.String("restart", "", "relaunch the process when it exits: never (default), on-failure, or always")
+	maxRestarts := fs.Int("max-restarts", 0, "cap on relaunches under -restart (0 = unlimited)")
+	probe := fs.String("probe", "", "liveness check: tcp:host:port, http(s)://url, or exec:command")
+	probeInterval := fs.Duration("probe-interval", 0, "time between probe checks (0 = server default)")
+	maxSilence := fs.Duration("max-silence", 0, "flag the job stalled after this long without output (0 = never)")
+	stallPolicy := fs.String("stall-policy", "", "what to do with a stalled job: flag (default) or kill")
+	cache := fs.Bool("cache", false, "deterministic job: reuse a prior successful identical run instead of re-executing")
+	cacheKey := fs.String("cache-key", "", "with -cache, override the content key derived from command, args, and env")
+	cacheTTL := fs.Duration("cache-ttl", 0, "with -cache, how long after completion the result may be reused (0 = server default)")
+	noCache := fs.Bool("no-cache", false, "with -cache, force a fresh run; it replaces the cached result")
+	var secretEnv listFlags
+	fs.Var(&secretEnv, "secret-env", "inject a secret as NAME=provider:ref, e.g. DB_PASS=vault:kv/db#password (repeatable)")
+	lockKey := fs.String("lock", "", "mutual-exclusion key: at most one of your running jobs holds it at a time")
+	lockWait := fs.Bool("lock-wait", false, "with -lock, queue until the key frees instead of failing the start")
+	group := fs.String("group", "", "concurrency group: queue while the group is at its max parallelism")
+	exitStatuses := exitStatusFlags{}
+	fs.Var(exitStatuses, "exit-status", `map an exit code to a custom terminal status, as code=label, e.g. 3=retryable (repeatable)`)
+	onSuccess := fs.String("on-success", "", "shell command the server runs as a child job if this one completes")
+	onFailure := fs.String("on-failure", "", "shell command the server runs as a child job if this one fails")
+	var notifyTargets listFlags
+	fs.Var(&notifyTargets, "notify", "notify this target when the job finishes, as smtp://relay:port/rcpt or slack://host/webhook-path (repeatable)")
+	jsonLogs := fs.Bool("json-logs", false, "treat output as JSON lines, parsed server-side for level filtering and pretty rendering")
+	file := fs.String("file", "", "start a batch of jobs from a JSON manifest instead of the command line")
+	atomic := fs.Bool("atomic", false, "with -file, fail the whole batch if any job cannot start")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file != "" {
+		if fs.NArg() != 0 {
+			return errors.New("usage: start -file <manifest.json> [-atomic]")
+		}
+		return runStartBatch(ctx, c, *file, selector, *atomic)
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: start [-name n] [-node-label k=v] <command> [args...]")
+	}
+	spec := client.StartSpec{Command: fs.Arg(0), Args: fs.Args()[1:], Name: *name, IdempotencyKey: *idemKey, Artifacts: artifacts, CPUBudget: *cpuBudget, CPUBudgetPolicy: *cpuBudgetPolicy, CaptureMode: *capture, StripANSI: *stripANSI, MaxOutputBytes: *maxOutput, OutputLimitPolicy: *outputLimit, MaxCoreBytes: *maxCore, Nice: *nice, Priority: *priority, IOClass: *ioClass, IOPriority: *ioPriority, GPUs: *gpus, Ports: *ports, RestartPolicy: *restart, MaxRestarts: *maxRestarts, Probe: *probe, ProbeInterval: *probeInterval, MaxSilence: *maxSilence, StallPolicy: *stallPolicy, Cacheable: *cache, CacheKey: *cacheKey, CacheTTL: *cacheTTL, NoCache: *noCache, SecretEnv: secretEnv, LockKey: *lockKey, LockWait: *lockWait, ConcurrencyGroup: *group, OnSuccess: *onSuccess, OnFailure: *onFailure, Notify: notifyTargets, JSONLogs: *jsonLogs}
+	if *cpuAffinity != "" {
+		cpus, err := parseCPUList(*cpuAffinity)
+		if err != nil {
+			return err
+		}
+		spec.CPUAffinity = cpus
+	}
+	if len(selector) > 0 {
+		spec.NodeSelector = selector
+	}
+	if len(labels) > 0 {
+		spec.Labels = labels
+	}
+	if len(exitStatuses) > 0 {
+		spec.ExitStatuses = exitStatuses
+	}
+	if len(uploads) > 0 {
+		files, err := uploadFiles(uploads)
+		if err != nil {
+			return err
+		}
+		spec.UploadID, err = c.UploadInput(ctx, files)
+		if err != nil {
+			return err
+		}
+	}
+	jobID, err := c.Start(ctx, spec)
+	if err != nil {
+		return err
+	}
+	fmt.Println(jobID)
+	return nil
+}
+
+// uploadFiles expands repeated -upload local:dest flags into the files to
+// stage, walking directories recursively.
+func uploadFiles(specs []string) ([]client.UploadFile, error) {
+	var files []client.UploadFile
+	for _, s := range specs {
+		local, dest, ok := strings.Cut(s, ":")
+		if !ok || local == "" || dest == "" {
+			return nil, fmt.Errorf("invalid -upload %q, want local:dest", s)
+		}
+		info, err := os.Stat(local)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, client.UploadFile{LocalPath: local, Path: dest})
+			continue
+		}
+		err = filepath.WalkDir(local, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(local, p)
+			if err != nil {
+				return err
+			}
+			files = append(files, client.UploadFile{LocalPath: p, Path: path.Join(dest, filepath.ToSlash(rel))})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// batchManifest is the on-disk layout of a -file batch manifest.
+type batchManifest struct {
+	Jobs []struct {
+		Command        string            `json:"command"`
+		Args           []string          `json:"args"`
+		NodeSelector   map[string]string `json:"node_selector"`
+		Name           string            `json:"name"`
+		IdempotencyKey string            `json:"idempotency_key"`
+		Artifacts      []string          `json:"artifacts"`
+		CaptureMode    string            `json:"capture_mode"`
+		StripANSI      bool              `json:"strip_ansi"`
+		MaxOutputBytes int64             `json:"max_output_bytes"`
+		OutputLimit    string            `json:"output_limit_policy"`
+		Nice           int               `json:"nice"`
+		IOClass        string            `json:"io_class"`
+		IOPriority     int               `json:"io_priority"`
+		CPUAffinity    []int             `json:"cpu_affinity"`
+		GPUs           int               `json:"gpus"`
+		Ports          int               `json:"ports"`
+		RestartPolicy  string            `json:"restart_policy"`
+		MaxRestarts    int               `json:"max_restarts"`
+		Probe          string            `json:"probe"`
+		ProbeInterval  int               `json:"probe_interval_seconds"`
+	} `json:"jobs"`
+	NodeSelector map[string]string `json:"node_selector"`
+}
+
+// parseCPUList parses a comma-separated list of CPU indices.
+func parseCPUList(s string) ([]int, error) {
+	var cpus []int
+	for _, part := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid cpu index %q", part)
+		}
+		cpus = append(cpus, n)
+	}
+	return cpus, nil
+}
+
+func runStartBatch(ctx context.Context, c *client.Client, path string, selector map[string]string, atomic bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var manifest batchManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	specs := make([]client.StartSpec, len(manifest.Jobs))
+	for i, j := range manifest.Jobs {
+		specs[i] = client.StartSpec{Command: j.Command, Args: j.Args, NodeSelector: j.NodeSelector, Name: j.Name, IdempotencyKey: j.IdempotencyKey, Artifacts: j.Artifacts, CaptureMode: j.CaptureMode, StripANSI: j.StripANSI, MaxOutputBytes: j.MaxOutputBytes, OutputLimitPolicy: j.OutputLimit, Nice: j.Nice, IOClass: j.IOClass, IOPriority: j.IOPriority, CPUAffinity: j.CPUAffinity, GPUs: j.GPUs, Ports: j.Ports, RestartPolicy: j.RestartPolicy, MaxRestarts: j.MaxRestarts, Probe: j.Probe, ProbeInterval: time.Duration(j.ProbeInterval) * time.Second}
+	}
+	batchSelector := manifest.NodeSelector
+	if len(selector) > 0 {
+		batchSelector = selector
+	}
+	results, err := c.StartBatch(ctx, specs, batchSelector, atomic)
+	if err != nil {
+		return err
+	}
+	failed := false
+	for i, r := range results {
+		if r.Err != "" {
+			failed = true
+			fmt.Printf("job %d: error: %s\n", i, r.Err)
+			continue
+		}
+		fmt.Println(r.JobID)
+	}
+	if failed {
+		return errors.New("some jobs failed to start")
+	}
+	return nil
+}
+
+// runRun starts a job, follows its output to completion, and propagates the
+// job's exit code, so the CLI can replace the command itself in CI scripts.
+func runRun(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	selector := labelFlags{}
+	fs.Var(selector, "node-label", "schedule only on nodes with this key=value label (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: run [-node-label k=v] <command> [args...]")
+	}
+	spec := client.StartSpec{Command: fs.Arg(0), Args: fs.Args()[1:]}
+	if len(selector) > 0 {
+		spec.NodeSelector = selector
+	}
+	jobID, err := c.Start(ctx, spec)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "job %s\n", jobID)
+
+	stream, err := c.StreamOutput(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	for stream.Next() {
+		fmt.Println(stream.Line())
+	}
+	if err := stream.Err(); err != nil {
+		return err
+	}
+	j, err := c.WaitJob(ctx, jobID, 0)
+	if err != nil {
+		return err
+	}
+	if j.ExitCode != 0 {
+		return exitCodeError{code: int(j.ExitCode)}
+	}
+	return nil
+}
+
+func runRunTemplate(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("run-template", flag.ContinueOnError)
+	params := labelFlags{}
+	selector := labelFlags{}
+	fs.Var(params, "param", "template parameter as key=value (repeatable)")
+	fs.Var(selector, "node-label", "schedule only on nodes with this key=value label (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: run-template [-param k=v] [-node-label k=v] <name>")
+	}
+	var sel map[string]string
+	if len(selector) > 0 {
+		sel = selector
+	}
+	jobID, err := c.RunTemplate(ctx, fs.Arg(0), params, sel)
+	if err != nil {
+		return err
+	}
+	fmt.Println(jobID)
+	return nil
+}
+
+func runTemplates(ctx context.Context, c *client.Client) error {
+	templates, err := c.ListTemplates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range templates {
+		var params []string
+		for _, p := range t.Params {
+			name := p.Name
+			if p.Required {
+				name += "*"
+			}
+			params = append(params, name)
+		}
+		fmt.Printf("%s  %s %s  params: %s\n", t.Name, t.Command, strings.Join(t.Args, " "), strings.Join(params, ","))
+	}
+	return nil
+}
+
+func runNodes(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) > 0 {
+		n, err := c.GetNode(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		printNode(n)
+		return nil
+	}
+	nodes, err := c.ListNodes(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		printNode(n)
+	}
+	return nil
+}
+
+// printNode renders one inventory line; unhealthy nodes get an
+// unmissable marker.
+func printNode(n *jobworkerv1.Node) {
+	state := "alive"
+	if !n.Alive {
+		state = "STALE!"
+	}
+	var labels []string
+	for k, v := range n.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	line := fmt.Sprintf("%s  %-6s  jobs=%d", n.Name, state, n.RunningJobs)
+	if n.Cpus > 0 {
+		line += fmt.Sprintf("  cpus=%d", n.Cpus)
+	}
+	if n.MemTotalBytes > 0 {
+		line += fmt.Sprintf("  mem=%d/%dMiB free", n.MemFreeBytes>>20, n.MemTotalBytes>>20)
+	}
+	if n.GpusTotal > 0 {
+		line += fmt.Sprintf("  gpus=%d/%d", n.GpusFree, n.GpusTotal)
+	}
+	if n.Version != "" {
+		line += "  " + n.Version
+	}
+	if n.LastHeartbeatUnix > 0 {
+		line += fmt.Sprintf("  hb=%s ago", time.Since(time.Unix(n.LastHeartbeatUnix, 0)).Round(time.Second))
+	}
+	fmt.Printf("%s  %s  %s\n", line, n.Addr, strings.Join(labels, ","))
+}
+
+func runVersion(ctx context.Context, c *client.Client) error {
+	fmt.Printf("client: %s (commit %s, built %s)\n", version.Version, version.Commit, version.BuildDate)
+	resp, err := c.GetVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching server version: %w", err)
+	}
+	fmt.Printf("server: %s (commit %s, built %s)\n", resp.Version, resp.Commit, resp.BuildDate)
+	fmt.Printf("server capabilities: %s\n", strings.Join(resp.Capabilities, ", "))
+	if resp.Version != version.Version {
+		fmt.Fprintf(os.Stderr, "warning: client %s and server %s differ; some features may be unavailable\n",
+			version.Version, resp.Version)
+	}
+	return nil
+}
+
+func runStop(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("stop", flag.ContinueOnError)
+	reason := fs.String("reason", "", "note recorded with the stop, shown in the job's status")
+	selector := labelFlags{}
+	fs.Var(selector, "l", "stop all jobs with this key=value label (repeatable)")
+	jobStatus := fs.String("status", "", "with bulk stop, only jobs in this state: running or paused")
+	all := fs.Bool("all", false, "stop all of your jobs; required when no other selector is given")
+	force := fs.Bool("force", false, "skip the confirmation prompt and stop protected jobs too")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	bulk := len(selector) > 0 || *jobStatus != "" || *all
+	if bulk {
+		if fs.NArg() != 0 {
+			return errors.New("a job ID and a selector cannot be combined")
+		}
+		if !*force {
+			if err := confirm(fmt.Sprintf("stop every job matching %s?", describeStopSelector(selector, *jobStatus))); err != nil {
+				return err
+			}
+		}
+		results, err := c.BatchStop(ctx, selector, *jobStatus, *all, *force, *reason)
+		if err != nil {
+			return err
+		}
+		stopped := 0
+		for _, r := range results {
+			if r.Error != "" {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", r.JobId, r.Error)
+				continue
+			}
+			fmt.Println(r.JobId)
+			stopped++
+		}
+		if stopped != len(results) {
+			return fmt.Errorf("stopped %d of %d matching jobs", stopped, len(results))
+		}
+		return nil
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: stop [-reason text] [-force] <job-id> | stop -l k=v [-status s] [-all]")
+	}
+	if *force {
+		return c.StopJobForce(ctx, fs.Arg(0), *reason)
+	}
+	if err := confirm(fmt.Sprintf("stop job %s?", fs.Arg(0))); err != nil {
+		return err
+	}
+	return c.StopJobReason(ctx, fs.Arg(0), *reason)
+}
+
+// describeStopSelector renders a bulk stop's selector for the
+// confirmation prompt.
+func describeStopSelector(selector map[string]string, jobStatus string) string {
+	var parts []string
+	for k, v := range selector {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+	if jobStatus != "" {
+		parts = append(parts, "status "+jobStatus)
+	}
+	if len(parts) == 0 {
+		return "any selector (ALL your jobs)"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func runPause(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: pause <job-id>")
+	}
+	return c.PauseJob(ctx, args[0])
+}
+
+func runResume(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: resume <job-id>")
+	}
+	return c.ResumeJob(ctx, args[0])
+}
+
+func runStatus(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: status <job-id>")
+	}
+	j, err := c.GetJobStatus(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	printJob(j)
+	return nil
+}
+
+// describeTailLines is how much recent output describe shows.
+const describeTailLines = 20
+
+func runDescribe(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: describe <job-id>")
+	}
+	j, err := c.GetJobStatus(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	printJob(j)
+	if j.Restarts > 0 || j.RestartPolicy != "never" {
+		fmt.Printf("Restarts: %d (%s)\n", j.Restarts, j.RestartPolicy)
+	}
+	if j.CpuBudgetSeconds > 0 {
+		fmt.Printf("CPUBudget: %s\n", time.Duration(j.CpuBudgetSeconds)*time.Second)
+	}
+	if j.MaxOutputBytes > 0 {
+		fmt.Printf("MaxOutput: %d bytes\n", j.MaxOutputBytes)
+	}
+	if j.Nice != 0 || j.IoClass != "" {
+		fmt.Printf("Sched:    nice=%d io-class=%s io-priority=%d\n", j.Nice, j.IoClass, j.IoPriority)
+	}
+	if len(j.CpuAffinity) > 0 {
+		fmt.Printf("CPUs:     %v\n", j.CpuAffinity)
+	}
+	if len(j.Gpus) > 0 {
+		fmt.Printf("GPUs:     %s\n", strings.Join(j.Gpus, ","))
+	}
+	if len(j.Ports) > 0 {
+		fmt.Printf("Ports:    %v\n", j.Ports)
+	}
+	if len(j.EnvKeys) > 0 {
+		fmt.Printf("Env:      %s (values withheld)\n", strings.Join(j.EnvKeys, ", "))
+	}
+	transitions, err := c.GetHistory(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Println("Timeline:")
+	for _, tr := range transitions {
+		line := fmt.Sprintf("  %s  %s", time.Unix(0, tr.AtUnixNanos).Format(time.RFC3339), tr.State)
+		if tr.Note != "" {
+			line += "  (" + tr.Note + ")"
+		}
+		fmt.Println(line)
+	}
+	lines, err := c.GetOutputWindow(ctx, args[0], client.OutputWindow{TailLines: describeTailLines})
+	if err != nil {
+		return err
+	}
+	if len(lines) > 0 {
+		fmt.Printf("Output (last %d lines):\n", len(lines))
+		for _, l := range lines {
+			fmt.Println("  " + l)
+		}
+	}
+	return nil
+}
+
+// pipelineManifest is the on-disk layout of a pipeline -f file.
+type pipelineManifest struct {
+	Name          string `json:"name"`
+	FailurePolicy string `json:"failure_policy"`
+	Stages        []struct {
+		Name           string   `json:"name"`
+		Command        string   `json:"command"`
+		Args           []string `json:"args"`
+		Needs          []string `json:"needs"`
+		Artifacts      []string `json:"artifacts"`
+		MaxOutputBytes int64    `json:"max_output_bytes"`
+		Nice           int      `json:"nice"`
+	} `json:"stages"`
+}
+
+func runPipeline(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: pipeline <start|status|logs|stop> ...")
+	}
+	sub, args := args[0], args[1:]
+	switch sub {
+	case "start":
+		return runPipelineStart(ctx, c, args)
+	case "status":
+		return runPipelineStatus(ctx, c, args)
+	case "logs":
+		return runPipelineLogs(ctx, c, args)
+	case "stop":
+		return runPipelineStop(ctx, c, args)
+	default:
+		return fmt.Errorf("unknown pipeline command %q", sub)
+	}
+}
+
+func runPipelineStart(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("pipeline start", flag.ContinueOnError)
+	file := fs.String("f", "", "JSON file describing the pipeline's stages")
+	name := fs.String("name", "", "pipeline name, overriding the file's")
+	policy := fs.String("failure-policy", "", "fail-fast (default) or continue, overriding the file's")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" || fs.NArg() != 0 {
+		return errors.New("usage: pipeline start -f pipeline.json [-name n] [-failure-policy p]")
+	}
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	var manifest pipelineManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("parsing %s: %w", *file, err)
+	}
+	stages := make([]client.PipelineStage, len(manifest.Stages))
+	for i, st := range manifest.Stages {
+		stages[i] = client.PipelineStage{
+			Name:  st.Name,
+			Needs: st.Needs,
+			Spec: client.StartSpec{
+				Command:        st.Command,
+				Args:           st.Args,
+				Artifacts:      st.Artifacts,
+				MaxOutputBytes: st.MaxOutputBytes,
+				Nice:           st.Nice,
+			},
+		}
+	}
+	if *name != "" {
+		manifest.Name = *name
+	}
+	if *policy != "" {
+		manifest.FailurePolicy = *policy
+	}
+	id, err := c.StartPipeline(ctx, manifest.Name, stages, manifest.FailurePolicy)
+	if err != nil {
+		return err
+	}
+	fmt.Println(id)
+	return nil
+}
+
+func runPipelineStatus(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: pipeline status <id>")
+	}
+	p, err := c.GetPipeline(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Pipeline: %s\n", p.Id)
+	if p.Name != "" {
+		fmt.Printf("Name:     %s\n", p.Name)
+	}
+	fmt.Printf("Status:   %s (%s)\n", p.Status, p.FailurePolicy)
+	fmt.Println("Stages:")
+	for _, st := range p.Stages {
+		line := fmt.Sprintf("  %-10s %s", st.State, st.Name)
+		if st.JobId != "" {
+			line += "  " + st.JobId
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// runPipelineLogs prints every stage's captured output in stage order,
+// each line prefixed with the stage it came from.
+func runPipelineLogs(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: pipeline logs <id>")
+	}
+	p, err := c.GetPipeline(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	for _, st := range p.Stages {
+		if st.JobId == "" {
+			continue
+		}
+		entries, err := c.GetOutputEntries(ctx, st.JobId, client.OutputWindow{})
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			printLine(os.Stdout, st.Name+" ", e, printOpts{})
+		}
+	}
+	return nil
+}
+
+func runPipelineStop(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: pipeline stop <id>")
+	}
+	stopped, err := c.StopPipeline(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("stopped %d running stages\n", stopped)
+	return nil
+}
+
+func runSweep(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: sweep <start|status|stop> ...")
+	}
+	sub, args := args[0], args[1:]
+	switch sub {
+	case "start":
+		return runSweepStart(ctx, c, args)
+	case "status":
+		return runSweepStatus(ctx, c, args)
+	case "stop":
+		return runSweepStop(ctx, c, args)
+	default:
+		return fmt.Errorf("unknown sweep command %q", sub)
+	}
+}
+
+func runSweepStart(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("sweep start", flag.ContinueOnError)
+	matrix := labelFlags{}
+	fs.Var(matrix, "matrix", "sweep this template parameter over key=v1,v2 or key=lo..hi (repeatable)")
+	params := labelFlags{}
+	fs.Var(params, "param", "fix this template parameter for every child, as key=value (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || len(matrix) == 0 {
+		return errors.New("usage: sweep start -matrix k=v1,v2 [-param k=v] <template>")
+	}
+	id, started, err := c.StartSweep(ctx, fs.Arg(0), matrix, params)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s (%d jobs)\n", id, started)
+	return nil
+}
+
+func runSweepStatus(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: sweep status <id>")
+	}
+	sw, err := c.GetSweep(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Sweep:    %s\n", sw.Id)
+	fmt.Printf("Template: %s\n", sw.Template)
+	fmt.Printf("Status:   %s (%d/%d done, %d failed)\n", sw.Status, sw.Completed+sw.Failed, sw.Total, sw.Failed)
+	fmt.Println("Children:")
+	for _, ch := range sw.Children {
+		fmt.Printf("  %-10s %s  %s\n", ch.State, ch.JobId, formatParams(ch.Params))
+	}
+	return nil
+}
+
+// formatParams renders matrix parameters as sorted key=value pairs.
+func formatParams(params map[string]string) string {
+	pairs := make([]string, 0, len(params))
+	for k, v := range params {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
+
+func runSweepStop(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: sweep stop <id>")
+	}
+	stopped, err := c.StopSweep(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("stopped %d running children\n", stopped)
+	return nil
+}
+
+func runManifest(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: manifest <job-id>")
+	}
+	m, err := c.GetJobManifest(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	cmd := m.Command
+	if len(m.Args) > 0 {
+		cmd += " " + strings.Join(m.Args, " ")
+	}
+	fmt.Printf("Job:      %s\n", m.JobId)
+	fmt.Printf("Command:  %s\n", cmd)
+	if m.Dir != "" {
+		fmt.Printf("Dir:      %s\n", m.Dir)
+	}
+	fmt.Printf("Started:  %s\n", time.Unix(m.StartedAtUnix, 0).Format(time.RFC3339))
+	fmt.Printf("Host:     %s (%s)\n", m.Hostname, m.Kernel)
+	fmt.Printf("Runner:   %s (%s)\n", m.ServerVersion, m.GoVersion)
+	if m.Nice != 0 {
+		fmt.Printf("Nice:     %d\n", m.Nice)
+	}
+	if m.IoClass != "" {
+		fmt.Printf("IOClass:  %s\n", m.IoClass)
+	}
+	if len(m.CpuAffinity) > 0 {
+		fmt.Printf("CPUs:     %v\n", m.CpuAffinity)
+	}
+	if m.CoreLimitBytes > 0 {
+		fmt.Printf("MaxCore:  %d bytes\n", m.CoreLimitBytes)
+	}
+	if m.CpuBudgetSeconds > 0 {
+		fmt.Printf("CPUBudget: %s\n", time.Duration(m.CpuBudgetSeconds)*time.Second)
+	}
+	fmt.Println("Env:")
+	for _, e := range m.Env {
+		fmt.Println("  " + e)
+	}
+	return nil
+}
+
+func runRerun(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("rerun", flag.ContinueOnError)
+	set := labelFlags{}
+	fs.Var(set, "set", "override a start parameter, as flag=value, e.g. cpu-budget=1h (repeatable)")
+	var appendArgs listFlags
+	fs.Var(&appendArgs, "append-arg", "add an argument after the recorded ones (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: rerun [-set flag=value] [-append-arg a] <job-id>")
+	}
+	id, err := c.RerunJob(ctx, fs.Arg(0), set, appendArgs)
+	if err != nil {
+		return err
+	}
+	fmt.Println(id)
+	return nil
+}
+
+func runWait(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("wait", flag.ContinueOnError)
+	timeout := fs.Duration("timeout", 0, "give up after this long (0 = wait forever)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: wait [-timeout d] <job-id>")
+	}
+	j, err := c.WaitJob(ctx, fs.Arg(0), *timeout)
+	if err != nil {
+		return err
+	}
+	printJob(j)
+	if j.ExitCode != 0 {
+		return fmt.Errorf("job exited with code %d", j.ExitCode)
+	}
+	return nil
+}
+
+func runList(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	group := fs.Bool("group", false, "collapse repeated runs into one row per name/command")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	jobs, err := c.ListJobs(ctx)
+	if err != nil {
+		return err
+	}
+	if *group {
+		return printGroupedJobs(jobs)
+	}
+	for _, j := range jobs {
+		line := fmt.Sprintf("%s  %-9s  %s", j.Id, jobStatusString(j), commandLine(j))
+		if j.Name != "" {
+			line += "  (" + j.Name + ")"
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// runHistory lists jobs from the server's SQL job store rather than its
+// in-memory table, so runs from before the last worker restart show up
+// too. All filtering happens server-side.
+func runHistory(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("history", flag.ContinueOnError)
+	selector := labelFlags{}
+	fs.Var(selector, "l", "keep only jobs with this key=value label (repeatable)")
+	jobStatus := fs.String("status", "", "keep only jobs in this state, e.g. COMPLETED or FAILED")
+	since := fs.Duration("since", 0, "keep only jobs started within this long ago")
+	limit := fs.Int("limit", 0, "show at most this many jobs, newest first (0 = all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	f := client.HistoryFilter{Status: *jobStatus, Limit: *limit}
+	if len(selector) > 0 {
+		f.Labels = selector
+	}
+	if *since > 0 {
+		f.Since = time.Now().Add(-*since)
+	}
+	jobs, err := c.ListJobHistory(ctx, f)
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		cmd := j.Command
+		for _, a := range j.Args {
+			cmd += " " + a
+		}
+		line := fmt.Sprintf("%s  %s  %-9s  %s",
+			j.Id, time.Unix(j.StartedAtUnix, 0).Format("2006-01-02 15:04:05"), j.Status, cmd)
+		if j.Name != "" {
+			line += "  (" + j.Name + ")"
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// printGroupedJobs is the deduplicated list view: one row per job name
+// (unnamed jobs group by command) with the run count and the most recent
+// run's status and ID.
+func printGroupedJobs(jobs []*jobworkerv1.Job) error {
+	type groupRow struct {
+		runs   int
+		latest *jobworkerv1.Job
+	}
+	groups := make(map[string]*groupRow)
+	var order []string
+	for _, j := range jobs {
+		key := j.Name
+		if key == "" {
+			key = j.Command
+		}
+		row, ok := groups[key]
+		if !ok {
+			row = &groupRow{}
+			groups[key] = row
+			order = append(order, key)
+		}
+		row.runs++
+		if row.latest == nil || j.StartedAtUnix > row.latest.StartedAtUnix {
+			row.latest = j
+		}
+	}
+	sort.Strings(order)
+	for _, key := range order {
+		row := groups[key]
+		fmt.Printf("%-30s  %3d runs  last: %-9s  %s\n",
+			key, row.runs, statusString(row.latest.Status), row.latest.Id)
+	}
+	return nil
+}
+
+func runStats(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	groupBy := fs.String("group-by", "", "group jobs by: name (default) or command")
+	last := fs.Int("last", 10, "consider only the last N finished runs per group (0 = all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	groups, err := c.GetRunStats(ctx, *groupBy, *last)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		line := fmt.Sprintf("%-30s  %3d runs", g.Group, g.Runs)
+		if g.Runs > 0 {
+			line += fmt.Sprintf("  %3.0f%% ok  p50 %s  p90 %s",
+				100*float64(g.Runs-g.Failures)/float64(g.Runs),
+				time.Duration(g.P50Millis)*time.Millisecond,
+				time.Duration(g.P90Millis)*time.Millisecond)
+		}
+		if g.Running > 0 {
+			line += fmt.Sprintf("  %d running", g.Running)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func runQuota(ctx context.Context, c *client.Client) error {
+	owners, err := c.GetQuotaUsage(ctx)
+	if err != nil {
+		return err
+	}
+	quotaCell := func(used, limit int64) string {
+		if limit == 0 {
+			return fmt.Sprintf("%d", used)
+		}
+		return fmt.Sprintf("%d/%d", used, limit)
+	}
+	for _, o := range owners {
+		fmt.Printf("%-20s  jobs %-10s  cpu %-12s  logs %-14s  since %s\n",
+			o.Owner,
+			quotaCell(o.Jobs, o.JobsLimit),
+			quotaCell(o.CpuSeconds, o.CpuSecondsLimit)+"s",
+			quotaCell(o.LogBytes, o.LogBytesLimit)+"B",
+			time.Unix(o.WindowStartUnix, 0).UTC().Format("2006-01-02"))
+	}
+	return nil
+}
+
+func runMaintenance(ctx context.Context, c *client.Client) error {
+	m, err := c.GetMaintenance(ctx)
+	if err != nil {
+		return err
+	}
+	if !m.Active {
+		fmt.Println("no maintenance window active")
+		return nil
+	}
+	fmt.Printf("maintenance until %s", time.Unix(m.UntilUnix, 0).UTC().Format(time.RFC3339))
+	if m.Reason != "" {
+		fmt.Printf(": %s", m.Reason)
+	}
+	fmt.Println()
+	return nil
+}
+
+func runAdmin(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) < 1 {
+		return errors.New("usage: admin <pause|resume|drain|reload|verify-audit|diag|debug> [flags]")
+	}
+	switch sub, rest := args[0], args[1:]; sub {
+	case "pause":
+		fs := flag.NewFlagSet("admin pause", flag.ContinueOnError)
+		reason := fs.String("reason", "", "reason shown to rejected starts")
+		if err := fs.Parse(rest); err != nil {
+			return err
+		}
+		return c.SetAdmission(ctx, true, *reason)
+	case "resume":
+		return c.SetAdmission(ctx, false, "")
+	case "drain":
+		running, err := c.Drain(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("draining; %d jobs still running\n", running)
+		return nil
+	case "reload":
+		reloaded, err := c.ReloadConfig(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println("reloaded:", strings.Join(reloaded, ", "))
+		return nil
+	case "verify-audit":
+		entries, err := c.VerifyAudit(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("audit trail intact: %d entries\n", entries)
+		return nil
+	case "diag":
+		fs := flag.NewFlagSet("admin diag", flag.ContinueOnError)
+		out := fs.String("o", "", "write the goroutine dump to this file instead of stdout")
+		if err := fs.Parse(rest); err != nil {
+			return err
+		}
+		diag, err := c.DumpDiagnostics(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("goroutines: %d  jobs: %d (%d running)  heap: %d bytes\n",
+			diag.Goroutines, diag.Jobs, diag.RunningJobs, diag.HeapBytes)
+		if *out != "" {
+			return os.WriteFile(*out, diag.GoroutineDump, 0o644)
+		}
+		_, err = os.Stdout.Write(diag.GoroutineDump)
+		return err
+	case "debug":
+		fs := flag.NewFlagSet("admin debug", flag.ContinueOnError)
+		tool := fs.String("tool", "strace", "diagnostic tool to attach: strace or perf")
+		duration := fs.Duration("duration", 0, "how long to capture (0 = server default)")
+		if err := fs.Parse(rest); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return errors.New("usage: admin debug [-tool strace|perf] [-duration 10s] <job>")
+		}
+		name, size, err := c.DebugJob(ctx, fs.Arg(0), *tool, *duration)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("captured %s (%d bytes); fetch it with: artifacts get %s %s\n", name, size, fs.Arg(0), name)
+		return nil
+	default:
+		return fmt.Errorf("unknown admin subcommand %q", sub)
+	}
+}
+
+func runLogs(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("logs", flag.ContinueOnError)
+	follow := fs.Bool("follow", false, "follow output until the jobs finish")
+	tail := fs.Int64("tail", 0, "print only the last N lines (0 = everything)")
+	since := fs.Duration("since", 0, "print only lines captured within this duration, e.g. 10m (0 = everything)")
+	timestamps := fs.Bool("timestamps", false, "prefix each line with its capture time")
+	stripANSI := fs.Bool("strip-ansi", false, "strip ANSI escape sequences before printing")
+	outputFile := fs.String("output-file", "", "write lines to this file instead of stdout")
+	prefixJobID := fs.Bool("prefix-job-id", false, "prefix each line with the job it came from (implied for several jobs)")
+	grep := fs.String("grep", "", "with -follow, only receive lines matching this RE2 pattern (filtered server-side)")
+	level := fs.String("level", "", `with -follow, only receive lines at this log level, e.g. "error" (filtered server-side)`)
+	pretty := fs.Bool("pretty", false, "render parsed JSON-lines entries as level and message instead of raw JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return errors.New("usage: logs [-follow] [-tail n] [-since d] [-timestamps] [-strip-ansi] [-output-file f] [-prefix-job-id] [-grep p] <job-id>...")
+	}
+	ids := fs.Args()
+
+	var sinceTime time.Time
+	if *since > 0 {
+		sinceTime = time.Now().Add(-*since)
+	}
+	if *follow && *tail > 0 {
+		return errors.New("-tail cannot be combined with -follow")
+	}
+	if *grep != "" && !*follow {
+		return errors.New("-grep requires -follow; use the grep command for captured output")
+	}
+	if *level != "" && !*follow {
+		return errors.New("-level requires -follow; use the grep command for captured output")
+	}
+
+	out := io.Writer(os.Stdout)
+	if *outputFile != "" {
+		f, err := os.Create(*outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	// With several jobs every line carries its origin, stern-style.
+	prefixes := make(map[string]string, len(ids))
+	for _, id := range ids {
+		if *prefixJobID || len(ids) > 1 {
+			prefixes[id] = id + " "
+		}
+	}
+
+	opts := printOpts{
+		timestamps: *timestamps,
+		stripANSI:  *stripANSI,
+		color:      *outputFile == "" && stdoutIsTerminal(),
+		pretty:     *pretty,
+	}
+
+	if !*follow {
+		for _, id := range ids {
+			entries, err := c.GetOutputEntries(ctx, id, client.OutputWindow{TailLines: *tail, Since: sinceTime})
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				printLine(out, prefixes[id], e, opts)
+			}
+		}
+		return nil
+	}
+
+	// Follow all jobs at once, one stream each, interleaving lines as they
+	// arrive; the mutex keeps lines whole.
+	var mu sync.Mutex
+	errc := make(chan error, len(ids))
+	for _, id := range ids {
+		stream, err := c.StreamOutputFiltered(ctx, id, sinceTime, *grep, *level)
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		go func(id string, stream *client.OutputStream) {
+			for stream.Next() {
+				mu.Lock()
+				printLine(out, prefixes[id], stream.Entry(), opts)
+				mu.Unlock()
+			}
+			errc <- stream.Err()
+		}(id, stream)
+	}
+	var firstErr error
+	for range ids {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func runAnnotate(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: annotate <job-id> <text...>")
+	}
+	return c.Annotate(ctx, args[0], strings.Join(args[1:], " "))
+}
+
+// printOpts bundles the rendering switches for printLine.
+type printOpts struct {
+	timestamps bool
+	stripANSI  bool
+	color      bool
+	pretty     bool
+}
+
+// printLine prints one output line, optionally prefixed with its origin job
+// and its capture time in the style of docker logs --timestamps.
+// Annotation markers are set off from process output with a dashed frame;
+// pretty swaps a parsed JSON-lines entry for its level and message; with
+// color, lines the server classified as errors or warnings are tinted.
+func printLine(w io.Writer, prefix string, e *jobworkerv1.OutputLine, opts printOpts) {
+	line := e.Line
+	if e.Stream == "marker" {
+		line = "--- " + line + " ---"
+	}
+	if opts.pretty && e.Msg != "" {
+		line = e.Msg
+		if e.Level != "" {
+			line = strings.ToUpper(e.Level) + " " + line
+		}
+	}
+	if opts.stripANSI {
+		line = job.StripANSI(line)
+	}
+	if opts.color {
+		switch e.Level {
+		case "error":
+			line = "\x1b[31m" + line + "\x1b[0m"
+		case "warn":
+			line = "\x1b[33m" + line + "\x1b[0m"
+		}
+	}
+	if opts.timestamps {
+		fmt.Fprintf(w, "%s%s %s\n", prefix, time.Unix(0, e.WallTimeUnixNanos).Format(time.RFC3339Nano), line)
+		return
+	}
+	fmt.Fprintf(w, "%s%s\n", prefix, line)
+}
+
+func runGrep(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("grep", flag.ContinueOnError)
+	literal := fs.Bool("F", false, "treat the pattern as a literal string, not a regexp")
+	ignoreCase := fs.Bool("i", false, "match case-insensitively")
+	maxMatches := fs.Int("m", 0, "stop after this many matches (0 = server default)")
+	contextLines := fs.Int("C", 0, "print this many lines of context around each match")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: grep [-F] [-i] [-m n] [-C n] <job-id> <pattern>")
+	}
+	resp, err := c.SearchOutput(ctx, fs.Arg(0), client.SearchSpec{
+		Pattern:         fs.Arg(1),
+		Literal:         *literal,
+		CaseInsensitive: *ignoreCase,
+		MaxMatches:      *maxMatches,
+		ContextLines:    *contextLines,
+	})
+	if err != nil {
+		return err
+	}
+	for _, m := range resp.Matches {
+		for i, line := range m.Before {
+			fmt.Printf("%d-%s\n", m.LineNumber-int64(len(m.Before)-i), line)
+		}
+		fmt.Printf("%d:%s\n", m.LineNumber, m.Line)
+		for i, line := range m.After {
+			fmt.Printf("%d-%s\n", m.LineNumber+int64(i+1), line)
+		}
+	}
+	if resp.Truncated {
+		fmt.Fprintln(os.Stderr, "(more matches; raise -m to see them)")
+	}
+	return nil
+}
+
+func runStdin(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: stdin <job-id>")
+	}
+	n, err := c.WriteStdin(ctx, args[0], os.Stdin)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "wrote %d bytes\n", n)
+	return nil
+}
+
+// detachInput forwards stdin to an attach session line by line, reporting
+// EOF when the caller types the ssh-style detach sequence "~." on a line of
+// its own.
+type detachInput struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func (d *detachInput) Read(p []byte) (int, error) {
+	if len(d.buf) == 0 {
+		line, err := d.r.ReadString('\n')
+		if strings.TrimSuffix(line, "\n") == "~." {
+			return 0, io.EOF
+		}
+		if len(line) == 0 {
+			return 0, err
+		}
+		d.buf = []byte(line)
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func runAttach(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: attach <job-id>")
+	}
+	id := args[0]
+	fmt.Fprintln(os.Stderr, `attached; type "~." on a line of its own to detach`)
+	in := &detachInput{r: bufio.NewReader(os.Stdin)}
+	if err := c.Attach(ctx, id, in, os.Stdout); err != nil {
+		return err
+	}
+	j, err := c.GetJobStatus(ctx, id)
+	if err != nil {
+		return err
+	}
+	if j.Status == jobworkerv1.JobStatus_JOB_STATUS_RUNNING || j.Status == jobworkerv1.JobStatus_JOB_STATUS_PAUSED {
+		fmt.Fprintf(os.Stderr, "detached; job %s keeps running\n", id)
+	} else {
+		fmt.Fprintf(os.Stderr, "job %s finished: %s (exit code %d)\n", id, statusString(j.Status), j.ExitCode)
+	}
+	return nil
+}
+
+// replayMaxGap caps the pause between replayed lines, like asciinema's idle
+// limit: an hour of job silence should not mean an hour of playback.
+const replayMaxGap = 2 * time.Second
+
+func runReplay(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	speed := fs.Float64("speed", 1, "playback speed multiplier")
+	cast := fs.String("cast", "", "write an asciicast v2 recording to this file instead of playing back")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: replay [-speed n] [-cast file] <job-id>")
+	}
+	entries, err := c.GetOutputEntries(ctx, fs.Arg(0), client.OutputWindow{})
+	if err != nil {
+		return err
+	}
+	if *cast != "" {
+		return writeAsciicast(*cast, entries)
+	}
+	if *speed <= 0 {
+		return errors.New("-speed must be positive")
+	}
+	var prev int64
+	for _, e := range entries {
+		gap := time.Duration(float64(e.MonoOffsetNanos-prev) / *speed)
+		if gap > replayMaxGap {
+			gap = replayMaxGap
+		}
+		if gap > 0 {
+			time.Sleep(gap)
+		}
+		prev = e.MonoOffsetNanos
+		fmt.Println(e.Line)
+	}
+	return nil
+}
+
+// writeAsciicast exports the job's timed output as an asciicast v2 file
+// (one JSON header line, then [time, "o", data] events), playable with
+// asciinema. Jobs run on pipes, so events are whole lines, not keystrokes.
+func writeAsciicast(path string, entries []*jobworkerv1.OutputLine) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	header := map[string]any{"version": 2, "width": 80, "height": 24}
+	if len(entries) > 0 {
+		header["timestamp"] = entries[0].WallTimeUnixNanos / int64(time.Second)
+	}
+	if err := enc.Encode(header); err != nil {
+		f.Close()
+		return err
+	}
+	for _, e := range entries {
+		event := []any{float64(e.MonoOffsetNanos) / float64(time.Second), "o", e.Line + "\r\n"}
+		if err := enc.Encode(event); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+func runArtifacts(ctx context.Context, c *client.Client, args []string) error {
+	if len(args) > 0 && args[0] == "get" {
+		return runArtifactsGet(ctx, c, args[1:])
+	}
+	if len(args) != 1 {
+		return errors.New("usage: artifacts <job-id> | artifacts get <job-id> <path> [-o file]")
+	}
+	artifacts, err := c.ListArtifacts(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	for _, a := range artifacts {
+		fmt.Printf("%10d  %s\n", a.SizeBytes, a.Path)
+	}
+	return nil
+}
+
+func runArtifactsGet(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("artifacts get", flag.ContinueOnError)
+	out := fs.String("o", "", "write the artifact to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("usage: artifacts get <job-id> <path> [-o file]")
+	}
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	_, err := c.DownloadArtifact(ctx, fs.Arg(0), fs.Arg(1), w)
+	return err
+}
+
+func printJob(j *jobworkerv1.Job) {
+	fmt.Printf("ID:       %s\n", j.Id)
+	if j.Name != "" {
+		fmt.Printf("Name:     %s\n", j.Name)
+	}
+	fmt.Printf("Owner:    %s\n", j.Owner)
+	fmt.Printf("Command:  %s\n", commandLine(j))
+	fmt.Printf("Status:   %s\n", jobStatusString(j))
+	if p := j.Progress; p != nil && p.Total > 0 {
+		line := fmt.Sprintf("%d/%d (%d%%)", p.Done, p.Total, p.Done*100/p.Total)
+		if p.Message != "" {
+			line += " " + p.Message
+		}
+		fmt.Printf("Progress: %s\n", line)
+	}
+	if j.Stalled {
+		fmt.Printf("Stalled:  yes\n")
+	}
+	fmt.Printf("Started:  %s\n", time.Unix(j.StartedAtUnix, 0).Format(time.RFC3339))
+	if j.EndedAtUnix != 0 {
+		fmt.Printf("Ended:    %s\n", time.Unix(j.EndedAtUnix, 0).Format(time.RFC3339))
+		fmt.Printf("ExitCode: %d\n", j.ExitCode)
+	}
+	if j.PeakRssBytes > 0 {
+		fmt.Printf("PeakRSS:  %d bytes\n", j.PeakRssBytes)
+	}
+	if j.StopRequestedAtUnix != 0 {
+		by := j.StoppedBy
+		if j.StopReason != "" {
+			by += ": " + j.StopReason
+		}
+		fmt.Printf("Stopped:  %s (%s)\n", by, time.Unix(j.StopRequestedAtUnix, 0).Format(time.RFC3339))
+	}
+}
+
+func commandLine(j *jobworkerv1.Job) string {
+	line := j.Command
+	for _, a := range j.Args {
+		line += " " + a
+	}
+	return line
+}
+
+// jobStatusString is statusString plus the job's custom exit-status label
+// when one applied, e.g. "FAILED:retryable".
+func jobStatusString(j *jobworkerv1.Job) string {
+	s := statusString(j.Status)
+	if j.StatusLabel != "" {
+		s += ":" + j.StatusLabel
+	}
+	return s
+}
+
+func statusString(s jobworkerv1.JobStatus) string {
+	switch s {
+	case jobworkerv1.JobStatus_JOB_STATUS_RUNNING:
+		return "RUNNING"
+	case jobworkerv1.JobStatus_JOB_STATUS_COMPLETED:
+		return "COMPLETED"
+	case jobworkerv1.JobStatus_JOB_STATUS_FAILED:
+		return "FAILED"
+	case jobworkerv1.JobStatus_JOB_STATUS_STOPPED:
+		return "STOPPED"
+	case jobworkerv1.JobStatus_JOB_STATUS_PAUSED:
+		return "PAUSED"
+	case jobworkerv1.JobStatus_JOB_STATUS_OUTPUT_LIMIT_EXCEEDED:
+		return "OUTPUT_LIMIT_EXCEEDED"
+	case jobworkerv1.JobStatus_JOB_STATUS_CPU_BUDGET_EXCEEDED:
+		return "CPU_BUDGET_EXCEEDED"
+	case jobworkerv1.JobStatus_JOB_STATUS_OOM_KILLED:
+		return "OOM_KILLED"
+	default:
+		return "UNKNOWN"
+	}
+}
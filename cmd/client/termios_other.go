@@ -0,0 +1,12 @@
+//go:build darwin || freebsd
+
+package main
+
+import "syscall"
+
+// The BSDs (and darwin) expose the same termios structure under the
+// older TIOC ioctl names.
+const (
+	ioctlGetTermios = syscall.TIOCGETA
+	ioctlSetTermios = syscall.TIOCSETA
+)
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// runTop renders a live, top-style dashboard of the caller's jobs: one row
+// per job with status, owner, CPU time, resident memory, and duration.
+// The view refreshes on a timer and immediately on WatchJobs events; j/k
+// (or the arrow keys) move the selection, s stops the selected job, l
+// replaces the dashboard with its logs, and q quits.
+func runTop(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("top", flag.ContinueOnError)
+	interval := fs.Duration("interval", 2*time.Second, "refresh interval")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	fd := int(os.Stdin.Fd())
+	saved, err := makeRawTerminal(fd)
+	if err != nil {
+		return fmt.Errorf("top needs a terminal: %w", err)
+	}
+	defer restoreTerminal(fd, saved)
+	// The alternate screen keeps the shell's scrollback intact; both it
+	// and the cursor come back on exit.
+	fmt.Print("\x1b[?1049h\x1b[?25l")
+	defer fmt.Print("\x1b[?25h\x1b[?1049l")
+
+	keys := make(chan byte, 8)
+	go readKeys(keys)
+
+	// WatchJobs nudges an immediate redraw on start and finish events;
+	// the ticker keeps CPU and memory fresh in between.
+	events := make(chan struct{}, 1)
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	go func() {
+		stream, err := c.WatchJobs(watchCtx, "")
+		if err != nil {
+			return
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+			select {
+			case events <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	var (
+		jobs     []*jobworkerv1.Job
+		selected int
+		note     string
+	)
+	refresh := func() error {
+		list, err := c.ListJobs(ctx)
+		if err != nil {
+			return err
+		}
+		sortTopJobs(list)
+		jobs = list
+		if selected >= len(jobs) {
+			selected = len(jobs) - 1
+		}
+		if selected < 0 {
+			selected = 0
+		}
+		return nil
+	}
+	if err := refresh(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		drawTop(jobs, selected, note)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := refresh(); err != nil {
+				return err
+			}
+		case <-events:
+			if err := refresh(); err != nil {
+				return err
+			}
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			switch key {
+			case 'q', 3: // q or Ctrl-C
+				return nil
+			case 'j':
+				if selected < len(jobs)-1 {
+					selected++
+				}
+			case 'k':
+				if selected > 0 {
+					selected--
+				}
+			case 's':
+				if selected < len(jobs) {
+					j := jobs[selected]
+					if err := c.StopJobReason(ctx, j.Id, "stopped from top"); err != nil {
+						note = fmt.Sprintf("stop %s: %v", shortJobID(j.Id), err)
+					} else {
+						note = "stopped " + shortJobID(j.Id)
+					}
+					if err := refresh(); err != nil {
+						return err
+					}
+				}
+			case 'l':
+				if selected < len(jobs) {
+					if err := topLogs(ctx, c, jobs[selected].Id, keys); err != nil {
+						note = fmt.Sprintf("logs: %v", err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// drawTop repaints the whole dashboard: a reverse-video header, one row
+// per job with the selection highlighted, and a key legend.
+func drawTop(jobs []*jobworkerv1.Job, selected int, note string) {
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	header := fmt.Sprintf("%-10s  %-9s  %-12s  %8s  %8s  %8s  %s",
+		"ID", "STATUS", "OWNER", "CPU", "MEM", "TIME", "COMMAND")
+	b.WriteString("\x1b[7m" + header + "\x1b[0m\r\n")
+	maxRows := terminalRows() - 3
+	for i, j := range jobs {
+		if i >= maxRows {
+			break
+		}
+		row := fmt.Sprintf("%-10s  %-9s  %-12s  %8s  %8s  %8s  %s",
+			shortJobID(j.Id), statusString(j.Status), j.Owner,
+			formatCPUSeconds(j.CpuSeconds), formatRSS(j.RssBytes),
+			topDuration(j), commandLine(j))
+		if i == selected {
+			row = "\x1b[7m" + row + "\x1b[0m"
+		}
+		b.WriteString(row + "\r\n")
+	}
+	legend := "q quit   j/k select   s stop   l logs"
+	if note != "" {
+		legend += "   | " + note
+	}
+	b.WriteString("\r\n" + legend)
+	fmt.Print(b.String())
+}
+
+// topLogs replaces the dashboard with the job's streamed output; q brings
+// the dashboard back.
+func topLogs(ctx context.Context, c *client.Client, jobID string, keys <-chan byte) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.StreamOutput(streamCtx, jobID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	fmt.Printf("\x1b[H\x1b[2Jlogs %s — press q for the dashboard\r\n\r\n", jobID)
+
+	lines := make(chan string, 64)
+	go func() {
+		defer close(lines)
+		for stream.Next() {
+			lines <- stream.Line()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case key := <-keys:
+			if key == 'q' || key == 3 {
+				return nil
+			}
+		case line, ok := <-lines:
+			if !ok {
+				// Stream over (job finished); wait for a key.
+				lines = nil
+				continue
+			}
+			fmt.Print(line + "\r\n")
+		}
+	}
+}
+
+// sortTopJobs orders running jobs first, then by CPU consumed, then by
+// most recent start, so the busy jobs sit at the top like in top itself.
+func sortTopJobs(jobs []*jobworkerv1.Job) {
+	running := func(j *jobworkerv1.Job) bool {
+		return j.Status == jobworkerv1.JobStatus_JOB_STATUS_RUNNING ||
+			j.Status == jobworkerv1.JobStatus_JOB_STATUS_PAUSED
+	}
+	sort.SliceStable(jobs, func(i, k int) bool {
+		if running(jobs[i]) != running(jobs[k]) {
+			return running(jobs[i])
+		}
+		if jobs[i].CpuSeconds != jobs[k].CpuSeconds {
+			return jobs[i].CpuSeconds > jobs[k].CpuSeconds
+		}
+		return jobs[i].StartedAtUnix > jobs[k].StartedAtUnix
+	})
+}
+
+// readKeys forwards single keypresses, folding the arrow-key escape
+// sequences onto j and k.
+func readKeys(keys chan<- byte) {
+	buf := make([]byte, 3)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			close(keys)
+			return
+		}
+		b := buf[0]
+		if n == 3 && buf[0] == 0x1b && buf[1] == '[' {
+			switch buf[2] {
+			case 'A':
+				b = 'k'
+			case 'B':
+				b = 'j'
+			default:
+				continue
+			}
+		}
+		keys <- b
+	}
+}
+
+func shortJobID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+func formatCPUSeconds(s float64) string {
+	return (time.Duration(s * float64(time.Second))).Truncate(100 * time.Millisecond).String()
+}
+
+func formatRSS(b int64) string {
+	switch {
+	case b == 0:
+		return "-"
+	case b < 1<<20:
+		return fmt.Sprintf("%dKiB", b>>10)
+	default:
+		return fmt.Sprintf("%dMiB", b>>20)
+	}
+}
+
+func topDuration(j *jobworkerv1.Job) string {
+	start := time.Unix(j.StartedAtUnix, 0)
+	end := time.Now()
+	if j.EndedAtUnix > 0 {
+		end = time.Unix(j.EndedAtUnix, 0)
+	}
+	return end.Sub(start).Truncate(time.Second).String()
+}
+
+// makeRawTerminal switches the terminal out of canonical mode with echo
+// off, so keypresses arrive immediately, and returns the state to restore.
+// Signal handling stays on: Ctrl-C still interrupts.
+func makeRawTerminal(fd int) (syscall.Termios, error) {
+	var saved syscall.Termios
+	if err := ioctlTermios(fd, ioctlGetTermios, &saved); err != nil {
+		return saved, err
+	}
+	raw := saved
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	return saved, ioctlTermios(fd, ioctlSetTermios, &raw)
+}
+
+func restoreTerminal(fd int, saved syscall.Termios) {
+	_ = ioctlTermios(fd, ioctlSetTermios, &saved)
+}
+
+func ioctlTermios(fd int, req uintptr, t *syscall.Termios) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), req, uintptr(unsafe.Pointer(t))); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// terminalRows reports the terminal height, defaulting to 24 when it
+// cannot be measured.
+func terminalRows() int {
+	var ws struct{ rows, cols, xpixel, ypixel uint16 }
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, os.Stdout.Fd(), syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&ws))); errno != 0 || ws.rows == 0 {
+		return 24
+	}
+	return int(ws.rows)
+}
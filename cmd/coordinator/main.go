@@ -0,0 +1,54 @@
+// Command coordinator runs the control plane for a multi-node deployment.
+// Workers register with it over gRPC; clients use it exactly like a single
+// worker, and jobs are scheduled onto registered workers.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pmantica4/teleport/pkg/coordinator"
+)
+
+func main() {
+	var cfg coordinator.Config
+	flag.StringVar(&cfg.Addr, "listen", ":8440", "address to listen on")
+	flag.StringVar(&cfg.CACertFile, "ca-cert", "", "CA certificate used to verify clients and workers")
+	flag.StringVar(&cfg.CertFile, "cert", "", "coordinator TLS certificate")
+	flag.StringVar(&cfg.KeyFile, "key", "", "coordinator TLS private key")
+	flag.StringVar(&cfg.TunnelAddr, "tunnel-listen", "", "address to accept worker reverse tunnels on (empty = disabled)")
+	flag.StringVar(&cfg.ReplicaName, "replica-name", "", "name of this replica in leader election (default: hostname)")
+	etcdEndpoints := flag.String("etcd-endpoints", "", "comma-separated etcd endpoints for HA mode (empty = standalone)")
+	strategy := flag.String("scheduler", "spread", "placement strategy: spread, bin-pack, or random")
+	flag.Parse()
+	if *etcdEndpoints != "" {
+		cfg.EtcdEndpoints = strings.Split(*etcdEndpoints, ",")
+	}
+	scheduler, err := coordinator.NewScheduler(*strategy)
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	cfg.Scheduler = scheduler
+
+	coord, err := coordinator.New(cfg)
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		log.Printf("received %v, shutting down", sig)
+		coord.Stop()
+	}()
+
+	log.Printf("coordinator listening on %s", cfg.Addr)
+	if err := coord.ListenAndServe(); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
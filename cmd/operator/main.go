@@ -0,0 +1,58 @@
+// Command operator runs the Kubernetes controller: it watches JobRun
+// custom resources and reconciles them against jobs on a worker or
+// coordinator, syncing job state back into each JobRun's status.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pmantica4/teleport/pkg/client"
+	"github.com/pmantica4/teleport/pkg/operator"
+)
+
+func main() {
+	var clientCfg client.Config
+	var cfg operator.Config
+	flag.StringVar(&clientCfg.Addr, "addr", "localhost:8443", "worker or coordinator address jobs are started on")
+	flag.StringVar(&clientCfg.CACertFile, "ca-cert", "", "CA certificate used to verify the worker")
+	flag.StringVar(&clientCfg.CertFile, "cert", "", "client TLS certificate")
+	flag.StringVar(&clientCfg.KeyFile, "key", "", "client TLS private key")
+	flag.StringVar(&cfg.APIServer, "kube-api", "", "Kubernetes API base URL (empty = in-cluster)")
+	flag.StringVar(&cfg.TokenFile, "kube-token-file", "", "bearer token file for the API server (empty = service account)")
+	flag.StringVar(&cfg.CAFile, "kube-ca-file", "", "CA certificate for the API server (empty = service account)")
+	flag.StringVar(&cfg.Namespace, "namespace", "", "namespace to watch JobRuns in (empty = the pod's own)")
+	flag.Parse()
+	clientCfg.KeepaliveTime = 30 * time.Second
+
+	c, err := client.New(clientCfg)
+	if err != nil {
+		log.Fatalf("connecting to worker: %v", err)
+	}
+	defer c.Close()
+	cfg.Jobs = c
+
+	op, err := operator.New(cfg)
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		log.Printf("received %v, shutting down", sig)
+		cancel()
+	}()
+
+	log.Printf("operator watching JobRuns")
+	if err := op.Run(ctx); err != nil && ctx.Err() == nil {
+		log.Fatalf("operator: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Command server runs the job worker: an mTLS gRPC service that starts,
+// stops, and streams output from arbitrary Linux commands.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/server"
+)
+
+func main() {
+	var cfg server.Config
+	flag.StringVar(&cfg.Addr, "listen", ":8443", "address to listen on")
+	flag.StringVar(&cfg.HTTPAddr, "http-listen", "", "address for the HTTP/JSON gateway (empty = disabled)")
+	flag.StringVar(&cfg.LogSinkURL, "ship-logs-to", "", "external log sink URL: syslog://tag, loki://host, or http(s):// (empty = disabled)")
+	flag.StringVar(&cfg.EventBusURL, "publish-events-to", "", "message bus URL for job events and output: nats://host:4222 or kafka://host:9092 (empty = disabled)")
+	flag.StringVar(&cfg.CoordinatorAddr, "coordinator", "", "coordinator address to register with (empty = standalone)")
+	flag.StringVar(&cfg.AdvertiseAddr, "advertise-addr", "", "address the coordinator should use to reach this worker")
+	flag.StringVar(&cfg.TunnelAddr, "tunnel-addr", "", "coordinator reverse-tunnel address to dial out to (for workers behind NAT)")
+	flag.StringVar(&cfg.WorkerName, "name", "", "worker name reported to the coordinator (default: hostname)")
+	flag.StringVar(&cfg.CoordinatorCN, "coordinator-cn", "", "certificate CN trusted to forward end-user identities")
+	labels := flag.String("labels", "", "comma-separated key=value labels for scheduling, e.g. gpu=true,zone=a")
+	flag.StringVar(&cfg.CACertFile, "ca-cert", "", "CA certificate used to verify client certificates")
+	flag.StringVar(&cfg.CertFile, "cert", "", "server TLS certificate")
+	flag.StringVar(&cfg.KeyFile, "key", "", "server TLS private key")
+	flag.IntVar(&cfg.MaxRecvMsgSize, "max-recv-msg-size", 0, "max gRPC receive message size in bytes (0 = gRPC default)")
+	flag.IntVar(&cfg.MaxSendMsgSize, "max-send-msg-size", 0, "max gRPC send message size in bytes (0 = gRPC default)")
+	maxStreams := flag.Uint("max-concurrent-streams", 0, "max concurrent streams per connection (0 = gRPC default)")
+	flag.DurationVar(&cfg.KeepaliveTime, "keepalive-time", server.DefaultKeepaliveTime, "interval between server keepalive pings on idle connections")
+	flag.DurationVar(&cfg.KeepaliveTimeout, "keepalive-timeout", server.DefaultKeepaliveTimeout, "time to wait for a keepalive ping ack before closing the connection")
+	flag.DurationVar(&cfg.KeepaliveMinTime, "keepalive-min-time", server.DefaultKeepaliveMinTime, "minimum interval allowed between client keepalive pings")
+	flag.BoolVar(&cfg.KeepalivePermitWithoutStream, "keepalive-permit-without-stream", true, "allow client keepalive pings when no RPCs are active")
+	flag.DurationVar(&cfg.MaxConnectionIdle, "max-connection-idle", 0, "close connections idle for this long (0 = never)")
+	flag.StringVar(&cfg.TemplatesFile, "templates-file", "", "JSON file of named job templates (empty = none)")
+	flag.IntVar(&cfg.OutputSubscriberQueue, "output-subscriber-queue", server.DefaultOutputSubscriberQueue, "per-subscriber output queue depth in lines")
+	flag.StringVar(&cfg.OutputBackpressurePolicy, "output-backpressure", "throttle", "slow output subscriber policy: throttle, drop, or disconnect")
+	flag.StringVar(&cfg.IDScheme, "id-scheme", "uuidv7", "job ID format: uuidv7 or ulid")
+	flag.StringVar(&cfg.WorkspaceRoot, "workspace-root", "", "run each job in its own directory under this root (empty = inherit the server's cwd)")
+	flag.StringVar(&cfg.ArtifactStoreURL, "artifact-store", "", "store for collected job artifacts: file:///path or http(s):// (empty = disabled)")
+	flag.StringVar(&cfg.JobStoreURL, "job-store", "", "SQL database mirroring job metadata: sqlite:/path or postgres:// (empty = disabled; the driver must be compiled in)")
+	flag.Int64Var(&cfg.MaxUploadBytes, "max-upload-bytes", server.DefaultMaxUploadBytes, "total size limit for one input upload (negative = unlimited)")
+	flag.StringVar(&cfg.LimitsFile, "limits-file", "", "JSON file of default resource limits and per-role ceilings (empty = no policy)")
+	flag.StringVar(&cfg.QuotasFile, "quotas-file", "", "JSON file of per-owner consumption quotas per day or month (empty = track only)")
+	flag.StringVar(&cfg.SharesFile, "shares-file", "", "JSON file with the fair-share policy: running-job cap and per-owner shares (empty = no cap)")
+	flag.StringVar(&cfg.MaintenanceFile, "maintenance-file", "", "JSON file of recurring maintenance windows that hold new job starts (empty = none)")
+	flag.StringVar(&cfg.SecretsDir, "secrets-dir", "", "directory file: secret references resolve under (empty = file secrets disabled)")
+	flag.StringVar(&cfg.RedactFile, "redact-file", "", "JSON file of regex rules blanked from captured output (empty = redact only secret values)")
+	flag.BoolVar(&cfg.ExposeIdentity, "expose-identity", false, "expose JOB_ID, JOB_OWNER, and JOB_LABELS to jobs, plus a .job.json workspace file")
+	flag.StringVar(&cfg.DataKey, "data-key", "", "hex key file or env:/vault: reference encrypting persisted logs and artifacts at rest (empty = plaintext)")
+	flag.StringVar(&cfg.AuditFile, "audit-file", "", "file for the hash-chained job audit trail (empty = disabled)")
+	flag.BoolVar(&cfg.LogRPCs, "log-rpcs", false, "log one audit line per completed RPC")
+	flag.Float64Var(&cfg.RPCRateLimit, "rpc-rate-limit", 0, "per-caller RPCs per second (0 = unlimited)")
+	gpus := flag.String("gpus", "", "comma-separated GPU device IDs this worker can allocate, e.g. 0,1 (empty = none)")
+	flag.StringVar(&cfg.Preemption, "preemption", "never", `GPU preemption policy: "never" or "lower-priority" (stop and requeue lower-priority jobs for a higher-priority start)`)
+	flag.StringVar(&cfg.NameCollision, "name-collision", "reject", `what a named start does when the name is already active: "reject", "replace", "suffix", or "allow"`)
+	flag.StringVar(&cfg.GroupsFile, "concurrency-file", "", "JSON file with per-group max parallelism for concurrency groups (empty = one per group)")
+	flag.StringVar(&cfg.NotifyFile, "notify-file", "", "JSON file routing finished-job notifications to email or Slack per owner (empty = per-job targets only)")
+	flag.Parse()
+	cfg.MaxConcurrentStreams = uint32(*maxStreams)
+	if *labels != "" {
+		cfg.Labels = make(map[string]string)
+		for _, kv := range strings.Split(*labels, ",") {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok {
+				log.Fatalf("invalid label %q, want key=value", kv)
+			}
+			cfg.Labels[k] = v
+		}
+	}
+	if *gpus != "" {
+		cfg.GPUs = strings.Split(*gpus, ",")
+	}
+
+	// When running as PID 1 in a container, orphaned job descendants
+	// reparent to us; the subreaper flag plus per-job group reaping keeps
+	// them from accumulating as zombies.
+	if err := job.EnableSubreaper(); err != nil {
+		log.Printf("subreaper not enabled: %v", err)
+	}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		log.Printf("received %v, shutting down", sig)
+		srv.Stop()
+	}()
+
+	log.Printf("job worker listening on %s", cfg.Addr)
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
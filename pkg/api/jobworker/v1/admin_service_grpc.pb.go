@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/jobworker/v1/admin_service.proto
+
+package jobworkerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AdminService_SetAdmission_FullMethodName    = "/jobworker.v1.AdminService/SetAdmission"
+	AdminService_Drain_FullMethodName           = "/jobworker.v1.AdminService/Drain"
+	AdminService_ReloadConfig_FullMethodName    = "/jobworker.v1.AdminService/ReloadConfig"
+	AdminService_DumpDiagnostics_FullMethodName = "/jobworker.v1.AdminService/DumpDiagnostics"
+	AdminService_DebugJob_FullMethodName        = "/jobworker.v1.AdminService/DebugJob"
+	AdminService_VerifyAudit_FullMethodName     = "/jobworker.v1.AdminService/VerifyAudit"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// SetAdmission pauses or resumes job admission. While paused, StartJob
+	// and its variants fail with UNAVAILABLE; running jobs are unaffected.
+	SetAdmission(ctx context.Context, in *SetAdmissionRequest, opts ...grpc.CallOption) (*SetAdmissionResponse, error)
+	// Drain puts the node into drain mode: admission stops and stays
+	// stopped until SetAdmission resumes it, while running jobs finish
+	// normally. The response reports how many are still running so callers
+	// can poll for zero before taking the node down.
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	// ReloadConfig re-reads the reloadable configuration from disk: the
+	// server certificate and key, and the template, limit, and quota policy
+	// files. The listen address and CA bundle still require a restart.
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	// DumpDiagnostics returns internal state for debugging: a full
+	// goroutine dump plus job-table and memory counters.
+	DumpDiagnostics(ctx context.Context, in *DumpDiagnosticsRequest, opts ...grpc.CallOption) (*DumpDiagnosticsResponse, error)
+	// DebugJob attaches a diagnostic tool (strace or perf) to a running
+	// job's process for a bounded time and stores the capture as a job
+	// artifact, retrievable with GetArtifact. Requires an artifact store.
+	DebugJob(ctx context.Context, in *DebugJobRequest, opts ...grpc.CallOption) (*DebugJobResponse, error)
+	// VerifyAudit re-checks the hash chain of the on-disk audit trail and
+	// fails with FAILED_PRECONDITION if any entry was altered, removed, or
+	// truncated. Requires the server to run with an audit file.
+	VerifyAudit(ctx context.Context, in *VerifyAuditRequest, opts ...grpc.CallOption) (*VerifyAuditResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SetAdmission(ctx context.Context, in *SetAdmissionRequest, opts ...grpc.CallOption) (*SetAdmissionResponse, error) {
+	out := new(SetAdmissionResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetAdmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, AdminService_Drain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReloadConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DumpDiagnostics(ctx context.Context, in *DumpDiagnosticsRequest, opts ...grpc.CallOption) (*DumpDiagnosticsResponse, error) {
+	out := new(DumpDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, AdminService_DumpDiagnostics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DebugJob(ctx context.Context, in *DebugJobRequest, opts ...grpc.CallOption) (*DebugJobResponse, error) {
+	out := new(DebugJobResponse)
+	err := c.cc.Invoke(ctx, AdminService_DebugJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) VerifyAudit(ctx context.Context, in *VerifyAuditRequest, opts ...grpc.CallOption) (*VerifyAuditResponse, error) {
+	out := new(VerifyAuditResponse)
+	err := c.cc.Invoke(ctx, AdminService_VerifyAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// SetAdmission pauses or resumes job admission. While paused, StartJob
+	// and its variants fail with UNAVAILABLE; running jobs are unaffected.
+	SetAdmission(context.Context, *SetAdmissionRequest) (*SetAdmissionResponse, error)
+	// Drain puts the node into drain mode: admission stops and stays
+	// stopped until SetAdmission resumes it, while running jobs finish
+	// normally. The response reports how many are still running so callers
+	// can poll for zero before taking the node down.
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	// ReloadConfig re-reads the reloadable configuration from disk: the
+	// server certificate and key, and the template, limit, and quota policy
+	// files. The listen address and CA bundle still require a restart.
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	// DumpDiagnostics returns internal state for debugging: a full
+	// goroutine dump plus job-table and memory counters.
+	DumpDiagnostics(context.Context, *DumpDiagnosticsRequest) (*DumpDiagnosticsResponse, error)
+	// DebugJob attaches a diagnostic tool (strace or perf) to a running
+	// job's process for a bounded time and stores the capture as a job
+	// artifact, retrievable with GetArtifact. Requires an artifact store.
+	DebugJob(context.Context, *DebugJobRequest) (*DebugJobResponse, error)
+	// VerifyAudit re-checks the hash chain of the on-disk audit trail and
+	// fails with FAILED_PRECONDITION if any entry was altered, removed, or
+	// truncated. Requires the server to run with an audit file.
+	VerifyAudit(context.Context, *VerifyAuditRequest) (*VerifyAuditResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) SetAdmission(context.Context, *SetAdmissionRequest) (*SetAdmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdmission not implemented")
+}
+func (UnimplementedAdminServiceServer) Drain(context.Context, *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedAdminServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) DumpDiagnostics(context.Context, *DumpDiagnosticsRequest) (*DumpDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpDiagnostics not implemented")
+}
+func (UnimplementedAdminServiceServer) DebugJob(context.Context, *DebugJobRequest) (*DebugJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugJob not implemented")
+}
+func (UnimplementedAdminServiceServer) VerifyAudit(context.Context, *VerifyAuditRequest) (*VerifyAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAudit not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SetAdmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetAdmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetAdmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetAdmission(ctx, req.(*SetAdmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DumpDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DumpDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DumpDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DumpDiagnostics(ctx, req.(*DumpDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DebugJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DebugJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DebugJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DebugJob(ctx, req.(*DebugJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_VerifyAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyAudit(ctx, req.(*VerifyAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jobworker.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAdmission",
+			Handler:    _AdminService_SetAdmission_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _AdminService_Drain_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _AdminService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "DumpDiagnostics",
+			Handler:    _AdminService_DumpDiagnostics_Handler,
+		},
+		{
+			MethodName: "DebugJob",
+			Handler:    _AdminService_DebugJob_Handler,
+		},
+		{
+			MethodName: "VerifyAudit",
+			Handler:    _AdminService_VerifyAudit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/jobworker/v1/admin_service.proto",
+}
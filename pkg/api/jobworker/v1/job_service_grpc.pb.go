@@ -0,0 +1,1933 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/jobworker/v1/job_service.proto
+
+package jobworkerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	JobService_StartJob_FullMethodName              = "/jobworker.v1.JobService/StartJob"
+	JobService_StartBatch_FullMethodName            = "/jobworker.v1.JobService/StartBatch"
+	JobService_StartPipeline_FullMethodName         = "/jobworker.v1.JobService/StartPipeline"
+	JobService_GetPipeline_FullMethodName           = "/jobworker.v1.JobService/GetPipeline"
+	JobService_StopPipeline_FullMethodName          = "/jobworker.v1.JobService/StopPipeline"
+	JobService_RunTemplate_FullMethodName           = "/jobworker.v1.JobService/RunTemplate"
+	JobService_StartSweep_FullMethodName            = "/jobworker.v1.JobService/StartSweep"
+	JobService_GetSweep_FullMethodName              = "/jobworker.v1.JobService/GetSweep"
+	JobService_StopSweep_FullMethodName             = "/jobworker.v1.JobService/StopSweep"
+	JobService_ListTemplates_FullMethodName         = "/jobworker.v1.JobService/ListTemplates"
+	JobService_StopJob_FullMethodName               = "/jobworker.v1.JobService/StopJob"
+	JobService_BatchStop_FullMethodName             = "/jobworker.v1.JobService/BatchStop"
+	JobService_PauseJob_FullMethodName              = "/jobworker.v1.JobService/PauseJob"
+	JobService_ResumeJob_FullMethodName             = "/jobworker.v1.JobService/ResumeJob"
+	JobService_GetJobStatus_FullMethodName          = "/jobworker.v1.JobService/GetJobStatus"
+	JobService_GetHistory_FullMethodName            = "/jobworker.v1.JobService/GetHistory"
+	JobService_GetRunStats_FullMethodName           = "/jobworker.v1.JobService/GetRunStats"
+	JobService_GetQuotaUsage_FullMethodName         = "/jobworker.v1.JobService/GetQuotaUsage"
+	JobService_GetMaintenance_FullMethodName        = "/jobworker.v1.JobService/GetMaintenance"
+	JobService_WaitJob_FullMethodName               = "/jobworker.v1.JobService/WaitJob"
+	JobService_ListJobs_FullMethodName              = "/jobworker.v1.JobService/ListJobs"
+	JobService_ListJobHistory_FullMethodName        = "/jobworker.v1.JobService/ListJobHistory"
+	JobService_GetOutput_FullMethodName             = "/jobworker.v1.JobService/GetOutput"
+	JobService_StreamOutput_FullMethodName          = "/jobworker.v1.JobService/StreamOutput"
+	JobService_SearchOutput_FullMethodName          = "/jobworker.v1.JobService/SearchOutput"
+	JobService_AnnotateJob_FullMethodName           = "/jobworker.v1.JobService/AnnotateJob"
+	JobService_GetJobManifest_FullMethodName        = "/jobworker.v1.JobService/GetJobManifest"
+	JobService_RerunJob_FullMethodName              = "/jobworker.v1.JobService/RerunJob"
+	JobService_GetVersion_FullMethodName            = "/jobworker.v1.JobService/GetVersion"
+	JobService_GetDiskUsage_FullMethodName          = "/jobworker.v1.JobService/GetDiskUsage"
+	JobService_ListArtifacts_FullMethodName         = "/jobworker.v1.JobService/ListArtifacts"
+	JobService_DownloadArtifact_FullMethodName      = "/jobworker.v1.JobService/DownloadArtifact"
+	JobService_UploadInput_FullMethodName           = "/jobworker.v1.JobService/UploadInput"
+	JobService_WriteStdin_FullMethodName            = "/jobworker.v1.JobService/WriteStdin"
+	JobService_ImportJob_FullMethodName             = "/jobworker.v1.JobService/ImportJob"
+	JobService_ListNodes_FullMethodName             = "/jobworker.v1.JobService/ListNodes"
+	JobService_GetNode_FullMethodName               = "/jobworker.v1.JobService/GetNode"
+	JobService_WatchJobs_FullMethodName             = "/jobworker.v1.JobService/WatchJobs"
+	JobService_RegisterWebhook_FullMethodName       = "/jobworker.v1.JobService/RegisterWebhook"
+	JobService_UnregisterWebhook_FullMethodName     = "/jobworker.v1.JobService/UnregisterWebhook"
+	JobService_ListWebhookDeliveries_FullMethodName = "/jobworker.v1.JobService/ListWebhookDeliveries"
+)
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	// StartJob launches a new job from the given command specification.
+	StartJob(ctx context.Context, in *StartJobRequest, opts ...grpc.CallOption) (*StartJobResponse, error)
+	// StartBatch launches several jobs in one call, either best-effort with
+	// per-job errors or atomically.
+	StartBatch(ctx context.Context, in *StartBatchRequest, opts ...grpc.CallOption) (*StartBatchResponse, error)
+	// StartPipeline submits an ordered or DAG-structured set of stages
+	// under one pipeline ID. A stage starts once every stage it needs has
+	// completed; the failure policy decides whether a failure stops the
+	// rest (fail-fast, the default) or only the stages downstream of it
+	// (continue).
+	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*StartPipelineResponse, error)
+	// GetPipeline returns a pipeline's aggregate status and the state and
+	// job ID of each stage.
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error)
+	// StopPipeline stops a pipeline's running stages and skips the ones
+	// not yet started.
+	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*StopPipelineResponse, error)
+	// RunTemplate starts a job from a named server-side template after
+	// validating the caller's parameters, a safer alternative to free-form
+	// commands.
+	RunTemplate(ctx context.Context, in *RunTemplateRequest, opts ...grpc.CallOption) (*RunTemplateResponse, error)
+	// StartSweep expands a template over a parameter matrix and starts one
+	// child job per combination, all under a single sweep ID. Matrix
+	// values are comma-separated lists or inclusive integer ranges like
+	// 0..9.
+	StartSweep(ctx context.Context, in *StartSweepRequest, opts ...grpc.CallOption) (*StartSweepResponse, error)
+	// GetSweep reports a sweep's aggregate progress and the state of each
+	// child job.
+	GetSweep(ctx context.Context, in *GetSweepRequest, opts ...grpc.CallOption) (*GetSweepResponse, error)
+	// StopSweep stops every still-running child of a sweep.
+	StopSweep(ctx context.Context, in *StopSweepRequest, opts ...grpc.CallOption) (*StopSweepResponse, error)
+	// ListTemplates enumerates the templates registered on the server.
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// StopJob requests termination of a running job. Stopping an already
+	// finished job is an error.
+	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*StopJobResponse, error)
+	// BatchStop stops every job of the caller's matching a selector in one
+	// call and reports the outcome per job. At least one selector (labels,
+	// status, or the explicit all flag) is required.
+	BatchStop(ctx context.Context, in *BatchStopRequest, opts ...grpc.CallOption) (*BatchStopResponse, error)
+	// PauseJob suspends a running job's process group with SIGSTOP; the job
+	// keeps its resources and can be resumed or stopped.
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error)
+	// ResumeJob continues a paused job's process group with SIGCONT.
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
+	// GetJobStatus returns the current state of a single job.
+	GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*GetJobStatusResponse, error)
+	// GetHistory returns the job's state-transition timeline: every status
+	// change with its timestamp and cause, for post-mortems.
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// GetRunStats aggregates the caller's runs into per-group statistics —
+	// success rate and duration percentiles — grouped by job name or
+	// command, so repeated runs of the same task can be compared.
+	GetRunStats(ctx context.Context, in *GetRunStatsRequest, opts ...grpc.CallOption) (*GetRunStatsResponse, error)
+	// GetQuotaUsage reports cumulative resource consumption per owner —
+	// jobs started, CPU seconds burned, log bytes captured — in the current
+	// accounting window, with the configured quota when one is enforced.
+	// Callers see their own usage; the "admin" role sees every owner's.
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error)
+	// GetMaintenance reports whether a maintenance window is currently
+	// open. While one is, non-exempt starts are held until it closes.
+	GetMaintenance(ctx context.Context, in *GetMaintenanceRequest, opts ...grpc.CallOption) (*GetMaintenanceResponse, error)
+	// WaitJob blocks until the job reaches a terminal state, or the optional
+	// timeout elapses, and returns the final job including its exit code.
+	WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error)
+	// ListJobs enumerates jobs visible to the caller.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// ListJobHistory lists the caller's jobs from the worker's SQL job
+	// store, which outlives restarts, filterable by status, labels, and
+	// start time. Requires the worker to run with a job store configured.
+	ListJobHistory(ctx context.Context, in *ListJobHistoryRequest, opts ...grpc.CallOption) (*ListJobHistoryResponse, error)
+	// GetOutput returns the output captured so far for a job.
+	GetOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error)
+	// StreamOutput streams a job's output from the beginning, then follows
+	// new output until the job finishes or the caller cancels.
+	StreamOutput(ctx context.Context, in *StreamOutputRequest, opts ...grpc.CallOption) (JobService_StreamOutputClient, error)
+	// SearchOutput greps a job's captured output server-side, avoiding
+	// shipping large logs to the client.
+	SearchOutput(ctx context.Context, in *SearchOutputRequest, opts ...grpc.CallOption) (*SearchOutputResponse, error)
+	// AnnotateJob appends an owner-written marker line to a running job's
+	// output timeline, e.g. "deploy step 2 started", stored inline with
+	// the captured output and tagged with the "marker" stream.
+	AnnotateJob(ctx context.Context, in *AnnotateJobRequest, opts ...grpc.CallOption) (*AnnotateJobResponse, error)
+	// GetJobManifest returns the job's reproducibility record: the
+	// resolved environment, working directory, host and kernel, and the
+	// execution limits the worker applied. Secret env values appear
+	// redacted.
+	GetJobManifest(ctx context.Context, in *GetJobManifestRequest, opts ...grpc.CallOption) (*GetJobManifestResponse, error)
+	// RerunJob starts a new job from the recorded start request of an
+	// existing one, re-entering the normal StartJob path: limits, quotas,
+	// and secret resolution all apply afresh. Staged uploads are not
+	// re-staged, and the rerun never reuses a cached result. Individual
+	// parameters can be overridden via set and append_args, so a failing
+	// job can be iterated on without retyping its command line.
+	RerunJob(ctx context.Context, in *RerunJobRequest, opts ...grpc.CallOption) (*StartJobResponse, error)
+	// GetVersion reports the server's build identity and supported API
+	// capabilities.
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// GetDiskUsage reports how much disk persisted job logs use against the
+	// configured budget. On a coordinator the totals span all live workers.
+	GetDiskUsage(ctx context.Context, in *GetDiskUsageRequest, opts ...grpc.CallOption) (*GetDiskUsageResponse, error)
+	// ListArtifacts enumerates the result files collected when the job
+	// finished.
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams one collected artifact's contents.
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (JobService_DownloadArtifactClient, error)
+	// UploadInput stages input files for a job before it starts. Each file
+	// opens with an UploadFile header followed by its data chunks; the
+	// returned upload ID is passed to StartJob, which places the files in
+	// the job's workspace. Uploads are scoped to the caller and expire if
+	// unused.
+	UploadInput(ctx context.Context, opts ...grpc.CallOption) (JobService_UploadInputClient, error)
+	// WriteStdin streams bytes into a running job's standard input. The
+	// job's stdin is closed when the stream ends, so `cat file | cli stdin
+	// <id>` delivers an EOF the way a shell pipe would.
+	WriteStdin(ctx context.Context, opts ...grpc.CallOption) (JobService_WriteStdinClient, error)
+	// ImportJob registers a finished job exported from another server — its
+	// spec, final status, and captured output — so reproductions can move
+	// between environments. The caller becomes the owner and a fresh job ID
+	// is issued.
+	ImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error)
+	// ListNodes reports the worker-node inventory: labels, liveness, and
+	// current load. A standalone worker reports itself.
+	ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error)
+	// GetNode reports one node by name, alive or not.
+	GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
+	// WatchJobs streams job state-change events for the caller's jobs,
+	// optionally filtered to a single job, until the caller cancels.
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (JobService_WatchJobsClient, error)
+	// RegisterWebhook registers a URL to receive signed JSON notifications
+	// on state changes of the caller's jobs.
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	// UnregisterWebhook removes a webhook previously registered by the
+	// caller.
+	UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookResponse, error)
+	// ListWebhookDeliveries reports the delivery history of the caller's
+	// webhooks.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) StartJob(ctx context.Context, in *StartJobRequest, opts ...grpc.CallOption) (*StartJobResponse, error) {
+	out := new(StartJobResponse)
+	err := c.cc.Invoke(ctx, JobService_StartJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StartBatch(ctx context.Context, in *StartBatchRequest, opts ...grpc.CallOption) (*StartBatchResponse, error) {
+	out := new(StartBatchResponse)
+	err := c.cc.Invoke(ctx, JobService_StartBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*StartPipelineResponse, error) {
+	out := new(StartPipelineResponse)
+	err := c.cc.Invoke(ctx, JobService_StartPipeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error) {
+	out := new(GetPipelineResponse)
+	err := c.cc.Invoke(ctx, JobService_GetPipeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*StopPipelineResponse, error) {
+	out := new(StopPipelineResponse)
+	err := c.cc.Invoke(ctx, JobService_StopPipeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RunTemplate(ctx context.Context, in *RunTemplateRequest, opts ...grpc.CallOption) (*RunTemplateResponse, error) {
+	out := new(RunTemplateResponse)
+	err := c.cc.Invoke(ctx, JobService_RunTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StartSweep(ctx context.Context, in *StartSweepRequest, opts ...grpc.CallOption) (*StartSweepResponse, error) {
+	out := new(StartSweepResponse)
+	err := c.cc.Invoke(ctx, JobService_StartSweep_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetSweep(ctx context.Context, in *GetSweepRequest, opts ...grpc.CallOption) (*GetSweepResponse, error) {
+	out := new(GetSweepResponse)
+	err := c.cc.Invoke(ctx, JobService_GetSweep_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StopSweep(ctx context.Context, in *StopSweepRequest, opts ...grpc.CallOption) (*StopSweepResponse, error) {
+	out := new(StopSweepResponse)
+	err := c.cc.Invoke(ctx, JobService_StopSweep_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*StopJobResponse, error) {
+	out := new(StopJobResponse)
+	err := c.cc.Invoke(ctx, JobService_StopJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) BatchStop(ctx context.Context, in *BatchStopRequest, opts ...grpc.CallOption) (*BatchStopResponse, error) {
+	out := new(BatchStopResponse)
+	err := c.cc.Invoke(ctx, JobService_BatchStop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error) {
+	out := new(PauseJobResponse)
+	err := c.cc.Invoke(ctx, JobService_PauseJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ResumeJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*GetJobStatusResponse, error) {
+	out := new(GetJobStatusResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, JobService_GetHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetRunStats(ctx context.Context, in *GetRunStatsRequest, opts ...grpc.CallOption) (*GetRunStatsResponse, error) {
+	out := new(GetRunStatsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetRunStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*GetQuotaUsageResponse, error) {
+	out := new(GetQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, JobService_GetQuotaUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetMaintenance(ctx context.Context, in *GetMaintenanceRequest, opts ...grpc.CallOption) (*GetMaintenanceResponse, error) {
+	out := new(GetMaintenanceResponse)
+	err := c.cc.Invoke(ctx, JobService_GetMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error) {
+	out := new(WaitJobResponse)
+	err := c.cc.Invoke(ctx, JobService_WaitJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobHistory(ctx context.Context, in *ListJobHistoryRequest, opts ...grpc.CallOption) (*ListJobHistoryResponse, error) {
+	out := new(ListJobHistoryResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetOutput(ctx context.Context, in *GetOutputRequest, opts ...grpc.CallOption) (*GetOutputResponse, error) {
+	out := new(GetOutputResponse)
+	err := c.cc.Invoke(ctx, JobService_GetOutput_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StreamOutput(ctx context.Context, in *StreamOutputRequest, opts ...grpc.CallOption) (JobService_StreamOutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[0], JobService_StreamOutput_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceStreamOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_StreamOutputClient interface {
+	Recv() (*OutputChunk, error)
+	grpc.ClientStream
+}
+
+type jobServiceStreamOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceStreamOutputClient) Recv() (*OutputChunk, error) {
+	m := new(OutputChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) SearchOutput(ctx context.Context, in *SearchOutputRequest, opts ...grpc.CallOption) (*SearchOutputResponse, error) {
+	out := new(SearchOutputResponse)
+	err := c.cc.Invoke(ctx, JobService_SearchOutput_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AnnotateJob(ctx context.Context, in *AnnotateJobRequest, opts ...grpc.CallOption) (*AnnotateJobResponse, error) {
+	out := new(AnnotateJobResponse)
+	err := c.cc.Invoke(ctx, JobService_AnnotateJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobManifest(ctx context.Context, in *GetJobManifestRequest, opts ...grpc.CallOption) (*GetJobManifestResponse, error) {
+	out := new(GetJobManifestResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RerunJob(ctx context.Context, in *RerunJobRequest, opts ...grpc.CallOption) (*StartJobResponse, error) {
+	out := new(StartJobResponse)
+	err := c.cc.Invoke(ctx, JobService_RerunJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, JobService_GetVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetDiskUsage(ctx context.Context, in *GetDiskUsageRequest, opts ...grpc.CallOption) (*GetDiskUsageResponse, error) {
+	out := new(GetDiskUsageResponse)
+	err := c.cc.Invoke(ctx, JobService_GetDiskUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error) {
+	out := new(ListArtifactsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (JobService_DownloadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[1], JobService_DownloadArtifact_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceDownloadArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_DownloadArtifactClient interface {
+	Recv() (*ArtifactChunk, error)
+	grpc.ClientStream
+}
+
+type jobServiceDownloadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceDownloadArtifactClient) Recv() (*ArtifactChunk, error) {
+	m := new(ArtifactChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) UploadInput(ctx context.Context, opts ...grpc.CallOption) (JobService_UploadInputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[2], JobService_UploadInput_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceUploadInputClient{stream}
+	return x, nil
+}
+
+type JobService_UploadInputClient interface {
+	Send(*UploadInputRequest) error
+	CloseAndRecv() (*UploadInputResponse, error)
+	grpc.ClientStream
+}
+
+type jobServiceUploadInputClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceUploadInputClient) Send(m *UploadInputRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jobServiceUploadInputClient) CloseAndRecv() (*UploadInputResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadInputResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) WriteStdin(ctx context.Context, opts ...grpc.CallOption) (JobService_WriteStdinClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[3], JobService_WriteStdin_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceWriteStdinClient{stream}
+	return x, nil
+}
+
+type JobService_WriteStdinClient interface {
+	Send(*WriteStdinRequest) error
+	CloseAndRecv() (*WriteStdinResponse, error)
+	grpc.ClientStream
+}
+
+type jobServiceWriteStdinClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceWriteStdinClient) Send(m *WriteStdinRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jobServiceWriteStdinClient) CloseAndRecv() (*WriteStdinResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteStdinResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) ImportJob(ctx context.Context, in *ImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error) {
+	out := new(ImportJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ImportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error) {
+	out := new(ListNodesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListNodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error) {
+	out := new(GetNodeResponse)
+	err := c.cc.Invoke(ctx, JobService_GetNode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (JobService_WatchJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[4], JobService_WatchJobs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceWatchJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_WatchJobsClient interface {
+	Recv() (*JobEvent, error)
+	grpc.ClientStream
+}
+
+type jobServiceWatchJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceWatchJobsClient) Recv() (*JobEvent, error) {
+	m := new(JobEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, JobService_RegisterWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookResponse, error) {
+	out := new(UnregisterWebhookResponse)
+	err := c.cc.Invoke(ctx, JobService_UnregisterWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility
+type JobServiceServer interface {
+	// StartJob launches a new job from the given command specification.
+	StartJob(context.Context, *StartJobRequest) (*StartJobResponse, error)
+	// StartBatch launches several jobs in one call, either best-effort with
+	// per-job errors or atomically.
+	StartBatch(context.Context, *StartBatchRequest) (*StartBatchResponse, error)
+	// StartPipeline submits an ordered or DAG-structured set of stages
+	// under one pipeline ID. A stage starts once every stage it needs has
+	// completed; the failure policy decides whether a failure stops the
+	// rest (fail-fast, the default) or only the stages downstream of it
+	// (continue).
+	StartPipeline(context.Context, *StartPipelineRequest) (*StartPipelineResponse, error)
+	// GetPipeline returns a pipeline's aggregate status and the state and
+	// job ID of each stage.
+	GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error)
+	// StopPipeline stops a pipeline's running stages and skips the ones
+	// not yet started.
+	StopPipeline(context.Context, *StopPipelineRequest) (*StopPipelineResponse, error)
+	// RunTemplate starts a job from a named server-side template after
+	// validating the caller's parameters, a safer alternative to free-form
+	// commands.
+	RunTemplate(context.Context, *RunTemplateRequest) (*RunTemplateResponse, error)
+	// StartSweep expands a template over a parameter matrix and starts one
+	// child job per combination, all under a single sweep ID. Matrix
+	// values are comma-separated lists or inclusive integer ranges like
+	// 0..9.
+	StartSweep(context.Context, *StartSweepRequest) (*StartSweepResponse, error)
+	// GetSweep reports a sweep's aggregate progress and the state of each
+	// child job.
+	GetSweep(context.Context, *GetSweepRequest) (*GetSweepResponse, error)
+	// StopSweep stops every still-running child of a sweep.
+	StopSweep(context.Context, *StopSweepRequest) (*StopSweepResponse, error)
+	// ListTemplates enumerates the templates registered on the server.
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// StopJob requests termination of a running job. Stopping an already
+	// finished job is an error.
+	StopJob(context.Context, *StopJobRequest) (*StopJobResponse, error)
+	// BatchStop stops every job of the caller's matching a selector in one
+	// call and reports the outcome per job. At least one selector (labels,
+	// status, or the explicit all flag) is required.
+	BatchStop(context.Context, *BatchStopRequest) (*BatchStopResponse, error)
+	// PauseJob suspends a running job's process group with SIGSTOP; the job
+	// keeps its resources and can be resumed or stopped.
+	PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error)
+	// ResumeJob continues a paused job's process group with SIGCONT.
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
+	// GetJobStatus returns the current state of a single job.
+	GetJobStatus(context.Context, *GetJobStatusRequest) (*GetJobStatusResponse, error)
+	// GetHistory returns the job's state-transition timeline: every status
+	// change with its timestamp and cause, for post-mortems.
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// GetRunStats aggregates the caller's runs into per-group statistics —
+	// success rate and duration percentiles — grouped by job name or
+	// command, so repeated runs of the same task can be compared.
+	GetRunStats(context.Context, *GetRunStatsRequest) (*GetRunStatsResponse, error)
+	// GetQuotaUsage reports cumulative resource consumption per owner —
+	// jobs started, CPU seconds burned, log bytes captured — in the current
+	// accounting window, with the configured quota when one is enforced.
+	// Callers see their own usage; the "admin" role sees every owner's.
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error)
+	// GetMaintenance reports whether a maintenance window is currently
+	// open. While one is, non-exempt starts are held until it closes.
+	GetMaintenance(context.Context, *GetMaintenanceRequest) (*GetMaintenanceResponse, error)
+	// WaitJob blocks until the job reaches a terminal state, or the optional
+	// timeout elapses, and returns the final job including its exit code.
+	WaitJob(context.Context, *WaitJobRequest) (*WaitJobResponse, error)
+	// ListJobs enumerates jobs visible to the caller.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// ListJobHistory lists the caller's jobs from the worker's SQL job
+	// store, which outlives restarts, filterable by status, labels, and
+	// start time. Requires the worker to run with a job store configured.
+	ListJobHistory(context.Context, *ListJobHistoryRequest) (*ListJobHistoryResponse, error)
+	// GetOutput returns the output captured so far for a job.
+	GetOutput(context.Context, *GetOutputRequest) (*GetOutputResponse, error)
+	// StreamOutput streams a job's output from the beginning, then follows
+	// new output until the job finishes or the caller cancels.
+	StreamOutput(*StreamOutputRequest, JobService_StreamOutputServer) error
+	// SearchOutput greps a job's captured output server-side, avoiding
+	// shipping large logs to the client.
+	SearchOutput(context.Context, *SearchOutputRequest) (*SearchOutputResponse, error)
+	// AnnotateJob appends an owner-written marker line to a running job's
+	// output timeline, e.g. "deploy step 2 started", stored inline with
+	// the captured output and tagged with the "marker" stream.
+	AnnotateJob(context.Context, *AnnotateJobRequest) (*AnnotateJobResponse, error)
+	// GetJobManifest returns the job's reproducibility record: the
+	// resolved environment, working directory, host and kernel, and the
+	// execution limits the worker applied. Secret env values appear
+	// redacted.
+	GetJobManifest(context.Context, *GetJobManifestRequest) (*GetJobManifestResponse, error)
+	// RerunJob starts a new job from the recorded start request of an
+	// existing one, re-entering the normal StartJob path: limits, quotas,
+	// and secret resolution all apply afresh. Staged uploads are not
+	// re-staged, and the rerun never reuses a cached result. Individual
+	// parameters can be overridden via set and append_args, so a failing
+	// job can be iterated on without retyping its command line.
+	RerunJob(context.Context, *RerunJobRequest) (*StartJobResponse, error)
+	// GetVersion reports the server's build identity and supported API
+	// capabilities.
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// GetDiskUsage reports how much disk persisted job logs use against the
+	// configured budget. On a coordinator the totals span all live workers.
+	GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageResponse, error)
+	// ListArtifacts enumerates the result files collected when the job
+	// finished.
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams one collected artifact's contents.
+	DownloadArtifact(*DownloadArtifactRequest, JobService_DownloadArtifactServer) error
+	// UploadInput stages input files for a job before it starts. Each file
+	// opens with an UploadFile header followed by its data chunks; the
+	// returned upload ID is passed to StartJob, which places the files in
+	// the job's workspace. Uploads are scoped to the caller and expire if
+	// unused.
+	UploadInput(JobService_UploadInputServer) error
+	// WriteStdin streams bytes into a running job's standard input. The
+	// job's stdin is closed when the stream ends, so `cat file | cli stdin
+	// <id>` delivers an EOF the way a shell pipe would.
+	WriteStdin(JobService_WriteStdinServer) error
+	// ImportJob registers a finished job exported from another server — its
+	// spec, final status, and captured output — so reproductions can move
+	// between environments. The caller becomes the owner and a fresh job ID
+	// is issued.
+	ImportJob(context.Context, *ImportJobRequest) (*ImportJobResponse, error)
+	// ListNodes reports the worker-node inventory: labels, liveness, and
+	// current load. A standalone worker reports itself.
+	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
+	// GetNode reports one node by name, alive or not.
+	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
+	// WatchJobs streams job state-change events for the caller's jobs,
+	// optionally filtered to a single job, until the caller cancels.
+	WatchJobs(*WatchJobsRequest, JobService_WatchJobsServer) error
+	// RegisterWebhook registers a URL to receive signed JSON notifications
+	// on state changes of the caller's jobs.
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	// UnregisterWebhook removes a webhook previously registered by the
+	// caller.
+	UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookResponse, error)
+	// ListWebhookDeliveries reports the delivery history of the caller's
+	// webhooks.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJobServiceServer struct {
+}
+
+func (UnimplementedJobServiceServer) StartJob(context.Context, *StartJobRequest) (*StartJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartJob not implemented")
+}
+func (UnimplementedJobServiceServer) StartBatch(context.Context, *StartBatchRequest) (*StartBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatch not implemented")
+}
+func (UnimplementedJobServiceServer) StartPipeline(context.Context, *StartPipelineRequest) (*StartPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPipeline not implemented")
+}
+func (UnimplementedJobServiceServer) GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (UnimplementedJobServiceServer) StopPipeline(context.Context, *StopPipelineRequest) (*StopPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPipeline not implemented")
+}
+func (UnimplementedJobServiceServer) RunTemplate(context.Context, *RunTemplateRequest) (*RunTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTemplate not implemented")
+}
+func (UnimplementedJobServiceServer) StartSweep(context.Context, *StartSweepRequest) (*StartSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSweep not implemented")
+}
+func (UnimplementedJobServiceServer) GetSweep(context.Context, *GetSweepRequest) (*GetSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSweep not implemented")
+}
+func (UnimplementedJobServiceServer) StopSweep(context.Context, *StopSweepRequest) (*StopSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSweep not implemented")
+}
+func (UnimplementedJobServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedJobServiceServer) StopJob(context.Context, *StopJobRequest) (*StopJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopJob not implemented")
+}
+func (UnimplementedJobServiceServer) BatchStop(context.Context, *BatchStopRequest) (*BatchStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStop not implemented")
+}
+func (UnimplementedJobServiceServer) PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedJobServiceServer) ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobStatus(context.Context, *GetJobStatusRequest) (*GetJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedJobServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedJobServiceServer) GetRunStats(context.Context, *GetRunStatsRequest) (*GetRunStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunStats not implemented")
+}
+func (UnimplementedJobServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*GetQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedJobServiceServer) GetMaintenance(context.Context, *GetMaintenanceRequest) (*GetMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenance not implemented")
+}
+func (UnimplementedJobServiceServer) WaitJob(context.Context, *WaitJobRequest) (*WaitJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitJob not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobHistory(context.Context, *ListJobHistoryRequest) (*ListJobHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobHistory not implemented")
+}
+func (UnimplementedJobServiceServer) GetOutput(context.Context, *GetOutputRequest) (*GetOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutput not implemented")
+}
+func (UnimplementedJobServiceServer) StreamOutput(*StreamOutputRequest, JobService_StreamOutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOutput not implemented")
+}
+func (UnimplementedJobServiceServer) SearchOutput(context.Context, *SearchOutputRequest) (*SearchOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOutput not implemented")
+}
+func (UnimplementedJobServiceServer) AnnotateJob(context.Context, *AnnotateJobRequest) (*AnnotateJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobManifest(context.Context, *GetJobManifestRequest) (*GetJobManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobManifest not implemented")
+}
+func (UnimplementedJobServiceServer) RerunJob(context.Context, *RerunJobRequest) (*StartJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedJobServiceServer) GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskUsage not implemented")
+}
+func (UnimplementedJobServiceServer) ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedJobServiceServer) DownloadArtifact(*DownloadArtifactRequest, JobService_DownloadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
+func (UnimplementedJobServiceServer) UploadInput(JobService_UploadInputServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadInput not implemented")
+}
+func (UnimplementedJobServiceServer) WriteStdin(JobService_WriteStdinServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStdin not implemented")
+}
+func (UnimplementedJobServiceServer) ImportJob(context.Context, *ImportJobRequest) (*ImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportJob not implemented")
+}
+func (UnimplementedJobServiceServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodes not implemented")
+}
+func (UnimplementedJobServiceServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
+}
+func (UnimplementedJobServiceServer) WatchJobs(*WatchJobsRequest, JobService_WatchJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (UnimplementedJobServiceServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedJobServiceServer) UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterWebhook not implemented")
+}
+func (UnimplementedJobServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_StartJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StartJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StartJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StartJob(ctx, req.(*StartJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StartBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StartBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StartBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StartBatch(ctx, req.(*StartBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StartPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StartPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StartPipeline(ctx, req.(*StartPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StopPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StopPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StopPipeline(ctx, req.(*StopPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RunTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RunTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RunTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RunTemplate(ctx, req.(*RunTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StartSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StartSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StartSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StartSweep(ctx, req.(*StartSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetSweep(ctx, req.(*GetSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StopSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StopSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StopSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StopSweep(ctx, req.(*StopSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).StopJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_StopJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).StopJob(ctx, req.(*StopJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_BatchStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).BatchStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_BatchStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).BatchStop(ctx, req.(*BatchStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobStatus(ctx, req.(*GetJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetRunStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetRunStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetRunStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetRunStats(ctx, req.(*GetRunStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetMaintenance(ctx, req.(*GetMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_WaitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).WaitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_WaitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).WaitJob(ctx, req.(*WaitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobHistory(ctx, req.(*ListJobHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetOutput(ctx, req.(*GetOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StreamOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).StreamOutput(m, &jobServiceStreamOutputServer{stream})
+}
+
+type JobService_StreamOutputServer interface {
+	Send(*OutputChunk) error
+	grpc.ServerStream
+}
+
+type jobServiceStreamOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceStreamOutputServer) Send(m *OutputChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_SearchOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SearchOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SearchOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SearchOutput(ctx, req.(*SearchOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AnnotateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AnnotateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_AnnotateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AnnotateJob(ctx, req.(*AnnotateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobManifest(ctx, req.(*GetJobManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RerunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RerunJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RerunJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RerunJob(ctx, req.(*RerunJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetDiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetDiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetDiskUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetDiskUsage(ctx, req.(*GetDiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DownloadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).DownloadArtifact(m, &jobServiceDownloadArtifactServer{stream})
+}
+
+type JobService_DownloadArtifactServer interface {
+	Send(*ArtifactChunk) error
+	grpc.ServerStream
+}
+
+type jobServiceDownloadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceDownloadArtifactServer) Send(m *ArtifactChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_UploadInput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JobServiceServer).UploadInput(&jobServiceUploadInputServer{stream})
+}
+
+type JobService_UploadInputServer interface {
+	SendAndClose(*UploadInputResponse) error
+	Recv() (*UploadInputRequest, error)
+	grpc.ServerStream
+}
+
+type jobServiceUploadInputServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceUploadInputServer) SendAndClose(m *UploadInputResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jobServiceUploadInputServer) Recv() (*UploadInputRequest, error) {
+	m := new(UploadInputRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JobService_WriteStdin_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JobServiceServer).WriteStdin(&jobServiceWriteStdinServer{stream})
+}
+
+type JobService_WriteStdinServer interface {
+	SendAndClose(*WriteStdinResponse) error
+	Recv() (*WriteStdinRequest, error)
+	grpc.ServerStream
+}
+
+type jobServiceWriteStdinServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceWriteStdinServer) SendAndClose(m *WriteStdinResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jobServiceWriteStdinServer) Recv() (*WriteStdinRequest, error) {
+	m := new(WriteStdinRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JobService_ImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ImportJob(ctx, req.(*ImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListNodes(ctx, req.(*ListNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).WatchJobs(m, &jobServiceWatchJobsServer{stream})
+}
+
+type JobService_WatchJobsServer interface {
+	Send(*JobEvent) error
+	grpc.ServerStream
+}
+
+type jobServiceWatchJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceWatchJobsServer) Send(m *JobEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RegisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UnregisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UnregisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_UnregisterWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UnregisterWebhook(ctx, req.(*UnregisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jobworker.v1.JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartJob",
+			Handler:    _JobService_StartJob_Handler,
+		},
+		{
+			MethodName: "StartBatch",
+			Handler:    _JobService_StartBatch_Handler,
+		},
+		{
+			MethodName: "StartPipeline",
+			Handler:    _JobService_StartPipeline_Handler,
+		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _JobService_GetPipeline_Handler,
+		},
+		{
+			MethodName: "StopPipeline",
+			Handler:    _JobService_StopPipeline_Handler,
+		},
+		{
+			MethodName: "RunTemplate",
+			Handler:    _JobService_RunTemplate_Handler,
+		},
+		{
+			MethodName: "StartSweep",
+			Handler:    _JobService_StartSweep_Handler,
+		},
+		{
+			MethodName: "GetSweep",
+			Handler:    _JobService_GetSweep_Handler,
+		},
+		{
+			MethodName: "StopSweep",
+			Handler:    _JobService_StopSweep_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _JobService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "StopJob",
+			Handler:    _JobService_StopJob_Handler,
+		},
+		{
+			MethodName: "BatchStop",
+			Handler:    _JobService_BatchStop_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _JobService_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _JobService_ResumeJob_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _JobService_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _JobService_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetRunStats",
+			Handler:    _JobService_GetRunStats_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _JobService_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "GetMaintenance",
+			Handler:    _JobService_GetMaintenance_Handler,
+		},
+		{
+			MethodName: "WaitJob",
+			Handler:    _JobService_WaitJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _JobService_ListJobs_Handler,
+		},
+		{
+			MethodName: "ListJobHistory",
+			Handler:    _JobService_ListJobHistory_Handler,
+		},
+		{
+			MethodName: "GetOutput",
+			Handler:    _JobService_GetOutput_Handler,
+		},
+		{
+			MethodName: "SearchOutput",
+			Handler:    _JobService_SearchOutput_Handler,
+		},
+		{
+			MethodName: "AnnotateJob",
+			Handler:    _JobService_AnnotateJob_Handler,
+		},
+		{
+			MethodName: "GetJobManifest",
+			Handler:    _JobService_GetJobManifest_Handler,
+		},
+		{
+			MethodName: "RerunJob",
+			Handler:    _JobService_RerunJob_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _JobService_GetVersion_Handler,
+		},
+		{
+			MethodName: "GetDiskUsage",
+			Handler:    _JobService_GetDiskUsage_Handler,
+		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _JobService_ListArtifacts_Handler,
+		},
+		{
+			MethodName: "ImportJob",
+			Handler:    _JobService_ImportJob_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _JobService_ListNodes_Handler,
+		},
+		{
+			MethodName: "GetNode",
+			Handler:    _JobService_GetNode_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _JobService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "UnregisterWebhook",
+			Handler:    _JobService_UnregisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _JobService_ListWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOutput",
+			Handler:       _JobService_StreamOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifact",
+			Handler:       _JobService_DownloadArtifact_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadInput",
+			Handler:       _JobService_UploadInput_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WriteStdin",
+			Handler:       _JobService_WriteStdin_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _JobService_WatchJobs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/jobworker/v1/job_service.proto",
+}
@@ -0,0 +1,85 @@
+// Package artifact stores result files collected from job workspaces and
+// serves them back for download. Stores are addressed by URL: file:// for
+// a local directory, http:// or https:// for an S3-compatible gateway.
+package artifact
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Entry describes one stored artifact.
+type Entry struct {
+	// Path is relative to the job's workspace, slash-separated.
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// Store persists and serves a job's artifacts. The index, written once
+// after collection, is the authoritative list; implementations need not
+// support enumeration themselves.
+type Store interface {
+	// Put stores one artifact's contents under the job.
+	Put(jobID, relPath string, r io.Reader) (int64, error)
+	// PutIndex records the job's full artifact list.
+	PutIndex(jobID string, entries []Entry) error
+	// Index returns the job's artifact list; a job that finished without
+	// artifacts has an empty index.
+	Index(jobID string) ([]Entry, error)
+	// Open returns one artifact's contents.
+	Open(jobID, relPath string) (io.ReadCloser, error)
+}
+
+// indexName is where a job's artifact list lives within the store.
+const indexName = "index.json"
+
+// New builds a store from a URL. Supported schemes:
+//
+//	file:///dir            artifacts under a local directory
+//	http(s)://host/base    PUT/GET against an S3-compatible gateway
+func New(rawURL string) (Store, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing artifact store url: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("file artifact store needs a directory path")
+		}
+		return &fileStore{root: u.Path}, nil
+	case "http", "https":
+		return &httpStore{base: strings.TrimSuffix(rawURL, "/")}, nil
+	default:
+		return nil, fmt.Errorf("unsupported artifact store scheme %q", u.Scheme)
+	}
+}
+
+// checkRelPath rejects paths that could escape a job's namespace.
+func checkRelPath(relPath string) error {
+	clean := path.Clean(relPath)
+	if clean == "." || strings.HasPrefix(clean, "../") || path.IsAbs(clean) {
+		return fmt.Errorf("invalid artifact path %q", relPath)
+	}
+	if clean == indexName {
+		return fmt.Errorf("artifact path %q is reserved", relPath)
+	}
+	return nil
+}
+
+// encodeIndex renders an index deterministically for storage.
+func encodeIndex(entries []Entry) ([]byte, error) {
+	return json.MarshalIndent(entries, "", "  ")
+}
+
+func decodeIndex(data []byte) ([]Entry, error) {
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing artifact index: %w", err)
+	}
+	return entries, nil
+}
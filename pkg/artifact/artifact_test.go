@@ -0,0 +1,160 @@
+package artifact
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pmantica4/teleport/pkg/crypt"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollectGlobsAndIndexes(t *testing.T) {
+	workdir := t.TempDir()
+	writeFile(t, filepath.Join(workdir, "result.txt"), "result")
+	writeFile(t, filepath.Join(workdir, "out", "a.log"), "aaa")
+	writeFile(t, filepath.Join(workdir, "out", "b.log"), "bb")
+	writeFile(t, filepath.Join(workdir, "skip.bin"), "skip")
+
+	store, err := New("file://" + t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := Collect(store, "job-1", workdir, []string{"*.txt", "out/*.log"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{
+		{Path: "out/a.log", Size: 3},
+		{Path: "out/b.log", Size: 2},
+		{Path: "result.txt", Size: 6},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %v", len(entries), len(want), entries)
+	}
+	for i, e := range entries {
+		if e != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, e, want[i])
+		}
+	}
+
+	index, err := store.Index("job-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index) != len(want) {
+		t.Fatalf("index has %d entries, want %d", len(index), len(want))
+	}
+
+	r, err := store.Open("job-1", "out/a.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "aaa" {
+		t.Errorf("artifact content = %q, want %q", data, "aaa")
+	}
+}
+
+func TestCollectRejectsWorkspaceEscape(t *testing.T) {
+	root := t.TempDir()
+	workdir := filepath.Join(root, "work")
+	writeFile(t, filepath.Join(root, "secret.txt"), "secret")
+	if err := os.MkdirAll(workdir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := New("file://" + t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Collect(store, "job-1", workdir, []string{"../*.txt"}); err == nil {
+		t.Fatal("expected an error for a pattern escaping the workspace")
+	} else if !strings.Contains(err.Error(), "escapes the workspace") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestStoreRejectsBadPaths(t *testing.T) {
+	store, err := New("file://" + t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"../x", "/abs", ".", "index.json"} {
+		if _, err := store.Open("job-1", path); err == nil {
+			t.Errorf("Open(%q) succeeded, want error", path)
+		}
+	}
+}
+
+func TestIndexIsEmptyForUnknownJob(t *testing.T) {
+	store, err := New("file://" + t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := store.Index("no-such-job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("got %d entries, want none", len(entries))
+	}
+}
+
+func TestEncryptedStoreSealsContents(t *testing.T) {
+	key, err := crypt.ParseKey(strings.Repeat("ef", 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	inner, err := New("file://" + root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := Encrypted(inner, key)
+
+	n, err := store.Put("job-1", "result.txt", strings.NewReader("sensitive result"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len("sensitive result")) {
+		t.Errorf("Put reported %d bytes, want the plaintext size", n)
+	}
+
+	// The backing file must not contain the plaintext.
+	raw, err := os.ReadFile(filepath.Join(root, "job-1", "result.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "sensitive") {
+		t.Error("stored artifact contains plaintext")
+	}
+
+	r, err := store.Open("job-1", "result.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "sensitive result" {
+		t.Errorf("decrypted artifact = %q, want the original", data)
+	}
+}
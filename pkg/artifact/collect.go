@@ -0,0 +1,57 @@
+package artifact
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Collect gathers the files matching the job's artifact patterns from its
+// workspace into the store, writes the index, and returns it. Patterns use
+// filepath.Glob syntax, relative to the workspace; matches outside the
+// workspace (via symlinks or ..) are rejected.
+func Collect(store Store, jobID, workdir string, patterns []string) ([]Entry, error) {
+	seen := make(map[string]bool)
+	var entries []Entry
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(workdir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("artifact pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			rel, err := filepath.Rel(workdir, match)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return nil, fmt.Errorf("artifact %q escapes the workspace", match)
+			}
+			relSlash := filepath.ToSlash(rel)
+			if seen[relSlash] {
+				continue
+			}
+			info, err := os.Stat(match)
+			if err != nil {
+				return nil, err
+			}
+			if info.IsDir() {
+				continue
+			}
+			f, err := os.Open(match)
+			if err != nil {
+				return nil, err
+			}
+			size, err := store.Put(jobID, relSlash, f)
+			f.Close()
+			if err != nil {
+				return nil, fmt.Errorf("storing artifact %s: %w", relSlash, err)
+			}
+			seen[relSlash] = true
+			entries = append(entries, Entry{Path: relSlash, Size: size})
+		}
+	}
+	sort.Slice(entries, func(i, k int) bool { return entries[i].Path < entries[k].Path })
+	if err := store.PutIndex(jobID, entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,58 @@
+package artifact
+
+import (
+	"io"
+
+	"github.com/pmantica4/teleport/pkg/crypt"
+)
+
+// Encrypted wraps a store so artifact contents are encrypted at rest
+// with the 32-byte AES key; see pkg/crypt. Reads decrypt transparently.
+// The index — paths and plaintext sizes, no content — stays as the
+// backend stores it.
+func Encrypted(s Store, key []byte) Store {
+	return &encryptedStore{inner: s, key: key}
+}
+
+type encryptedStore struct {
+	inner Store
+	key   []byte
+}
+
+func (s *encryptedStore) Put(jobID, relPath string, r io.Reader) (int64, error) {
+	// Count plaintext so Put reports the artifact's real size rather
+	// than the ciphertext's.
+	cr := &countingReader{r: r}
+	enc, err := crypt.NewEncryptingReader(cr, s.key)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.inner.Put(jobID, relPath, enc); err != nil {
+		return 0, err
+	}
+	return cr.n, nil
+}
+
+func (s *encryptedStore) PutIndex(jobID string, entries []Entry) error {
+	return s.inner.PutIndex(jobID, entries)
+}
+
+func (s *encryptedStore) Index(jobID string) ([]Entry, error) {
+	return s.inner.Index(jobID)
+}
+
+func (s *encryptedStore) Open(jobID, relPath string) (io.ReadCloser, error) {
+	rc, err := s.inner.Open(jobID, relPath)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := crypt.NewReader(rc, s.key)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{dec, rc}, nil
+}
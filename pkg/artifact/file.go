@@ -0,0 +1,75 @@
+package artifact
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// fileStore keeps artifacts under root/<jobID>/, with the job's index next
+// to its files.
+type fileStore struct {
+	root string
+}
+
+func (s *fileStore) jobPath(jobID, relPath string) (string, error) {
+	if err := checkRelPath(relPath); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, jobID, filepath.FromSlash(relPath)), nil
+}
+
+func (s *fileStore) Put(jobID, relPath string, r io.Reader) (int64, error) {
+	dst, err := s.jobPath(jobID, relPath)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+func (s *fileStore) PutIndex(jobID string, entries []Entry) error {
+	data, err := encodeIndex(entries)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(s.root, jobID)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, indexName), data, 0o600)
+}
+
+func (s *fileStore) Index(jobID string) ([]Entry, error) {
+	data, err := os.ReadFile(filepath.Join(s.root, jobID, indexName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeIndex(data)
+}
+
+func (s *fileStore) Open(jobID, relPath string) (io.ReadCloser, error) {
+	src, err := s.jobPath(jobID, relPath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(src)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("artifact %s not found", relPath)
+	}
+	return f, err
+}
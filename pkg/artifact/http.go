@@ -0,0 +1,112 @@
+package artifact
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// httpStore PUTs and GETs objects against an S3-compatible HTTP gateway,
+// keyed <base>/<jobID>/<path>. Authentication, if any, travels in the base
+// URL (pre-signed style) or is handled by the gateway.
+type httpStore struct {
+	base string
+}
+
+func (s *httpStore) objectURL(jobID, relPath string) (string, error) {
+	if err := checkRelPath(relPath); err != nil {
+		return "", err
+	}
+	return s.base + "/" + url.PathEscape(jobID) + "/" + relPath, nil
+}
+
+func (s *httpStore) Put(jobID, relPath string, r io.Reader) (int64, error) {
+	dst, err := s.objectURL(jobID, relPath)
+	if err != nil {
+		return 0, err
+	}
+	// Count while uploading; chunked transfer keeps memory flat.
+	counter := &countingReader{r: r}
+	req, err := http.NewRequest(http.MethodPut, dst, counter)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("artifact store returned %s", resp.Status)
+	}
+	return counter.n, nil
+}
+
+func (s *httpStore) PutIndex(jobID string, entries []Entry) error {
+	data, err := encodeIndex(entries)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, s.base+"/"+url.PathEscape(jobID)+"/"+indexName, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("artifact store returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *httpStore) Index(jobID string) ([]Entry, error) {
+	resp, err := http.Get(s.base + "/" + url.PathEscape(jobID) + "/" + indexName)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("artifact store returned %s", resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeIndex(data)
+}
+
+func (s *httpStore) Open(jobID, relPath string) (io.ReadCloser, error) {
+	src, err := s.objectURL(jobID, relPath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("artifact store returned %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
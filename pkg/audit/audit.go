@@ -0,0 +1,197 @@
+// Package audit persists an append-only, hash-chained audit trail. Each
+// entry carries the SHA-256 of the previous entry, so modifying or
+// reordering history breaks the chain, and a sidecar head file pinning
+// the latest hash makes truncation of the tail detectable too.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is one audit record. Hash covers every other field, chained
+// through Prev.
+type Entry struct {
+	Seq      int64  `json:"seq"`
+	TimeUnix int64  `json:"time_unix"`
+	Actor    string `json:"actor"`
+	Action   string `json:"action"`
+	Detail   string `json:"detail"`
+	Prev     string `json:"prev"`
+	Hash     string `json:"hash"`
+}
+
+// entryHash chains the entry onto its predecessor. The canonical form
+// pins field order and length-prefixes every field, so content cannot
+// shift across a field boundary without changing the hash — a plain
+// delimiter join would let Action "stop|job 42" read identically to
+// Action "stop" with Detail "job 42".
+func entryHash(e Entry) string {
+	h := sha256.New()
+	for _, field := range []string{
+		strconv.FormatInt(e.Seq, 10),
+		strconv.FormatInt(e.TimeUnix, 10),
+		e.Actor,
+		e.Action,
+		e.Detail,
+		e.Prev,
+	} {
+		fmt.Fprintf(h, "%d:%s", len(field), field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Log is an open audit trail. Appends are serialized; the file only
+// ever grows.
+type Log struct {
+	mu       sync.Mutex
+	f        *os.File
+	path     string
+	headPath string
+	lastHash string
+	seq      int64
+}
+
+// Open opens (or creates) the audit trail at path, recovering the chain
+// position from the existing entries.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	l := &Log{f: f, path: path, headPath: path + ".head"}
+	entries, err := readEntries(path)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if n := len(entries); n > 0 {
+		l.seq = entries[n-1].Seq
+		l.lastHash = entries[n-1].Hash
+	}
+	return l, nil
+}
+
+// Append records one entry and advances the head file.
+func (l *Log) Append(actor, action, detail string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e := Entry{
+		Seq:      l.seq + 1,
+		TimeUnix: time.Now().Unix(),
+		Actor:    actor,
+		Action:   action,
+		Detail:   detail,
+		Prev:     l.lastHash,
+	}
+	e.Hash = entryHash(e)
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := l.f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("appending audit entry: %w", err)
+	}
+	// The head is replaced atomically so a crash cannot leave it pointing
+	// into the middle of nowhere.
+	head := fmt.Sprintf("%d %s\n", e.Seq, e.Hash)
+	tmp := l.headPath + ".tmp"
+	if err := os.WriteFile(tmp, []byte(head), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, l.headPath); err != nil {
+		return err
+	}
+	l.seq = e.Seq
+	l.lastHash = e.Hash
+	return nil
+}
+
+// Verify walks the whole chain and checks it against the head file,
+// returning how many entries were verified. Any modification,
+// reordering, or truncation of the history yields an error saying where
+// the chain breaks.
+func (l *Log) Verify() (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return Verify(l.path)
+}
+
+// Verify checks the audit trail at path independently of any open Log,
+// so the CLI can audit a copied file.
+func Verify(path string) (int64, error) {
+	entries, err := readEntries(path)
+	if err != nil {
+		return 0, err
+	}
+	prev := ""
+	for i, e := range entries {
+		if e.Seq != int64(i)+1 {
+			return int64(i), fmt.Errorf("entry %d: sequence %d out of order", i+1, e.Seq)
+		}
+		if e.Prev != prev {
+			return int64(i), fmt.Errorf("entry %d: chain broken; an earlier entry was altered or removed", e.Seq)
+		}
+		if entryHash(e) != e.Hash {
+			return int64(i), fmt.Errorf("entry %d: hash mismatch; the entry was altered", e.Seq)
+		}
+		prev = e.Hash
+	}
+	head, err := os.ReadFile(path + ".head")
+	if os.IsNotExist(err) {
+		if len(entries) == 0 {
+			return 0, nil
+		}
+		return int64(len(entries)), fmt.Errorf("head file missing; cannot rule out truncation")
+	}
+	if err != nil {
+		return int64(len(entries)), err
+	}
+	var headSeq int64
+	var headHash string
+	if _, err := fmt.Sscanf(strings.TrimSpace(string(head)), "%d %s", &headSeq, &headHash); err != nil {
+		return int64(len(entries)), fmt.Errorf("malformed head file: %w", err)
+	}
+	if int64(len(entries)) != headSeq || prev != headHash {
+		return int64(len(entries)), fmt.Errorf("head records %d entries ending %.12s; the log was truncated or rewritten", headSeq, headHash)
+	}
+	return int64(len(entries)), nil
+}
+
+// Close flushes and closes the underlying file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// readEntries loads every entry in file order.
+func readEntries(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []Entry
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		var e Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			return entries, fmt.Errorf("entry %d: malformed: %w", len(entries)+1, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, sc.Err()
+}
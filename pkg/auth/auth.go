@@ -0,0 +1,151 @@
+// Package auth extracts caller identities from mutual-TLS connections and
+// provides the gRPC interceptors that enforce authentication. It is shared
+// by the worker and the coordinator.
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// identityKey is the context key under which the authenticated identity is
+// stored.
+type identityKey struct{}
+
+// rolesKey is the context key under which the caller's roles are stored.
+type rolesKey struct{}
+
+// ForwardedIdentityHeader carries the end user's identity on RPCs the
+// coordinator proxies to workers. Workers only honor it when the direct
+// peer is the configured coordinator identity.
+const ForwardedIdentityHeader = "x-forwarded-identity"
+
+// ForwardedRolesHeader carries the end user's roles on proxied RPCs, one
+// metadata value per role, honored under the same trust rule as
+// ForwardedIdentityHeader.
+const ForwardedRolesHeader = "x-forwarded-roles"
+
+// IdentityFromContext returns the identity stored by the interceptors.
+func IdentityFromContext(ctx context.Context) (string, error) {
+	id, ok := ctx.Value(identityKey{}).(string)
+	if !ok || id == "" {
+		return "", status.Error(codes.Unauthenticated, "no authenticated identity")
+	}
+	return id, nil
+}
+
+// ContextWithIdentity returns ctx carrying the given identity, as the
+// interceptors would set it. Used by non-gRPC front ends (the HTTP
+// gateway) and tests.
+func ContextWithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// RolesFromContext returns the roles stored by the interceptors. Callers
+// without roles get nil; roles gate policy, not authentication, so there is
+// no error case.
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesKey{}).([]string)
+	return roles
+}
+
+// ContextWithRoles returns ctx carrying the given roles, as the
+// interceptors would set them.
+func ContextWithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// PeerRoles extracts the caller's roles from the verified client
+// certificate: the leaf certificate's organizational units.
+func PeerRoles(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	certs := tlsInfo.State.VerifiedChains
+	if len(certs) == 0 || len(certs[0]) == 0 {
+		return nil
+	}
+	return certs[0][0].Subject.OrganizationalUnit
+}
+
+// PeerIdentity extracts the caller's identity from the verified client
+// certificate: the leaf certificate's Common Name.
+func PeerIdentity(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "no peer information")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "connection is not TLS")
+	}
+	certs := tlsInfo.State.VerifiedChains
+	if len(certs) == 0 || len(certs[0]) == 0 {
+		return "", status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	cn := certs[0][0].Subject.CommonName
+	if cn == "" {
+		return "", status.Error(codes.Unauthenticated, "client certificate has no common name")
+	}
+	return cn, nil
+}
+
+// Interceptors builds the unary and stream auth interceptors. If
+// trustedProxyCN is non-empty and the direct peer's CN matches it, the
+// identity in ForwardedIdentityHeader (if present) is used instead of the
+// peer's own, letting a coordinator act on behalf of end users.
+func Interceptors(trustedProxyCN string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	resolve := func(ctx context.Context) (context.Context, error) {
+		id, err := PeerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		roles := PeerRoles(ctx)
+		if trustedProxyCN != "" && id == trustedProxyCN {
+			if md, ok := metadata.FromIncomingContext(ctx); ok {
+				if fwd := md.Get(ForwardedIdentityHeader); len(fwd) > 0 && fwd[0] != "" {
+					id = fwd[0]
+					roles = md.Get(ForwardedRolesHeader)
+				}
+			}
+		}
+		return ContextWithRoles(ContextWithIdentity(ctx, id), roles), nil
+	}
+
+	unary := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := resolve(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+// wrappedStream overrides the stream context to carry the identity.
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// ListArtifacts returns the index of files collected from the job's
+// workspace when it finished.
+func (c *Client) ListArtifacts(ctx context.Context, jobID string) ([]*jobworkerv1.Artifact, error) {
+	var resp *jobworkerv1.ListArtifactsResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ListArtifacts(ctx, &jobworkerv1.ListArtifactsRequest{JobId: jobID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Artifacts, nil
+}
+
+// DownloadArtifact streams one collected artifact into w and returns the
+// number of bytes written.
+func (c *Client) DownloadArtifact(ctx context.Context, jobID, path string, w io.Writer) (int64, error) {
+	stream, err := c.svc.DownloadArtifact(ctx, &jobworkerv1.DownloadArtifactRequest{
+		JobId: jobID,
+		Path:  path,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var written int64
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+		n, err := w.Write(chunk.Data)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Attach connects the caller's terminal to a job: the output captured so
+// far is replayed, new output is followed, and bytes read from in are
+// forwarded to the job's stdin. It returns when the job finishes, when in
+// reports io.EOF (a detach), or on error. The job's stdin is left open on
+// detach, so the caller can attach again later. Jobs run on pipes, not a
+// PTY, so output arrives line by line.
+func (c *Client) Attach(ctx context.Context, jobID string, in io.Reader, out io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.StreamOutput(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	// The input copy can outlive a finished job by one blocked Read; the
+	// cancelled context makes its next Send fail, and a CLI caller exits
+	// right after anyway.
+	stdinDone := make(chan error, 1)
+	go func() {
+		_, err := c.copyStdin(ctx, jobID, in, true)
+		stdinDone <- err
+	}()
+
+	outDone := make(chan error, 1)
+	go func() {
+		for stream.Next() {
+			fmt.Fprintln(out, stream.Line())
+		}
+		outDone <- stream.Err()
+	}()
+
+	select {
+	case err := <-outDone:
+		return err
+	case err := <-stdinDone:
+		return err
+	}
+}
@@ -0,0 +1,1102 @@
+// Package client is a Go library for the job worker service. It wraps the
+// raw gRPC bindings with connection/TLS setup, typed methods, and retries,
+// so programs can embed a worker client without shelling out to the CLI.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+// Config holds connection options for a worker client.
+type Config struct {
+	// Addr is the worker's address, e.g. "worker:8443".
+	Addr string
+
+	// CACertFile, CertFile, and KeyFile configure mutual TLS; all three are
+	// required.
+	CACertFile string
+	CertFile   string
+	KeyFile    string
+
+	// KeepaliveTime is the client ping interval. Zero disables client
+	// keepalives.
+	KeepaliveTime time.Duration
+
+	// DialTimeout bounds the initial connection attempt; New fails fast
+	// with a descriptive error if the worker is unreachable. Zero dials
+	// lazily: the connection is established on the first RPC.
+	DialTimeout time.Duration
+
+	// MaxRecvMsgSize and MaxSendMsgSize bound gRPC message sizes in bytes.
+	// Zero means the gRPC defaults.
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+
+	// RetryAttempts is the number of times unary RPCs are attempted when the
+	// worker is unavailable. Zero means DefaultRetryAttempts.
+	RetryAttempts int
+
+	// WarningHandler receives deprecation warnings the server attaches to
+	// response headers; see pkg/version. Nil discards them.
+	WarningHandler func(string)
+}
+
+// DefaultRetryAttempts is how many times unary RPCs are tried when the
+// config does not say otherwise.
+const DefaultRetryAttempts = 3
+
+// retryBaseDelay is the backoff after the first failed attempt; it doubles
+// per attempt.
+const retryBaseDelay = 100 * time.Millisecond
+
+// CheckAndSetDefaults validates the config and fills in defaults.
+func (c *Config) CheckAndSetDefaults() error {
+	if c.Addr == "" {
+		return fmt.Errorf("worker address is required")
+	}
+	if c.CACertFile == "" || c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("ca-cert, cert, and key are all required for mutual TLS")
+	}
+	if c.RetryAttempts == 0 {
+		c.RetryAttempts = DefaultRetryAttempts
+	}
+	return nil
+}
+
+// Client is a connected worker client. It is safe for concurrent use.
+type Client struct {
+	cfg   Config
+	conn  *grpc.ClientConn
+	svc   jobworkerv1.JobServiceClient
+	admin jobworkerv1.AdminServiceClient
+}
+
+// New dials the worker and returns a ready client. The connection is
+// established lazily; RPCs fail if the worker is unreachable.
+func New(cfg Config) (*Client, error) {
+	if err := cfg.CheckAndSetDefaults(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	unaryVersion, streamVersion := version.ClientInterceptors(cfg.WarningHandler)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithChainUnaryInterceptor(unaryVersion),
+		grpc.WithChainStreamInterceptor(streamVersion),
+	}
+	if cfg.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}))
+	}
+	var callOpts []grpc.CallOption
+	if cfg.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(cfg.MaxSendMsgSize))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	var conn *grpc.ClientConn
+	if cfg.DialTimeout > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
+		defer cancel()
+		opts = append(opts, grpc.WithBlock(), grpc.WithReturnConnectionError())
+		conn, err = grpc.DialContext(ctx, cfg.Addr, opts...)
+	} else {
+		conn, err = grpc.Dial(cfg.Addr, opts...)
+	}
+	if err != nil {
+		return nil, dialError(cfg.Addr, cfg.DialTimeout, err)
+	}
+	return &Client{
+		cfg:   cfg,
+		conn:  conn,
+		svc:   jobworkerv1.NewJobServiceClient(conn),
+		admin: jobworkerv1.NewAdminServiceClient(conn),
+	}, nil
+}
+
+// dialError turns gRPC's dial failures into something actionable: a bad
+// certificate reads very differently from a worker that is down.
+func dialError(addr string, timeout time.Duration, err error) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "tls:") || strings.Contains(msg, "x509:"):
+		return fmt.Errorf("TLS handshake with %s failed: %w (check -ca-cert, -cert, and -key)", addr, err)
+	case errors.Is(err, context.DeadlineExceeded) || strings.Contains(msg, "deadline exceeded"):
+		return fmt.Errorf("could not connect to %s within %s: %w (is the worker up?)", addr, timeout, err)
+	default:
+		return fmt.Errorf("dialing %s: %w", addr, err)
+	}
+}
+
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading client key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(c.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates parsed from %s", c.CACertFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// Close tears down the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// retry runs fn up to the configured number of attempts, backing off between
+// attempts, as long as the failure is transient (worker unavailable).
+func (c *Client) retry(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	delay := retryBaseDelay
+	for attempt := 0; attempt < c.cfg.RetryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			delay *= 2
+		}
+		if err = fn(ctx); status.Code(err) != codes.Unavailable {
+			return err
+		}
+	}
+	return err
+}
+
+// StartSpec describes a job to start.
+type StartSpec struct {
+	Command string
+	Args    []string
+	// NodeSelector restricts scheduling to nodes matching all labels.
+	NodeSelector map[string]string
+	// Name optionally gives the job a human-friendly handle usable
+	// wherever a job ID is accepted.
+	Name string
+	// IdempotencyKey makes the start safe to retry after a network
+	// failure: replaying the same key returns the already started job.
+	IdempotencyKey string
+	// Artifacts are glob patterns of result files to collect from the
+	// job's workspace when it finishes.
+	Artifacts []string
+	// UploadID references input files previously staged with UploadInput;
+	// they land in the job's workspace before the command starts.
+	UploadID string
+	// CPUBudget, when positive, caps how much CPU time the job's process
+	// may consume; CPUBudgetPolicy is "pause" (default) or "kill".
+	CPUBudget       time.Duration
+	CPUBudgetPolicy string
+	// CaptureMode controls how much output the worker keeps: "full"
+	// (default), "tail:N", "sample:N", or "discard".
+	CaptureMode string
+	// StripANSI removes terminal escape sequences from output lines as
+	// they are captured on the worker.
+	StripANSI bool
+	// MaxOutputBytes, when positive, caps the job's total output;
+	// OutputLimitPolicy is "truncate" (default) or "kill".
+	MaxOutputBytes    int64
+	OutputLimitPolicy string
+	// Nice adjusts scheduling priority, -20 (highest) to 19 (lowest);
+	// zero inherits the worker's.
+	Nice int
+	// Priority orders jobs for preemption on workers that allow it: a
+	// higher-priority GPU start may stop and requeue lower-priority
+	// jobs. Zero is the lowest priority.
+	Priority int
+	// IOClass ("realtime", "best-effort", or "idle") and IOPriority (0-7)
+	// set the IO scheduling class and its level; empty inherits.
+	IOClass    string
+	IOPriority int
+	// CPUAffinity pins the process to these CPU indices; empty means all.
+	CPUAffinity []int
+	// GPUs is how many GPU devices the job needs; the start queues until
+	// the worker has that many free.
+	GPUs int
+	// Ports is how many TCP ports to allocate for the job to listen on;
+	// they appear in its environment as PORT0..PORTn-1.
+	Ports int
+	// RestartPolicy relaunches the process when it exits: "never"
+	// (default), "on-failure", or "always". MaxRestarts caps the number of
+	// relaunches; zero means unlimited.
+	RestartPolicy string
+	MaxRestarts   int
+	// Probe is an optional liveness check ("tcp:host:port",
+	// "http(s)://url", or "exec:command") run every ProbeInterval; after
+	// repeated failures the process is killed so the restart policy can
+	// relaunch it.
+	Probe         string
+	ProbeInterval time.Duration
+	// MaxSilence, when positive, flags the job stalled once it has
+	// produced no output (or "::heartbeat" directive) for that long;
+	// StallPolicy is "flag" (default) or "kill".
+	MaxSilence  time.Duration
+	StallPolicy string
+	// Cacheable marks the job deterministic: an identical cacheable
+	// start may return a prior successful job instead of re-executing.
+	// CacheKey overrides the server's content hash, CacheTTL bounds how
+	// long the result is reused, and NoCache forces a fresh run.
+	Cacheable bool
+	CacheKey  string
+	CacheTTL  time.Duration
+	NoCache   bool
+	// MaxCoreBytes, when positive, lets the process dump a core file up
+	// to this many bytes into its workspace on a crash; the server
+	// collects it with the job's artifacts.
+	MaxCoreBytes int64
+	// SecretEnv injects secrets resolved by the server, as
+	// NAME=provider:reference entries.
+	SecretEnv []string
+	// Labels are free-form key/value tags on the job, used to select
+	// groups of jobs in bulk operations such as BatchStop.
+	Labels map[string]string
+	// LockKey makes the job a singleton: at most one running job of the
+	// caller's holds a given key at a time. LockWait queues the start
+	// until the key frees instead of failing it.
+	LockKey  string
+	LockWait bool
+	// ConcurrencyGroup queues the start while the group is at the
+	// worker's configured max parallelism for it (default one).
+	ConcurrencyGroup string
+	// ExitStatuses maps exit codes to custom terminal status labels,
+	// e.g. {3: "retryable", 4: "skipped"}; under the on-failure restart
+	// policy only exits labelled "retryable" are relaunched.
+	ExitStatuses map[int]string
+	// OnSuccess and OnFailure are follow-up shell commands the server
+	// runs as child jobs when this one completes or fails.
+	OnSuccess string
+	OnFailure string
+	// Notify lists notification targets told when the job finishes, as
+	// smtp:// or slack:// URLs.
+	Notify []string
+	// JSONLogs marks the output as JSON lines, parsed server-side for
+	// level filtering and pretty rendering.
+	JSONLogs bool
+}
+
+// Start starts a job from the spec and returns the job ID.
+func (c *Client) Start(ctx context.Context, spec StartSpec) (string, error) {
+	var resp *jobworkerv1.StartJobResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command:              spec.Command,
+			Args:                 spec.Args,
+			NodeSelector:         spec.NodeSelector,
+			Name:                 spec.Name,
+			IdempotencyKey:       spec.IdempotencyKey,
+			Artifacts:            spec.Artifacts,
+			UploadId:             spec.UploadID,
+			CpuBudgetSeconds:     int64(spec.CPUBudget / time.Second),
+			CpuBudgetPolicy:      spec.CPUBudgetPolicy,
+			CaptureMode:          spec.CaptureMode,
+			StripAnsi:            spec.StripANSI,
+			MaxOutputBytes:       spec.MaxOutputBytes,
+			OutputLimitPolicy:    spec.OutputLimitPolicy,
+			Nice:                 int32(spec.Nice),
+			Priority:             int32(spec.Priority),
+			IoClass:              spec.IOClass,
+			IoPriority:           int32(spec.IOPriority),
+			CpuAffinity:          cpuAffinityProto(spec.CPUAffinity),
+			Gpus:                 int32(spec.GPUs),
+			Ports:                int32(spec.Ports),
+			RestartPolicy:        spec.RestartPolicy,
+			MaxRestarts:          int32(spec.MaxRestarts),
+			Probe:                spec.Probe,
+			ProbeIntervalSeconds: int32(spec.ProbeInterval / time.Second),
+			MaxSilenceSeconds:    int64(spec.MaxSilence / time.Second),
+			StallPolicy:          spec.StallPolicy,
+			MaxCoreBytes:         spec.MaxCoreBytes,
+			Cacheable:            spec.Cacheable,
+			CacheKey:             spec.CacheKey,
+			CacheTtlSeconds:      int64(spec.CacheTTL / time.Second),
+			NoCache:              spec.NoCache,
+			SecretEnv:            spec.SecretEnv,
+			Labels:               spec.Labels,
+			LockKey:              spec.LockKey,
+			LockWait:             spec.LockWait,
+			ConcurrencyGroup:     spec.ConcurrencyGroup,
+			ExitStatuses:         exitStatusesProto(spec.ExitStatuses),
+			OnSuccess:            spec.OnSuccess,
+			OnFailure:            spec.OnFailure,
+			Notify:               spec.Notify,
+			JsonLogs:             spec.JSONLogs,
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.JobId, nil
+}
+
+// cpuAffinityProto converts CPU indices to their wire representation.
+func cpuAffinityProto(cpus []int) []int32 {
+	var out []int32
+	for _, c := range cpus {
+		out = append(out, int32(c))
+	}
+	return out
+}
+
+func exitStatusesProto(rules map[int]string) map[int32]string {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make(map[int32]string, len(rules))
+	for code, label := range rules {
+		out[int32(code)] = label
+	}
+	return out
+}
+
+// StartJob starts command with args on the worker and returns the job ID.
+func (c *Client) StartJob(ctx context.Context, command string, args ...string) (string, error) {
+	return c.Start(ctx, StartSpec{Command: command, Args: args})
+}
+
+// BatchResult reports the outcome for one job of a batch: a job ID on
+// success, an error message otherwise.
+type BatchResult struct {
+	JobID string
+	Err   string
+}
+
+// StartBatch starts several jobs in one call. With atomic set, the whole
+// call fails and nothing is left running if any job cannot start; otherwise
+// each result carries its own error.
+func (c *Client) StartBatch(ctx context.Context, specs []StartSpec, selector map[string]string, atomic bool) ([]BatchResult, error) {
+	req := &jobworkerv1.StartBatchRequest{
+		NodeSelector: selector,
+		Atomic:       atomic,
+	}
+	for _, spec := range specs {
+		req.Jobs = append(req.Jobs, &jobworkerv1.StartJobRequest{
+			Command:              spec.Command,
+			Args:                 spec.Args,
+			NodeSelector:         spec.NodeSelector,
+			Name:                 spec.Name,
+			IdempotencyKey:       spec.IdempotencyKey,
+			Artifacts:            spec.Artifacts,
+			CaptureMode:          spec.CaptureMode,
+			StripAnsi:            spec.StripANSI,
+			MaxOutputBytes:       spec.MaxOutputBytes,
+			OutputLimitPolicy:    spec.OutputLimitPolicy,
+			Nice:                 int32(spec.Nice),
+			Priority:             int32(spec.Priority),
+			IoClass:              spec.IOClass,
+			IoPriority:           int32(spec.IOPriority),
+			CpuAffinity:          cpuAffinityProto(spec.CPUAffinity),
+			Gpus:                 int32(spec.GPUs),
+			Ports:                int32(spec.Ports),
+			RestartPolicy:        spec.RestartPolicy,
+			MaxRestarts:          int32(spec.MaxRestarts),
+			Probe:                spec.Probe,
+			ProbeIntervalSeconds: int32(spec.ProbeInterval / time.Second),
+			Labels:               spec.Labels,
+		})
+	}
+	var resp *jobworkerv1.StartBatchResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.StartBatch(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]BatchResult, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = BatchResult{JobID: r.JobId, Err: r.Error}
+	}
+	return results, nil
+}
+
+// PipelineStage is one node of a pipeline DAG: a start spec plus the
+// names of the stages that must complete before it runs.
+type PipelineStage struct {
+	Name  string
+	Spec  StartSpec
+	Needs []string
+}
+
+// StartPipeline submits a set of dependent stages under one pipeline ID.
+// failurePolicy is fail-fast (default) or continue. It is not retried: a
+// resubmission would start a second pipeline.
+func (c *Client) StartPipeline(ctx context.Context, name string, stages []PipelineStage, failurePolicy string) (string, error) {
+	req := &jobworkerv1.StartPipelineRequest{
+		Name:          name,
+		FailurePolicy: failurePolicy,
+	}
+	for _, st := range stages {
+		req.Stages = append(req.Stages, &jobworkerv1.PipelineStage{
+			Name:  st.Name,
+			Needs: st.Needs,
+			Job: &jobworkerv1.StartJobRequest{
+				Command:           st.Spec.Command,
+				Args:              st.Spec.Args,
+				NodeSelector:      st.Spec.NodeSelector,
+				Artifacts:         st.Spec.Artifacts,
+				CaptureMode:       st.Spec.CaptureMode,
+				StripAnsi:         st.Spec.StripANSI,
+				MaxOutputBytes:    st.Spec.MaxOutputBytes,
+				OutputLimitPolicy: st.Spec.OutputLimitPolicy,
+				Nice:              int32(st.Spec.Nice),
+				IoClass:           st.Spec.IOClass,
+				IoPriority:        int32(st.Spec.IOPriority),
+				CpuAffinity:       cpuAffinityProto(st.Spec.CPUAffinity),
+				RestartPolicy:     st.Spec.RestartPolicy,
+				MaxRestarts:       int32(st.Spec.MaxRestarts),
+				SecretEnv:         st.Spec.SecretEnv,
+				Labels:            st.Spec.Labels,
+			},
+		})
+	}
+	resp, err := c.svc.StartPipeline(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.PipelineId, nil
+}
+
+// GetPipeline returns a pipeline's aggregate status and per-stage states.
+func (c *Client) GetPipeline(ctx context.Context, id string) (*jobworkerv1.Pipeline, error) {
+	var resp *jobworkerv1.GetPipelineResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetPipeline(ctx, &jobworkerv1.GetPipelineRequest{PipelineId: id})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pipeline, nil
+}
+
+// StopPipeline stops a pipeline's running stages and skips the pending
+// ones, returning how many running stages were stopped.
+func (c *Client) StopPipeline(ctx context.Context, id string) (int32, error) {
+	var resp *jobworkerv1.StopPipelineResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.StopPipeline(ctx, &jobworkerv1.StopPipelineRequest{PipelineId: id})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Stopped, nil
+}
+
+// StartSweep expands a template over a parameter matrix and starts one
+// child job per combination under a single sweep ID. Matrix values are
+// comma-separated lists or inclusive integer ranges like 0..9; params fix
+// the remaining template parameters. It returns the sweep ID and how many
+// children started. It is not retried: a resubmission would fan out
+// twice.
+func (c *Client) StartSweep(ctx context.Context, template string, matrix, params map[string]string) (string, int32, error) {
+	resp, err := c.svc.StartSweep(ctx, &jobworkerv1.StartSweepRequest{
+		Template: template,
+		Matrix:   matrix,
+		Params:   params,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return resp.SweepId, resp.Started, nil
+}
+
+// GetSweep reports a sweep's aggregate progress and its child jobs.
+func (c *Client) GetSweep(ctx context.Context, id string) (*jobworkerv1.Sweep, error) {
+	var resp *jobworkerv1.GetSweepResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetSweep(ctx, &jobworkerv1.GetSweepRequest{SweepId: id})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sweep, nil
+}
+
+// StopSweep stops every still-running child of a sweep and returns how
+// many were stopped.
+func (c *Client) StopSweep(ctx context.Context, id string) (int32, error) {
+	var resp *jobworkerv1.StopSweepResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.StopSweep(ctx, &jobworkerv1.StopSweepRequest{SweepId: id})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Stopped, nil
+}
+
+// RunTemplate starts a job from a named server-side template and returns
+// the job ID.
+func (c *Client) RunTemplate(ctx context.Context, name string, params, nodeSelector map[string]string) (string, error) {
+	var resp *jobworkerv1.RunTemplateResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.RunTemplate(ctx, &jobworkerv1.RunTemplateRequest{
+			Name:         name,
+			Params:       params,
+			NodeSelector: nodeSelector,
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.JobId, nil
+}
+
+// ListTemplates reports the templates registered on the server.
+func (c *Client) ListTemplates(ctx context.Context) ([]*jobworkerv1.Template, error) {
+	var resp *jobworkerv1.ListTemplatesResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ListTemplates(ctx, &jobworkerv1.ListTemplatesRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Templates, nil
+}
+
+// GetVersion reports the server's build identity and API capabilities.
+func (c *Client) GetVersion(ctx context.Context) (*jobworkerv1.GetVersionResponse, error) {
+	var resp *jobworkerv1.GetVersionResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetVersion(ctx, &jobworkerv1.GetVersionRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListNodes reports the worker-node inventory.
+func (c *Client) ListNodes(ctx context.Context) ([]*jobworkerv1.Node, error) {
+	var resp *jobworkerv1.ListNodesResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ListNodes(ctx, &jobworkerv1.ListNodesRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Nodes, nil
+}
+
+// GetNode reports one node by name, alive or not.
+func (c *Client) GetNode(ctx context.Context, name string) (*jobworkerv1.Node, error) {
+	var resp *jobworkerv1.GetNodeResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetNode(ctx, &jobworkerv1.GetNodeRequest{Name: name})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Node, nil
+}
+
+// PauseJob suspends a running job's process group; resume with ResumeJob.
+func (c *Client) PauseJob(ctx context.Context, jobID string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.svc.PauseJob(ctx, &jobworkerv1.PauseJobRequest{JobId: jobID})
+		return err
+	})
+}
+
+// ResumeJob continues a paused job's process group.
+func (c *Client) ResumeJob(ctx context.Context, jobID string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.svc.ResumeJob(ctx, &jobworkerv1.ResumeJobRequest{JobId: jobID})
+		return err
+	})
+}
+
+// StopJob stops the job with the given ID.
+func (c *Client) StopJob(ctx context.Context, jobID string) error {
+	return c.StopJobReason(ctx, jobID, "")
+}
+
+// StopJobReason is StopJob with a free-form reason recorded alongside the
+// caller's identity in the job's status, for post-mortems.
+func (c *Client) StopJobReason(ctx context.Context, jobID, reason string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.svc.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: jobID, Reason: reason})
+		return err
+	})
+}
+
+// StopJobForce stops a job like StopJobReason, additionally overriding
+// the server-side protection label.
+func (c *Client) StopJobForce(ctx context.Context, jobID, reason string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.svc.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: jobID, Reason: reason, Force: true})
+		return err
+	})
+}
+
+// Annotate appends a marker line to a running job's output timeline, for
+// correlating external events with the surrounding output.
+func (c *Client) Annotate(ctx context.Context, jobID, text string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.svc.AnnotateJob(ctx, &jobworkerv1.AnnotateJobRequest{JobId: jobID, Text: text})
+		return err
+	})
+}
+
+// BatchStop stops every job of the caller's matching the selector and
+// returns per-job results. At least one of labels, status, or all must
+// be set; force additionally stops protected jobs.
+func (c *Client) BatchStop(ctx context.Context, labels map[string]string, jobStatus string, all, force bool, reason string) ([]*jobworkerv1.BatchStopResult, error) {
+	var resp *jobworkerv1.BatchStopResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.BatchStop(ctx, &jobworkerv1.BatchStopRequest{
+			Labels: labels,
+			Status: jobStatus,
+			All:    all,
+			Force:  force,
+			Reason: reason,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// GetJobStatus returns the job's current state.
+func (c *Client) GetJobStatus(ctx context.Context, jobID string) (*jobworkerv1.Job, error) {
+	var resp *jobworkerv1.GetJobStatusResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: jobID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// GetJobManifest returns the job's reproducibility record: resolved
+// environment, working directory, host and runner identity, and the
+// resource settings applied at launch.
+func (c *Client) GetJobManifest(ctx context.Context, jobID string) (*jobworkerv1.JobManifest, error) {
+	var resp *jobworkerv1.GetJobManifestResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetJobManifest(ctx, &jobworkerv1.GetJobManifestRequest{JobId: jobID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Manifest, nil
+}
+
+// RerunJob starts a new job from the recorded start request of an
+// existing one and returns the new job's ID. set overrides individual
+// start parameters, keyed by the start flag name; appendArgs adds extra
+// arguments after the recorded ones. It is not retried: a rerun submits
+// work.
+func (c *Client) RerunJob(ctx context.Context, jobID string, set map[string]string, appendArgs []string) (string, error) {
+	resp, err := c.svc.RerunJob(ctx, &jobworkerv1.RerunJobRequest{JobId: jobID, Set: set, AppendArgs: appendArgs})
+	if err != nil {
+		return "", err
+	}
+	return resp.JobId, nil
+}
+
+// ImportJob registers a finished job exported from another server; the
+// caller becomes its owner. It returns the fresh job ID this server issued.
+func (c *Client) ImportJob(ctx context.Context, job *jobworkerv1.Job, output []*jobworkerv1.OutputLine) (string, error) {
+	var resp *jobworkerv1.ImportJobResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ImportJob(ctx, &jobworkerv1.ImportJobRequest{Job: job, Output: output})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.JobId, nil
+}
+
+// GetHistory returns the job's state-transition timeline, oldest first.
+func (c *Client) GetHistory(ctx context.Context, jobID string) ([]*jobworkerv1.JobTransition, error) {
+	var resp *jobworkerv1.GetHistoryResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetHistory(ctx, &jobworkerv1.GetHistoryRequest{JobId: jobID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Transitions, nil
+}
+
+// GetRunStats returns per-group run statistics for the caller's jobs,
+// grouped by name or command. lastRuns caps how many recent finished runs
+// per group are considered; zero considers them all.
+func (c *Client) GetRunStats(ctx context.Context, groupBy string, lastRuns int) ([]*jobworkerv1.RunStats, error) {
+	var resp *jobworkerv1.GetRunStatsResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetRunStats(ctx, &jobworkerv1.GetRunStatsRequest{
+			GroupBy:  groupBy,
+			LastRuns: int32(lastRuns),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Groups, nil
+}
+
+// GetQuotaUsage reports per-owner resource consumption in the current
+// accounting window; callers without the admin role see only their own
+// row.
+func (c *Client) GetQuotaUsage(ctx context.Context) ([]*jobworkerv1.QuotaUsage, error) {
+	var resp *jobworkerv1.GetQuotaUsageResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetQuotaUsage(ctx, &jobworkerv1.GetQuotaUsageRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Owners, nil
+}
+
+// GetMaintenance reports whether the server has a maintenance window
+// open; while one is, non-exempt starts are held until it closes.
+func (c *Client) GetMaintenance(ctx context.Context) (*jobworkerv1.GetMaintenanceResponse, error) {
+	var resp *jobworkerv1.GetMaintenanceResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetMaintenance(ctx, &jobworkerv1.GetMaintenanceRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetAdmission pauses (or resumes) job admission on the worker. Requires
+// the admin role on the client certificate.
+func (c *Client) SetAdmission(ctx context.Context, paused bool, reason string) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		_, err := c.admin.SetAdmission(ctx, &jobworkerv1.SetAdmissionRequest{Paused: paused, Reason: reason})
+		return err
+	})
+}
+
+// Drain puts the worker into drain mode — no new jobs, running ones finish
+// — and returns how many jobs were still running. Requires the admin role.
+func (c *Client) Drain(ctx context.Context) (int64, error) {
+	var resp *jobworkerv1.DrainResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.admin.Drain(ctx, &jobworkerv1.DrainRequest{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.RunningJobs, nil
+}
+
+// ReloadConfig re-reads the worker's reloadable configuration from disk
+// and returns what was reloaded. Requires the admin role.
+func (c *Client) ReloadConfig(ctx context.Context) ([]string, error) {
+	var resp *jobworkerv1.ReloadConfigResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.admin.ReloadConfig(ctx, &jobworkerv1.ReloadConfigRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Reloaded, nil
+}
+
+// VerifyAudit asks the worker to re-check the hash chain of its audit
+// trail, returning how many entries verified. Requires the admin role.
+func (c *Client) VerifyAudit(ctx context.Context) (int64, error) {
+	var resp *jobworkerv1.VerifyAuditResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.admin.VerifyAudit(ctx, &jobworkerv1.VerifyAuditRequest{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Entries, nil
+}
+
+// DumpDiagnostics fetches internal worker state — goroutine dump, job and
+// memory counters — for debugging. Requires the admin role.
+func (c *Client) DumpDiagnostics(ctx context.Context) (*jobworkerv1.DumpDiagnosticsResponse, error) {
+	var resp *jobworkerv1.DumpDiagnosticsResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.admin.DumpDiagnostics(ctx, &jobworkerv1.DumpDiagnosticsRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DebugJob attaches a diagnostic tool ("strace" or "perf") to a running
+// job's process for the given duration and returns the stored capture's
+// artifact path and size. Requires the admin role; retries do not apply
+// since the call blocks for the capture.
+func (c *Client) DebugJob(ctx context.Context, jobID, tool string, duration time.Duration) (string, int64, error) {
+	resp, err := c.admin.DebugJob(ctx, &jobworkerv1.DebugJobRequest{
+		JobId:           jobID,
+		Tool:            tool,
+		DurationSeconds: int64(duration / time.Second),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return resp.Artifact, resp.SizeBytes, nil
+}
+
+// WaitJob blocks until the job reaches a terminal state and returns the
+// final job. A timeout of zero waits indefinitely; retries do not apply
+// since the call is expected to block.
+func (c *Client) WaitJob(ctx context.Context, jobID string, timeout time.Duration) (*jobworkerv1.Job, error) {
+	resp, err := c.svc.WaitJob(ctx, &jobworkerv1.WaitJobRequest{
+		JobId:          jobID,
+		TimeoutSeconds: int64(timeout / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// WatchJobs streams state-change events for the caller's jobs, or for one
+// job when jobID is set, until ctx is cancelled.
+func (c *Client) WatchJobs(ctx context.Context, jobID string) (jobworkerv1.JobService_WatchJobsClient, error) {
+	return c.svc.WatchJobs(ctx, &jobworkerv1.WatchJobsRequest{JobId: jobID})
+}
+
+// ListJobs returns the caller's jobs.
+func (c *Client) ListJobs(ctx context.Context) ([]*jobworkerv1.Job, error) {
+	var resp *jobworkerv1.ListJobsResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ListJobs(ctx, &jobworkerv1.ListJobsRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Jobs, nil
+}
+
+// HistoryFilter narrows a ListJobHistory query; zero fields match
+// everything.
+type HistoryFilter struct {
+	// Status keeps only jobs in that state, e.g. "COMPLETED".
+	Status string
+	// Labels keeps only jobs carrying every given label.
+	Labels map[string]string
+	// Since and Until bound the job's start time, inclusive on Since and
+	// exclusive on Until.
+	Since time.Time
+	Until time.Time
+	// Limit caps the number of jobs returned, newest first; zero means
+	// no cap.
+	Limit int
+}
+
+// ListJobHistory returns the caller's jobs from the worker's SQL job
+// store, which outlives restarts. The worker must be running with a job
+// store configured.
+func (c *Client) ListJobHistory(ctx context.Context, f HistoryFilter) ([]*jobworkerv1.JobHistoryEntry, error) {
+	req := &jobworkerv1.ListJobHistoryRequest{
+		Status: f.Status,
+		Labels: f.Labels,
+		Limit:  int32(f.Limit),
+	}
+	if !f.Since.IsZero() {
+		req.SinceUnix = f.Since.Unix()
+	}
+	if !f.Until.IsZero() {
+		req.UntilUnix = f.Until.Unix()
+	}
+	var resp *jobworkerv1.ListJobHistoryResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.ListJobHistory(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Jobs, nil
+}
+
+// SearchSpec describes a server-side output search.
+type SearchSpec struct {
+	// Pattern is an RE2 regular expression, or a plain substring when
+	// Literal is set.
+	Pattern         string
+	Literal         bool
+	CaseInsensitive bool
+	// MaxMatches caps the result; zero means the server default.
+	MaxMatches int
+	// ContextLines includes this many lines before and after each match.
+	ContextLines int
+}
+
+// SearchOutput greps a job's captured output on the server and returns the
+// matching lines.
+func (c *Client) SearchOutput(ctx context.Context, jobID string, spec SearchSpec) (*jobworkerv1.SearchOutputResponse, error) {
+	var resp *jobworkerv1.SearchOutputResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.SearchOutput(ctx, &jobworkerv1.SearchOutputRequest{
+			JobId:           jobID,
+			Pattern:         spec.Pattern,
+			Literal:         spec.Literal,
+			CaseInsensitive: spec.CaseInsensitive,
+			MaxMatches:      int32(spec.MaxMatches),
+			ContextLines:    int32(spec.ContextLines),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// OutputWindow selects a slice of a job's output. The zero value selects
+// everything.
+type OutputWindow struct {
+	// Offset skips this many lines from the start.
+	Offset int64
+	// Limit caps the number of lines returned; zero means no cap.
+	Limit int64
+	// TailLines returns only the last N lines; mutually exclusive with
+	// Offset.
+	TailLines int64
+	// MaxBytes caps the total size of the returned lines.
+	MaxBytes int64
+	// Since skips lines captured before this wall-clock time.
+	Since time.Time
+}
+
+// sinceUnixNanos converts the window's Since to the wire representation;
+// the zero time maps to zero (no filtering).
+func (w OutputWindow) sinceUnixNanos() int64 {
+	if w.Since.IsZero() {
+		return 0
+	}
+	return w.Since.UnixNano()
+}
+
+// GetOutput returns the job's output captured so far.
+func (c *Client) GetOutput(ctx context.Context, jobID string) ([]string, error) {
+	return c.GetOutputWindow(ctx, jobID, OutputWindow{})
+}
+
+// GetOutputWindow returns the selected slice of the job's output.
+func (c *Client) GetOutputWindow(ctx context.Context, jobID string, w OutputWindow) ([]string, error) {
+	entries, err := c.GetOutputEntries(ctx, jobID, w)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.Line
+	}
+	return lines, nil
+}
+
+// GetOutputEntries is like GetOutputWindow but keeps the per-line capture
+// timestamps.
+func (c *Client) GetOutputEntries(ctx context.Context, jobID string, w OutputWindow) ([]*jobworkerv1.OutputLine, error) {
+	var resp *jobworkerv1.GetOutputResponse
+	err := c.retry(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.svc.GetOutput(ctx, &jobworkerv1.GetOutputRequest{
+			JobId:          jobID,
+			Offset:         w.Offset,
+			Limit:          w.Limit,
+			TailLines:      w.TailLines,
+			MaxBytes:       w.MaxBytes,
+			SinceUnixNanos: w.sinceUnixNanos(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Entries, nil
+}
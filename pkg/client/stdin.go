@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// stdinChunkSize is how much of the input each streamed message carries.
+const stdinChunkSize = 64 * 1024
+
+// WriteStdin copies r into the job's standard input and closes it when r is
+// exhausted. It returns the number of bytes the server accepted.
+func (c *Client) WriteStdin(ctx context.Context, jobID string, r io.Reader) (int64, error) {
+	return c.copyStdin(ctx, jobID, r, false)
+}
+
+// copyStdin streams r into the job's stdin; keepOpen leaves the job's stdin
+// open when r is exhausted, for attach sessions that detach and come back.
+func (c *Client) copyStdin(ctx context.Context, jobID string, r io.Reader, keepOpen bool) (int64, error) {
+	stream, err := c.svc.WriteStdin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := stream.Send(&jobworkerv1.WriteStdinRequest{JobId: jobID, KeepOpen: keepOpen}); err != nil {
+		return 0, err
+	}
+	buf := make([]byte, stdinChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&jobworkerv1.WriteStdinRequest{Data: buf[:n]}); err != nil {
+				break
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("reading input: %w", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	return resp.BytesWritten, nil
+}
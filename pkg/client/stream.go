@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// OutputStream iterates over a job's output lines. Use Next to advance and
+// Err to distinguish end-of-output from failure:
+//
+//	stream, err := client.StreamOutput(ctx, jobID)
+//	...
+//	for stream.Next() {
+//	    fmt.Println(stream.Line())
+//	}
+//	if err := stream.Err(); err != nil { ... }
+//
+// When a follow breaks because the worker went away, the stream reconnects
+// with backoff and resumes from the last line it received; the caller only
+// sees an error once the retry budget is spent.
+type OutputStream struct {
+	c      *Client
+	ctx    context.Context
+	cancel context.CancelFunc
+	jobID  string
+	since  int64
+	grep   string
+	level  string
+	stream jobworkerv1.JobService_StreamOutputClient
+
+	// received counts lines accepted from the server, the resume offset
+	// after a reconnect.
+	received int64
+
+	pending []*jobworkerv1.OutputLine
+	entry   *jobworkerv1.OutputLine
+	err     error
+	done    bool
+}
+
+// StreamOutput streams the job's output from the beginning and follows it
+// until the job finishes, the context is cancelled, or Close is called.
+func (c *Client) StreamOutput(ctx context.Context, jobID string) (*OutputStream, error) {
+	return c.StreamOutputSince(ctx, jobID, time.Time{})
+}
+
+// StreamOutputSince is like StreamOutput but skips lines captured before
+// since; the zero time means everything.
+func (c *Client) StreamOutputSince(ctx context.Context, jobID string, since time.Time) (*OutputStream, error) {
+	return c.StreamOutputFiltered(ctx, jobID, since, "", "")
+}
+
+// StreamOutputFiltered is StreamOutputSince with server-side filters:
+// only lines matching the RE2 pattern grep and carrying the given log
+// level are streamed. Either filter may be empty to pass everything.
+func (c *Client) StreamOutputFiltered(ctx context.Context, jobID string, since time.Time, grep, level string) (*OutputStream, error) {
+	var sinceNanos int64
+	if !since.IsZero() {
+		sinceNanos = since.UnixNano()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &OutputStream{c: c, ctx: ctx, cancel: cancel, jobID: jobID, since: sinceNanos, grep: grep, level: level}
+	stream, err := s.open()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s.stream = stream
+	return s, nil
+}
+
+// open starts a server stream resuming at the lines received so far.
+func (s *OutputStream) open() (jobworkerv1.JobService_StreamOutputClient, error) {
+	return s.c.svc.StreamOutput(s.ctx, &jobworkerv1.StreamOutputRequest{
+		JobId:          s.jobID,
+		SinceUnixNanos: s.since,
+		Offset:         s.received,
+		Grep:           s.grep,
+		Level:          s.level,
+	})
+}
+
+// Next advances to the next output line, blocking until one is available.
+// It returns false when the stream ends or fails.
+func (s *OutputStream) Next() bool {
+	if s.done {
+		return false
+	}
+	for len(s.pending) == 0 {
+		chunk, err := s.stream.Recv()
+		if err != nil {
+			if s.reconnect(err) {
+				continue
+			}
+			s.done = true
+			if !errors.Is(err, io.EOF) {
+				s.err = err
+			}
+			return false
+		}
+		s.pending = chunk.Entries
+		s.received += int64(len(chunk.Entries))
+	}
+	s.entry = s.pending[0]
+	s.pending = s.pending[1:]
+	return true
+}
+
+// reconnect tries to resume the stream after a transient failure, backing
+// off between attempts. It reports whether a new stream is in place.
+func (s *OutputStream) reconnect(recvErr error) bool {
+	if status.Code(recvErr) != codes.Unavailable {
+		return false
+	}
+	delay := retryBaseDelay
+	for attempt := 0; attempt < s.c.cfg.RetryAttempts; attempt++ {
+		select {
+		case <-time.After(delay):
+		case <-s.ctx.Done():
+			return false
+		}
+		delay *= 2
+		stream, err := s.open()
+		if err == nil {
+			s.stream = stream
+			return true
+		}
+		if status.Code(err) != codes.Unavailable {
+			return false
+		}
+	}
+	return false
+}
+
+// Line returns the line read by the most recent successful Next.
+func (s *OutputStream) Line() string {
+	return s.entry.GetLine()
+}
+
+// Entry returns the line read by the most recent successful Next together
+// with its capture timestamps.
+func (s *OutputStream) Entry() *jobworkerv1.OutputLine {
+	return s.entry
+}
+
+// Err returns the first error encountered, or nil if the stream ended
+// because the job finished.
+func (s *OutputStream) Err() error {
+	return s.err
+}
+
+// Close releases the stream early. It is safe to call multiple times.
+func (s *OutputStream) Close() {
+	s.cancel()
+	s.done = true
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// uploadChunkSize is how much of a file each streamed message carries.
+const uploadChunkSize = 64 * 1024
+
+// UploadFile names one local file to ship into a job's workspace.
+type UploadFile struct {
+	// LocalPath is the file to read on this machine.
+	LocalPath string
+	// Path is the destination, relative to the job's workspace,
+	// slash-separated.
+	Path string
+}
+
+// UploadInput stages the files on the server and returns the upload ID to
+// pass in StartSpec.UploadID. Each file's size and SHA-256 checksum are sent
+// along so the server can verify the transfer.
+func (c *Client) UploadInput(ctx context.Context, files []UploadFile) (string, error) {
+	stream, err := c.svc.UploadInput(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if err := sendFile(stream, file); err != nil {
+			return "", err
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+	return resp.UploadId, nil
+}
+
+func sendFile(stream jobworkerv1.JobService_UploadInputClient, file UploadFile) error {
+	f, err := os.Open(file.LocalPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	err = stream.Send(&jobworkerv1.UploadInputRequest{
+		Msg: &jobworkerv1.UploadInputRequest_File{File: &jobworkerv1.UploadFile{
+			Path:      file.Path,
+			SizeBytes: info.Size(),
+			Sha256:    hex.EncodeToString(h.Sum(nil)),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			chunk := &jobworkerv1.UploadInputRequest{
+				Msg: &jobworkerv1.UploadInputRequest_Data{Data: buf[:n]},
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", file.LocalPath, err)
+		}
+	}
+}
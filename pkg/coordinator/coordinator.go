@@ -0,0 +1,246 @@
+package coordinator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/leader"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+// heartbeatInterval is what registered workers are told to heartbeat at.
+const heartbeatInterval = 10 * time.Second
+
+// Config holds everything needed to build a Coordinator.
+type Config struct {
+	// Addr is the address the coordinator listens on for both client and
+	// worker traffic.
+	Addr string
+
+	// CACertFile, CertFile, and KeyFile configure mutual TLS, exactly as
+	// on the worker. The same key pair is also used as the client
+	// certificate when the coordinator dials workers.
+	CACertFile string
+	CertFile   string
+	KeyFile    string
+
+	// TunnelAddr optionally accepts reverse-tunnel connections from
+	// workers behind NAT; see pkg/coordinator/tunnel.go. Empty disables
+	// the tunnel listener.
+	TunnelAddr string
+
+	// EtcdEndpoints enables HA mode: job placements are shared through
+	// etcd and replicas elect a scheduling leader. Empty runs a single
+	// self-contained coordinator.
+	EtcdEndpoints []string
+	// ReplicaName identifies this replica in the election; defaults to
+	// the hostname.
+	ReplicaName string
+
+	// Scheduler places start requests on workers; nil means LeastLoaded.
+	Scheduler Scheduler
+}
+
+// CheckAndSetDefaults validates the config and fills in defaults.
+func (c *Config) CheckAndSetDefaults() error {
+	if c.Addr == "" {
+		return fmt.Errorf("listen address is required")
+	}
+	if c.CACertFile == "" || c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("ca-cert, cert, and key are all required for mutual TLS")
+	}
+	if c.Scheduler == nil {
+		c.Scheduler = LeastLoaded{}
+	}
+	return nil
+}
+
+// Coordinator serves JobService to clients and WorkerService to workers.
+type Coordinator struct {
+	cfg     Config
+	grpc    *grpc.Server
+	nodes   *nodeRegistry
+	tunnels *tunnelRegistry
+
+	// etcd and elector are set only in HA mode.
+	etcd    *clientv3.Client
+	elector *leader.Elector
+
+	// migrator re-runs restartable jobs off dead workers; see migrate.go.
+	migrator      *migrator
+	migrateCancel context.CancelFunc
+
+	// serverTLS secures inbound listeners; workerTLS is the client TLS
+	// config used to dial workers.
+	serverTLS *tls.Config
+	workerTLS *tls.Config
+}
+
+// clientFor returns a JobService client for the node, dialing either
+// directly or through the node's reverse tunnel.
+func (c *Coordinator) clientFor(n *Node) (jobworkerv1.JobServiceClient, error) {
+	unaryVersion, streamVersion := version.ClientInterceptors(nil)
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unaryVersion),
+		grpc.WithChainStreamInterceptor(streamVersion),
+	}
+	if strings.HasPrefix(n.Addr, TunnelAddrPrefix) {
+		// The inner connection still runs TLS end to end; verify the
+		// worker certificate against its registered name.
+		tlsConfig := c.workerTLS.Clone()
+		tlsConfig.ServerName = strings.TrimPrefix(n.Addr, TunnelAddrPrefix)
+		opts = append(opts,
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			grpc.WithContextDialer(c.tunnelDialer),
+		)
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(c.workerTLS)))
+	}
+	return n.client(opts...)
+}
+
+// New builds a Coordinator; call Serve to start accepting connections.
+func New(cfg Config) (*Coordinator, error) {
+	if err := cfg.CheckAndSetDefaults(); err != nil {
+		return nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading coordinator key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(cfg.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates parsed from %s", cfg.CACertFile)
+	}
+	serverTLS := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS13,
+	}
+
+	unaryInterceptor, streamInterceptor := auth.Interceptors("")
+	unaryVersion, streamVersion := version.ServerInterceptors()
+	c := &Coordinator{
+		cfg:       cfg,
+		nodes:     newNodeRegistry(),
+		tunnels:   newTunnelRegistry(),
+		serverTLS: serverTLS,
+		workerTLS: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+			MinVersion:   tls.VersionTLS13,
+		},
+		grpc: grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(serverTLS)),
+			grpc.ChainUnaryInterceptor(unaryInterceptor, unaryVersion),
+			grpc.ChainStreamInterceptor(streamInterceptor, streamVersion),
+		),
+	}
+	var routes routeStore = newMemoryRoutes()
+	if len(cfg.EtcdEndpoints) > 0 {
+		etcdClient, err := clientv3.New(clientv3.Config{
+			Endpoints:   cfg.EtcdEndpoints,
+			DialTimeout: 5 * time.Second,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("connecting to etcd: %w", err)
+		}
+		c.etcd = etcdClient
+		routes = &etcdRoutes{client: etcdClient}
+		name := cfg.ReplicaName
+		if name == "" {
+			name, _ = os.Hostname()
+		}
+		c.elector = leader.NewWithClient(etcdClient, name)
+	}
+
+	c.migrator = newMigrator(c, routes)
+	jobworkerv1.RegisterWorkerServiceServer(c.grpc, &workerServiceServer{nodes: c.nodes})
+	jobworkerv1.RegisterJobServiceServer(c.grpc, &proxyServer{coord: c, routes: routes})
+	return c, nil
+}
+
+// isSchedulingLeader reports whether this replica may place new jobs. A
+// single-replica coordinator always is.
+func (c *Coordinator) isSchedulingLeader() bool {
+	return c.elector == nil || c.elector.IsLeader()
+}
+
+// ListenAndServe listens on the configured address and serves until Stop.
+func (c *Coordinator) ListenAndServe() error {
+	lis, err := net.Listen("tcp", c.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", c.cfg.Addr, err)
+	}
+	return c.Serve(lis)
+}
+
+// Serve accepts connections on lis until Stop is called. If a tunnel
+// address is configured, the reverse-tunnel listener runs alongside.
+func (c *Coordinator) Serve(lis net.Listener) error {
+	if c.elector != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go c.elector.Run(ctx)
+	}
+	migrateCtx, cancel := context.WithCancel(context.Background())
+	c.migrateCancel = cancel
+	go c.migrator.run(migrateCtx)
+	if c.cfg.TunnelAddr != "" {
+		tunnelLis, err := tls.Listen("tcp", c.cfg.TunnelAddr, c.serverTLS)
+		if err != nil {
+			return fmt.Errorf("listening for tunnels on %s: %w", c.cfg.TunnelAddr, err)
+		}
+		defer tunnelLis.Close()
+		go c.serveTunnels(tunnelLis)
+	}
+	return c.grpc.Serve(lis)
+}
+
+// Stop gracefully stops the coordinator.
+func (c *Coordinator) Stop() {
+	if c.migrateCancel != nil {
+		c.migrateCancel()
+	}
+	c.grpc.GracefulStop()
+	if c.etcd != nil {
+		_ = c.etcd.Close()
+	}
+}
+
+// workerServiceServer implements the internal worker registration API.
+type workerServiceServer struct {
+	jobworkerv1.UnimplementedWorkerServiceServer
+
+	nodes *nodeRegistry
+}
+
+func (s *workerServiceServer) RegisterWorker(ctx context.Context, req *jobworkerv1.RegisterWorkerRequest) (*jobworkerv1.RegisterWorkerResponse, error) {
+	s.nodes.register(req)
+	return &jobworkerv1.RegisterWorkerResponse{
+		HeartbeatIntervalSeconds: int64(heartbeatInterval / time.Second),
+	}, nil
+}
+
+func (s *workerServiceServer) Heartbeat(ctx context.Context, req *jobworkerv1.HeartbeatRequest) (*jobworkerv1.HeartbeatResponse, error) {
+	return &jobworkerv1.HeartbeatResponse{
+		Registered: s.nodes.heartbeat(req),
+	}, nil
+}
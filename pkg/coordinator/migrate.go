@@ -0,0 +1,201 @@
+package coordinator
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+)
+
+// migrateCheckInterval is how often placements are swept for dead
+// workers; one heartbeat period keeps detection prompt without hammering
+// live workers with status probes.
+const migrateCheckInterval = heartbeatInterval
+
+// migrateRPCTimeout bounds each RPC the sweep makes.
+const migrateRPCTimeout = 30 * time.Second
+
+// restartable reports whether the job declared itself safe to relaunch;
+// only these are re-run elsewhere after a node failure.
+func restartable(policy string) bool {
+	return policy != "" && policy != "never"
+}
+
+// placement remembers enough of a start request to re-run the job on
+// another worker.
+type placement struct {
+	req      *jobworkerv1.StartJobRequest
+	identity string
+	roles    []string
+	node     string
+}
+
+// migrator re-runs restartable jobs whose worker stopped heartbeating,
+// so a transient node failure does not need an operator to notice and
+// resubmit. A job that finished right as its node died cannot be told
+// apart from a lost one, so restartable jobs must tolerate an extra run
+// — the same contract their restart policy already imposes.
+type migrator struct {
+	coord  *Coordinator
+	routes routeStore
+
+	mu         sync.Mutex
+	placements map[string]*placement
+}
+
+func newMigrator(c *Coordinator, routes routeStore) *migrator {
+	return &migrator{coord: c, routes: routes, placements: make(map[string]*placement)}
+}
+
+// track remembers where a restartable job was placed; starts with a
+// "never" policy are ignored.
+func (m *migrator) track(jobID string, req *jobworkerv1.StartJobRequest, identity string, roles []string, node string) {
+	if !restartable(req.RestartPolicy) {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.placements[jobID] = &placement{req: req, identity: identity, roles: roles, node: node}
+}
+
+// forget drops a job from migration tracking, e.g. once it is stopped.
+func (m *migrator) forget(jobID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.placements, jobID)
+}
+
+// run sweeps until ctx is cancelled.
+func (m *migrator) run(ctx context.Context) {
+	ticker := time.NewTicker(migrateCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.sweep(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sweep finds placements whose worker is dead and re-runs them, and
+// drops tracked jobs that finished on live workers so they are never
+// replayed after a later failure.
+func (m *migrator) sweep(ctx context.Context) {
+	if !m.coord.isSchedulingLeader() {
+		return
+	}
+	m.mu.Lock()
+	lost := make(map[string]*placement)
+	live := make(map[string]*placement)
+	for id, p := range m.placements {
+		n, ok := m.coord.nodes.get(p.node)
+		if !ok || !n.Alive(nodeStaleAfter) {
+			lost[id] = p
+			delete(m.placements, id)
+		} else {
+			live[id] = p
+		}
+	}
+	m.mu.Unlock()
+
+	for id, p := range live {
+		m.pruneFinished(ctx, id, p)
+	}
+	for id, p := range lost {
+		m.migrate(ctx, id, p)
+	}
+}
+
+// pruneFinished forgets a tracked job once its worker reports it in a
+// terminal state.
+func (m *migrator) pruneFinished(ctx context.Context, jobID string, p *placement) {
+	n, ok := m.coord.nodes.get(p.node)
+	if !ok {
+		return
+	}
+	client, err := m.coord.clientFor(n)
+	if err != nil {
+		return
+	}
+	rctx, cancel := context.WithTimeout(m.callerContext(ctx, p), migrateRPCTimeout)
+	defer cancel()
+	resp, err := client.GetJobStatus(rctx, &jobworkerv1.GetJobStatusRequest{JobId: jobID})
+	if err != nil {
+		return
+	}
+	switch resp.Job.GetStatus() {
+	case jobworkerv1.JobStatus_JOB_STATUS_RUNNING, jobworkerv1.JobStatus_JOB_STATUS_PAUSED:
+	default:
+		m.forget(jobID)
+	}
+}
+
+// migrate re-runs one lost job on a healthy worker, linking the new
+// attempt to the original through its RescheduledFrom field. Failures
+// put the placement back so the next sweep retries.
+func (m *migrator) migrate(ctx context.Context, jobID string, p *placement) {
+	log.Printf("job %s lost: worker %s stopped heartbeating; rescheduling", jobID, p.node)
+	candidates := m.coord.nodes.alive()
+	if len(p.req.NodeSelector) > 0 {
+		var matched []*Node
+		for _, n := range candidates {
+			if n.MatchesSelector(p.req.NodeSelector) {
+				matched = append(matched, n)
+			}
+		}
+		candidates = matched
+	}
+	node, err := m.coord.cfg.Scheduler.Pick(candidates)
+	if err != nil {
+		m.requeue(jobID, p, err)
+		return
+	}
+	client, err := m.coord.clientFor(node)
+	if err != nil {
+		m.requeue(jobID, p, err)
+		return
+	}
+	req := proto.Clone(p.req).(*jobworkerv1.StartJobRequest)
+	req.IdempotencyKey = ""
+	req.RescheduledFrom = jobID
+	rctx, cancel := context.WithTimeout(m.callerContext(ctx, p), migrateRPCTimeout)
+	defer cancel()
+	resp, err := client.StartJob(rctx, req)
+	if err != nil {
+		m.requeue(jobID, p, err)
+		return
+	}
+	if err := m.routes.put(resp.JobId, node.Name); err != nil {
+		log.Printf("job %s rescheduled as %s but route not persisted: %v", jobID, resp.JobId, err)
+	}
+	m.track(resp.JobId, p.req, p.identity, p.roles, node.Name)
+	log.Printf("job %s rescheduled as %s on worker %s", jobID, resp.JobId, node.Name)
+}
+
+// requeue logs a failed migration and puts the placement back for the
+// next sweep.
+func (m *migrator) requeue(jobID string, p *placement, err error) {
+	log.Printf("rescheduling job %s: %v; will retry", jobID, err)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.placements[jobID] = p
+}
+
+// callerContext rebuilds the forwarded-identity metadata the original
+// caller's RPC carried, so the worker applies its normal authorization
+// and limit policy to the re-run.
+func (m *migrator) callerContext(ctx context.Context, p *placement) context.Context {
+	kv := []string{auth.ForwardedIdentityHeader, p.identity}
+	for _, role := range p.roles {
+		kv = append(kv, auth.ForwardedRolesHeader, role)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
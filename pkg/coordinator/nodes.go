@@ -0,0 +1,164 @@
+// Package coordinator implements the control plane for a multi-node
+// deployment: workers register and heartbeat over WorkerService, and the
+// coordinator serves the public JobService by scheduling start requests
+// onto workers and routing job RPCs to the worker running each job.
+package coordinator
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// Node is a registered worker.
+type Node struct {
+	Name   string
+	Addr   string
+	Labels map[string]string
+	// Version, Cpus, MemTotal, and GpusTotal are the capacity the worker
+	// declared at registration.
+	Version   string
+	Cpus      int64
+	MemTotal  int64
+	GpusTotal int32
+
+	mu            sync.Mutex
+	conn          *grpc.ClientConn
+	lastHeartbeat time.Time
+	runningJobs   int64
+	memFree       int64
+	gpusFree      int32
+}
+
+// client returns a JobService client for the node, dialing lazily with the
+// given options and reusing the connection.
+func (n *Node) client(opts ...grpc.DialOption) (jobworkerv1.JobServiceClient, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn == nil {
+		conn, err := grpc.Dial(n.Addr, opts...)
+		if err != nil {
+			return nil, err
+		}
+		n.conn = conn
+	}
+	return jobworkerv1.NewJobServiceClient(n.conn), nil
+}
+
+// MatchesSelector reports whether every selector entry is present in the
+// node's labels with the same value. An empty selector matches all nodes.
+func (n *Node) MatchesSelector(selector map[string]string) bool {
+	for k, v := range selector {
+		if n.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// RunningJobs returns the load reported by the node's last heartbeat.
+func (n *Node) RunningJobs() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.runningJobs
+}
+
+// Headroom returns the free memory and GPUs reported by the node's last
+// heartbeat, and when that heartbeat arrived.
+func (n *Node) Headroom() (memFree int64, gpusFree int32, at time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.memFree, n.gpusFree, n.lastHeartbeat
+}
+
+// Alive reports whether the node heartbeated within the staleness window.
+func (n *Node) Alive(window time.Duration) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return time.Since(n.lastHeartbeat) < window
+}
+
+func (n *Node) close() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		_ = n.conn.Close()
+		n.conn = nil
+	}
+}
+
+// nodeStaleAfter is how long after the last heartbeat a node stops being
+// scheduled onto.
+const nodeStaleAfter = 30 * time.Second
+
+// nodeRegistry tracks registered workers.
+type nodeRegistry struct {
+	mu    sync.RWMutex
+	nodes map[string]*Node
+}
+
+func newNodeRegistry() *nodeRegistry {
+	return &nodeRegistry{nodes: make(map[string]*Node)}
+}
+
+// register adds or replaces a node.
+func (r *nodeRegistry) register(req *jobworkerv1.RegisterWorkerRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.nodes[req.Name]; ok {
+		old.close()
+	}
+	r.nodes[req.Name] = &Node{
+		Name:          req.Name,
+		Addr:          req.Addr,
+		Labels:        req.Labels,
+		Version:       req.Version,
+		Cpus:          req.Cpus,
+		MemTotal:      req.MemTotalBytes,
+		GpusTotal:     req.GpusTotal,
+		gpusFree:      req.GpusTotal,
+		lastHeartbeat: time.Now(),
+	}
+}
+
+// heartbeat refreshes a node's liveness and headroom; it reports false
+// for unknown nodes so the worker re-registers.
+func (r *nodeRegistry) heartbeat(req *jobworkerv1.HeartbeatRequest) bool {
+	r.mu.RLock()
+	n, ok := r.nodes[req.Name]
+	r.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	n.mu.Lock()
+	n.lastHeartbeat = time.Now()
+	n.runningJobs = req.RunningJobs
+	n.memFree = req.MemFreeBytes
+	n.gpusFree = req.GpusFree
+	n.mu.Unlock()
+	return true
+}
+
+// alive returns all nodes with a fresh heartbeat.
+func (r *nodeRegistry) alive() []*Node {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*Node
+	for _, n := range r.nodes {
+		if n.Alive(nodeStaleAfter) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// get returns the node by name, alive or not.
+func (r *nodeRegistry) get(name string) (*Node, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n, ok := r.nodes[name]
+	return n, ok
+}
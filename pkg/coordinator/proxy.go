@@ -0,0 +1,844 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+// proxyServer serves the public JobService by routing every RPC to the
+// worker that owns the job. Job placement is recorded at StartJob time.
+type proxyServer struct {
+	jobworkerv1.UnimplementedJobServiceServer
+
+	coord *Coordinator
+
+	// routes records which worker runs each job; shared through etcd in
+	// HA mode so every replica can route.
+	routes routeStore
+
+	// idemMu guards idem, which maps owner-scoped idempotency keys to the
+	// job they started. Without it a replayed StartJob could be scheduled
+	// onto a different worker than the original, defeating the worker's
+	// own deduplication.
+	idemMu sync.Mutex
+	idem   map[string]proxyIdemEntry
+
+	// upMu guards uploadNodes, which pins each forwarded upload to the
+	// worker that staged it so the subsequent StartJob lands there too.
+	upMu        sync.Mutex
+	uploadNodes map[string]uploadPin
+}
+
+// proxyUploadTTL matches the worker-side staging window.
+const proxyUploadTTL = time.Hour
+
+type uploadPin struct {
+	node    string
+	expires time.Time
+}
+
+// pinUpload records which worker holds a staged upload, pruning expired
+// pins as it goes.
+func (p *proxyServer) pinUpload(uploadID, node string) {
+	p.upMu.Lock()
+	defer p.upMu.Unlock()
+	now := time.Now()
+	for id, pin := range p.uploadNodes {
+		if now.After(pin.expires) {
+			delete(p.uploadNodes, id)
+		}
+	}
+	if p.uploadNodes == nil {
+		p.uploadNodes = make(map[string]uploadPin)
+	}
+	p.uploadNodes[uploadID] = uploadPin{node: node, expires: now.Add(proxyUploadTTL)}
+}
+
+// pinnedNode returns the worker holding a staged upload, consuming the pin.
+func (p *proxyServer) pinnedNode(uploadID string) (string, bool) {
+	p.upMu.Lock()
+	defer p.upMu.Unlock()
+	pin, ok := p.uploadNodes[uploadID]
+	if !ok || time.Now().After(pin.expires) {
+		return "", false
+	}
+	delete(p.uploadNodes, uploadID)
+	return pin.node, true
+}
+
+// proxyIdemTTL matches the worker-side idempotency window.
+const proxyIdemTTL = 24 * time.Hour
+
+type proxyIdemEntry struct {
+	jobID   string
+	expires time.Time
+}
+
+// replayedJob returns the job a previously seen idempotency key started, if
+// any. key is already owner-scoped.
+func (p *proxyServer) replayedJob(key string) (string, bool) {
+	p.idemMu.Lock()
+	defer p.idemMu.Unlock()
+	e, ok := p.idem[key]
+	if !ok || time.Now().After(e.expires) {
+		return "", false
+	}
+	return e.jobID, true
+}
+
+// rememberKey records which job an idempotency key started, pruning expired
+// entries as it goes.
+func (p *proxyServer) rememberKey(key, jobID string) {
+	p.idemMu.Lock()
+	defer p.idemMu.Unlock()
+	now := time.Now()
+	for k, e := range p.idem {
+		if now.After(e.expires) {
+			delete(p.idem, k)
+		}
+	}
+	if p.idem == nil {
+		p.idem = make(map[string]proxyIdemEntry)
+	}
+	p.idem[key] = proxyIdemEntry{jobID: jobID, expires: now.Add(proxyIdemTTL)}
+}
+
+// resolveRef maps a job reference (ID, name, or unique ID prefix) to the
+// full job ID. Exact IDs hit the route store directly; anything else is
+// resolved by asking the live workers for the caller's jobs.
+func (p *proxyServer) resolveRef(fctx context.Context, ref string) (string, error) {
+	if _, ok := p.routes.get(ref); ok {
+		return ref, nil
+	}
+	seen := map[string]bool{}
+	var matches []string
+	for _, node := range p.coord.nodes.alive() {
+		client, err := p.coord.clientFor(node)
+		if err != nil {
+			continue
+		}
+		resp, err := client.ListJobs(fctx, &jobworkerv1.ListJobsRequest{})
+		if err != nil {
+			continue
+		}
+		for _, j := range resp.Jobs {
+			if seen[j.Id] {
+				continue
+			}
+			if (j.Name != "" && j.Name == ref) || strings.HasPrefix(j.Id, ref) {
+				seen[j.Id] = true
+				matches = append(matches, j.Id)
+			}
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return "", status.Errorf(codes.NotFound, "job not found: %s", ref)
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "ambiguous job reference %q matches %d jobs", ref, len(matches))
+	}
+}
+
+func (p *proxyServer) routeFor(jobID string) (*Node, error) {
+	name, ok := p.routes.get(jobID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job not found: %s", jobID)
+	}
+	node, ok := p.coord.nodes.get(name)
+	if !ok {
+		return nil, status.Errorf(codes.Unavailable, "worker %s is gone", name)
+	}
+	return node, nil
+}
+
+// forwardContext builds the outgoing context for a worker RPC, carrying the
+// end user's identity and roles so the worker applies its normal
+// authorization and limit policy.
+func forwardContext(ctx context.Context) (context.Context, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kv := []string{auth.ForwardedIdentityHeader, identity}
+	for _, role := range auth.RolesFromContext(ctx) {
+		kv = append(kv, auth.ForwardedRolesHeader, role)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...), nil
+}
+
+func (p *proxyServer) StartJob(ctx context.Context, req *jobworkerv1.StartJobRequest) (*jobworkerv1.StartJobResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.coord.isSchedulingLeader() {
+		return nil, status.Error(codes.Unavailable, "not the scheduling leader; retry against another replica")
+	}
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var idemKey string
+	if req.IdempotencyKey != "" {
+		idemKey = identity + "\x00" + req.IdempotencyKey
+		if jobID, ok := p.replayedJob(idemKey); ok {
+			return &jobworkerv1.StartJobResponse{JobId: jobID}, nil
+		}
+	}
+	candidates := p.coord.nodes.alive()
+	if len(req.NodeSelector) > 0 {
+		var matched []*Node
+		for _, n := range candidates {
+			if n.MatchesSelector(req.NodeSelector) {
+				matched = append(matched, n)
+			}
+		}
+		if len(matched) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "no nodes match the selector")
+		}
+		candidates = matched
+	}
+	var node *Node
+	if req.UploadId != "" {
+		// The staged input lives on one worker; the job must run there.
+		name, ok := p.pinnedNode(req.UploadId)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "upload not found: %s", req.UploadId)
+		}
+		for _, n := range candidates {
+			if n.Name == name {
+				node = n
+				break
+			}
+		}
+		if node == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "worker %s holding the upload is unavailable or does not match the selector", name)
+		}
+	} else {
+		node, err = p.coord.cfg.Scheduler.Pick(candidates)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	resp, err := client.StartJob(fctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.routes.put(resp.JobId, node.Name); err != nil {
+		// The job is running; surface the routing failure rather than
+		// pretending the job does not exist.
+		return nil, status.Errorf(codes.Internal, "job %s started but route not persisted: %v", resp.JobId, err)
+	}
+	if idemKey != "" {
+		p.rememberKey(idemKey, resp.JobId)
+	}
+	p.coord.migrator.track(resp.JobId, req, identity, auth.RolesFromContext(ctx), node.Name)
+	return resp, nil
+}
+
+// StartBatch schedules each job of the batch independently through StartJob.
+// Atomic mode stops every job already placed when one placement fails.
+func (p *proxyServer) StartBatch(ctx context.Context, req *jobworkerv1.StartBatchRequest) (*jobworkerv1.StartBatchResponse, error) {
+	if len(req.Jobs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch has no jobs")
+	}
+	var started []string
+	resp := &jobworkerv1.StartBatchResponse{
+		Results: make([]*jobworkerv1.StartBatchResult, len(req.Jobs)),
+	}
+	for i, jr := range req.Jobs {
+		if len(jr.NodeSelector) == 0 {
+			jr.NodeSelector = req.NodeSelector
+		}
+		startResp, err := p.StartJob(ctx, jr)
+		if err != nil {
+			if req.Atomic {
+				for _, id := range started {
+					_, _ = p.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: id})
+				}
+				return nil, status.Errorf(status.Code(err), "job %d: %v", i, err)
+			}
+			resp.Results[i] = &jobworkerv1.StartBatchResult{Error: err.Error()}
+			continue
+		}
+		started = append(started, startResp.JobId)
+		resp.Results[i] = &jobworkerv1.StartBatchResult{JobId: startResp.JobId}
+	}
+	return resp, nil
+}
+
+// RunTemplate schedules like StartJob; the template itself lives on the
+// chosen worker, which validates the parameters.
+func (p *proxyServer) RunTemplate(ctx context.Context, req *jobworkerv1.RunTemplateRequest) (*jobworkerv1.RunTemplateResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.coord.isSchedulingLeader() {
+		return nil, status.Error(codes.Unavailable, "not the scheduling leader; retry against another replica")
+	}
+	candidates := p.coord.nodes.alive()
+	if len(req.NodeSelector) > 0 {
+		var matched []*Node
+		for _, n := range candidates {
+			if n.MatchesSelector(req.NodeSelector) {
+				matched = append(matched, n)
+			}
+		}
+		if len(matched) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "no nodes match the selector")
+		}
+		candidates = matched
+	}
+	node, err := p.coord.cfg.Scheduler.Pick(candidates)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	resp, err := client.RunTemplate(fctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.routes.put(resp.JobId, node.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "job %s started but route not persisted: %v", resp.JobId, err)
+	}
+	return resp, nil
+}
+
+// ListTemplates fans out to every live worker and merges the results,
+// deduplicating by name.
+func (p *proxyServer) ListTemplates(ctx context.Context, req *jobworkerv1.ListTemplatesRequest) (*jobworkerv1.ListTemplatesResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var out jobworkerv1.ListTemplatesResponse
+	for _, node := range p.coord.nodes.alive() {
+		client, err := p.coord.clientFor(node)
+		if err != nil {
+			continue
+		}
+		resp, err := client.ListTemplates(fctx, req)
+		if err != nil {
+			continue
+		}
+		for _, t := range resp.Templates {
+			if !seen[t.Name] {
+				seen[t.Name] = true
+				out.Templates = append(out.Templates, t)
+			}
+		}
+	}
+	return &out, nil
+}
+
+func (p *proxyServer) StopJob(ctx context.Context, req *jobworkerv1.StopJobRequest) (*jobworkerv1.StopJobResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	resp, err := client.StopJob(fctx, req)
+	if err == nil {
+		// A stopped job must never be replayed onto another worker.
+		p.coord.migrator.forget(jobID)
+	}
+	return resp, err
+}
+
+func (p *proxyServer) PauseJob(ctx context.Context, req *jobworkerv1.PauseJobRequest) (*jobworkerv1.PauseJobResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.PauseJob(fctx, req)
+}
+
+func (p *proxyServer) ResumeJob(ctx context.Context, req *jobworkerv1.ResumeJobRequest) (*jobworkerv1.ResumeJobResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.ResumeJob(fctx, req)
+}
+
+func (p *proxyServer) GetJobStatus(ctx context.Context, req *jobworkerv1.GetJobStatusRequest) (*jobworkerv1.GetJobStatusResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.GetJobStatus(fctx, req)
+}
+
+func (p *proxyServer) WaitJob(ctx context.Context, req *jobworkerv1.WaitJobRequest) (*jobworkerv1.WaitJobResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.WaitJob(fctx, req)
+}
+
+func (p *proxyServer) GetOutput(ctx context.Context, req *jobworkerv1.GetOutputRequest) (*jobworkerv1.GetOutputResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.GetOutput(fctx, req)
+}
+
+func (p *proxyServer) SearchOutput(ctx context.Context, req *jobworkerv1.SearchOutputRequest) (*jobworkerv1.SearchOutputResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.SearchOutput(fctx, req)
+}
+
+// ListJobs fans out to every live worker and merges the results. Workers
+// that fail to answer are skipped: a partial listing beats a failed one.
+func (p *proxyServer) ListJobs(ctx context.Context, req *jobworkerv1.ListJobsRequest) (*jobworkerv1.ListJobsResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out jobworkerv1.ListJobsResponse
+	for _, node := range p.coord.nodes.alive() {
+		client, err := p.coord.clientFor(node)
+		if err != nil {
+			continue
+		}
+		resp, err := client.ListJobs(fctx, req)
+		if err != nil {
+			continue
+		}
+		out.Jobs = append(out.Jobs, resp.Jobs...)
+	}
+	return &out, nil
+}
+
+func (p *proxyServer) StreamOutput(req *jobworkerv1.StreamOutputRequest, stream jobworkerv1.JobService_StreamOutputServer) error {
+	fctx, err := forwardContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	upstream, err := client.StreamOutput(fctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *proxyServer) ListArtifacts(ctx context.Context, req *jobworkerv1.ListArtifactsRequest) (*jobworkerv1.ListArtifactsResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	return client.ListArtifacts(fctx, req)
+}
+
+func (p *proxyServer) DownloadArtifact(req *jobworkerv1.DownloadArtifactRequest, stream jobworkerv1.JobService_DownloadArtifactServer) error {
+	fctx, err := forwardContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	jobID, err := p.resolveRef(fctx, req.JobId)
+	if err != nil {
+		return err
+	}
+	req.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	upstream, err := client.DownloadArtifact(fctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// UploadInput forwards the upload stream to one worker, chosen now, and
+// pins the returned upload ID to it so StartJob schedules the job there.
+func (p *proxyServer) UploadInput(stream jobworkerv1.JobService_UploadInputServer) error {
+	fctx, err := forwardContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	if !p.coord.isSchedulingLeader() {
+		return status.Error(codes.Unavailable, "not the scheduling leader; retry against another replica")
+	}
+	node, err := p.coord.cfg.Scheduler.Pick(p.coord.nodes.alive())
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	upstream, err := client.UploadInput(fctx)
+	if err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := upstream.Send(req); err != nil {
+			return err
+		}
+	}
+	resp, err := upstream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	p.pinUpload(resp.UploadId, node.Name)
+	return stream.SendAndClose(resp)
+}
+
+// WriteStdin routes the stdin stream to the worker running the job named
+// in the first message.
+func (p *proxyServer) WriteStdin(stream jobworkerv1.JobService_WriteStdinServer) error {
+	fctx, err := forwardContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	jobID, err := p.resolveRef(fctx, first.JobId)
+	if err != nil {
+		return err
+	}
+	first.JobId = jobID
+	node, err := p.routeFor(jobID)
+	if err != nil {
+		return err
+	}
+	client, err := p.coord.clientFor(node)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "dialing worker %s: %v", node.Name, err)
+	}
+	upstream, err := client.WriteStdin(fctx)
+	if err != nil {
+		return err
+	}
+	if err := upstream.Send(first); err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := upstream.Send(req); err != nil {
+			return err
+		}
+	}
+	resp, err := upstream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// GetVersion reports the coordinator's own build identity; workers may run
+// different builds.
+func (p *proxyServer) GetVersion(ctx context.Context, _ *jobworkerv1.GetVersionRequest) (*jobworkerv1.GetVersionResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return &jobworkerv1.GetVersionResponse{
+		Version:      version.Version,
+		Commit:       version.Commit,
+		BuildDate:    version.BuildDate,
+		Capabilities: version.Capabilities,
+	}, nil
+}
+
+// GetDiskUsage sums the disk usage of every live worker; workers without
+// an on-disk log store are skipped.
+func (p *proxyServer) GetDiskUsage(ctx context.Context, req *jobworkerv1.GetDiskUsageRequest) (*jobworkerv1.GetDiskUsageResponse, error) {
+	fctx, err := forwardContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out jobworkerv1.GetDiskUsageResponse
+	for _, node := range p.coord.nodes.alive() {
+		client, err := p.coord.clientFor(node)
+		if err != nil {
+			continue
+		}
+		resp, err := client.GetDiskUsage(fctx, req)
+		if err != nil {
+			continue
+		}
+		out.UsedBytes += resp.UsedBytes
+		out.BudgetBytes += resp.BudgetBytes
+		out.EvictedJobs += resp.EvictedJobs
+	}
+	return &out, nil
+}
+
+// ListNodes reports every registered node, including stale ones, so
+// operators can see which workers have dropped out.
+func (p *proxyServer) ListNodes(ctx context.Context, _ *jobworkerv1.ListNodesRequest) (*jobworkerv1.ListNodesResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	var out jobworkerv1.ListNodesResponse
+	p.coord.nodes.mu.RLock()
+	defer p.coord.nodes.mu.RUnlock()
+	for _, n := range p.coord.nodes.nodes {
+		out.Nodes = append(out.Nodes, nodeToProto(n))
+	}
+	return &out, nil
+}
+
+// GetNode reports one registered node by name, alive or not.
+func (p *proxyServer) GetNode(ctx context.Context, req *jobworkerv1.GetNodeRequest) (*jobworkerv1.GetNodeResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	n, ok := p.coord.nodes.get(req.Name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "node %q not found", req.Name)
+	}
+	return &jobworkerv1.GetNodeResponse{Node: nodeToProto(n)}, nil
+}
+
+func nodeToProto(n *Node) *jobworkerv1.Node {
+	memFree, gpusFree, heartbeat := n.Headroom()
+	return &jobworkerv1.Node{
+		Name:              n.Name,
+		Addr:              n.Addr,
+		Labels:            n.Labels,
+		RunningJobs:       n.RunningJobs(),
+		Alive:             n.Alive(nodeStaleAfter),
+		Version:           n.Version,
+		LastHeartbeatUnix: heartbeat.Unix(),
+		Cpus:              n.Cpus,
+		MemTotalBytes:     n.MemTotal,
+		MemFreeBytes:      memFree,
+		GpusTotal:         n.GpusTotal,
+		GpusFree:          gpusFree,
+	}
+}
+
+// WatchJobs fans out to every live worker and merges the event streams.
+func (p *proxyServer) WatchJobs(req *jobworkerv1.WatchJobsRequest, stream jobworkerv1.JobService_WatchJobsServer) error {
+	fctx, err := forwardContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	events := make(chan *jobworkerv1.JobEvent)
+	ctx, cancel := context.WithCancel(fctx)
+	defer cancel()
+
+	for _, node := range p.coord.nodes.alive() {
+		client, err := p.coord.clientFor(node)
+		if err != nil {
+			continue
+		}
+		upstream, err := client.WatchJobs(ctx, req)
+		if err != nil {
+			continue
+		}
+		go func() {
+			for {
+				ev, err := upstream.Recv()
+				if err != nil {
+					return
+				}
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		select {
+		case ev := <-events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
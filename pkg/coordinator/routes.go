@@ -0,0 +1,68 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// routeStore maps job IDs to the worker running them. In HA deployments
+// the store is shared so any replica can route RPCs for any job.
+type routeStore interface {
+	put(jobID, nodeName string) error
+	get(jobID string) (string, bool)
+}
+
+// memoryRoutes is the single-replica store.
+type memoryRoutes struct {
+	mu     sync.RWMutex
+	routes map[string]string
+}
+
+func newMemoryRoutes() *memoryRoutes {
+	return &memoryRoutes{routes: make(map[string]string)}
+}
+
+func (m *memoryRoutes) put(jobID, nodeName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.routes[jobID] = nodeName
+	return nil
+}
+
+func (m *memoryRoutes) get(jobID string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	name, ok := m.routes[jobID]
+	return name, ok
+}
+
+// routePrefix is the etcd key prefix for job placements.
+const routePrefix = "/jobworker/routes/"
+
+// etcdRoutes shares placements across coordinator replicas.
+type etcdRoutes struct {
+	client *clientv3.Client
+}
+
+func (e *etcdRoutes) put(jobID, nodeName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := e.client.Put(ctx, routePrefix+jobID, nodeName); err != nil {
+		return fmt.Errorf("persisting route for job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+func (e *etcdRoutes) get(jobID string) (string, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := e.client.Get(ctx, routePrefix+jobID)
+	if err != nil || len(resp.Kvs) == 0 {
+		return "", false
+	}
+	return string(resp.Kvs[0].Value), true
+}
@@ -0,0 +1,106 @@
+package coordinator
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNoNodes is returned when no live worker can take a job.
+var ErrNoNodes = errors.New("no worker nodes available")
+
+// Scheduler picks a node for a start request from the live candidates.
+// Implementations must not mutate the slice.
+type Scheduler interface {
+	Pick(candidates []*Node) (*Node, error)
+}
+
+// LeastLoaded schedules onto the node with the fewest running jobs,
+// spreading load evenly; the default policy.
+type LeastLoaded struct{}
+
+// Pick implements Scheduler.
+func (LeastLoaded) Pick(candidates []*Node) (*Node, error) {
+	if len(candidates) == 0 {
+		return nil, ErrNoNodes
+	}
+	best := candidates[0]
+	for _, n := range candidates[1:] {
+		if n.RunningJobs() < best.RunningJobs() {
+			best = n
+		}
+	}
+	return best, nil
+}
+
+// BinPack schedules onto the busiest node, filling workers one at a time
+// so the rest stay idle and can be drained or powered down.
+type BinPack struct{}
+
+// Pick implements Scheduler.
+func (BinPack) Pick(candidates []*Node) (*Node, error) {
+	if len(candidates) == 0 {
+		return nil, ErrNoNodes
+	}
+	best := candidates[0]
+	for _, n := range candidates[1:] {
+		if n.RunningJobs() > best.RunningJobs() {
+			best = n
+		}
+	}
+	return best, nil
+}
+
+// Random schedules onto a uniformly random node; useful when heartbeat
+// load figures lag too far behind bursty workloads to be trusted.
+type Random struct{}
+
+// Pick implements Scheduler.
+func (Random) Pick(candidates []*Node) (*Node, error) {
+	if len(candidates) == 0 {
+		return nil, ErrNoNodes
+	}
+	return candidates[rand.Intn(len(candidates))], nil
+}
+
+// schedulers is the registry strategy names resolve through. Built-ins
+// register here too, so custom strategies get equal footing.
+var (
+	schedulersMu sync.RWMutex
+	schedulers   = map[string]Scheduler{
+		"spread":   LeastLoaded{},
+		"bin-pack": BinPack{},
+		"random":   Random{},
+	}
+)
+
+// RegisterScheduler makes a custom strategy selectable by name, for
+// deployments that compile their own in. It panics on a duplicate name,
+// like database/sql.Register.
+func RegisterScheduler(name string, s Scheduler) {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+	if _, dup := schedulers[name]; dup {
+		panic(fmt.Sprintf("coordinator: scheduler %q registered twice", name))
+	}
+	schedulers[name] = s
+}
+
+// NewScheduler resolves a strategy by name. Errors list what is
+// available so a typo in config is easy to fix.
+func NewScheduler(name string) (Scheduler, error) {
+	schedulersMu.RLock()
+	defer schedulersMu.RUnlock()
+	if s, ok := schedulers[name]; ok {
+		return s, nil
+	}
+	names := make([]string, 0, len(schedulers))
+	for n := range schedulers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown scheduler %q, want one of %s", name, strings.Join(names, ", "))
+}
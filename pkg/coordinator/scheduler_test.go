@@ -0,0 +1,109 @@
+package coordinator
+
+import (
+	"testing"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+func TestLeastLoadedPicksLowest(t *testing.T) {
+	a := &Node{Name: "a"}
+	a.runningJobs = 3
+	b := &Node{Name: "b"}
+	b.runningJobs = 1
+	c := &Node{Name: "c"}
+	c.runningJobs = 2
+
+	node, err := LeastLoaded{}.Pick([]*Node{a, b, c})
+	if err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if node != b {
+		t.Errorf("Pick = %s, want b", node.Name)
+	}
+}
+
+func TestLeastLoadedNoNodes(t *testing.T) {
+	if _, err := (LeastLoaded{}).Pick(nil); err != ErrNoNodes {
+		t.Errorf("Pick = %v, want ErrNoNodes", err)
+	}
+}
+
+func TestRegistryHeartbeat(t *testing.T) {
+	r := newNodeRegistry()
+	if r.heartbeat(&jobworkerv1.HeartbeatRequest{Name: "ghost"}) {
+		t.Error("heartbeat accepted for unregistered node")
+	}
+	r.register(&jobworkerv1.RegisterWorkerRequest{Name: "w1", Addr: "w1:8443"})
+	if !r.heartbeat(&jobworkerv1.HeartbeatRequest{Name: "w1", RunningJobs: 2}) {
+		t.Error("heartbeat rejected for registered node")
+	}
+	alive := r.alive()
+	if len(alive) != 1 || alive[0].Name != "w1" || alive[0].RunningJobs() != 2 {
+		t.Errorf("alive = %+v", alive)
+	}
+}
+
+func TestBinPackPicksBusiest(t *testing.T) {
+	a := &Node{Name: "a"}
+	a.runningJobs = 3
+	b := &Node{Name: "b"}
+	b.runningJobs = 1
+
+	node, err := BinPack{}.Pick([]*Node{a, b})
+	if err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if node != a {
+		t.Errorf("Pick = %s, want the busiest node a", node.Name)
+	}
+}
+
+func TestRandomStaysWithinCandidates(t *testing.T) {
+	nodes := []*Node{{Name: "a"}, {Name: "b"}}
+	for i := 0; i < 20; i++ {
+		node, err := Random{}.Pick(nodes)
+		if err != nil {
+			t.Fatalf("Pick: %v", err)
+		}
+		if node != nodes[0] && node != nodes[1] {
+			t.Fatalf("Pick returned a node outside the candidates: %v", node)
+		}
+	}
+	if _, err := (Random{}).Pick(nil); err != ErrNoNodes {
+		t.Errorf("Pick with no nodes = %v, want ErrNoNodes", err)
+	}
+}
+
+func TestSchedulerRegistry(t *testing.T) {
+	for _, name := range []string{"spread", "bin-pack", "random"} {
+		if _, err := NewScheduler(name); err != nil {
+			t.Errorf("NewScheduler(%q): %v", name, err)
+		}
+	}
+	if _, err := NewScheduler("fanciest"); err == nil {
+		t.Error("NewScheduler accepted an unknown strategy")
+	}
+
+	RegisterScheduler("always-b", alwaysB{})
+	s, err := NewScheduler("always-b")
+	if err != nil {
+		t.Fatalf("NewScheduler after register: %v", err)
+	}
+	b := &Node{Name: "b"}
+	if node, _ := s.Pick([]*Node{{Name: "a"}, b}); node != b {
+		t.Errorf("custom strategy picked %v, want b", node)
+	}
+}
+
+// alwaysB is a custom strategy for the registration test.
+type alwaysB struct{}
+
+func (alwaysB) Pick(candidates []*Node) (*Node, error) {
+	for _, n := range candidates {
+		if n.Name == "b" {
+			return n, nil
+		}
+	}
+	return nil, ErrNoNodes
+}
@@ -0,0 +1,107 @@
+package coordinator
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/yamux"
+)
+
+// TunnelAddrPrefix marks a node address as reachable only through a
+// reverse tunnel; the remainder is the worker name.
+const TunnelAddrPrefix = "tunnel:"
+
+// tunnelRegistry holds the live reverse-tunnel sessions, keyed by worker
+// name. A worker behind NAT dials the coordinator's tunnel listener and
+// the coordinator opens multiplexed streams back over that connection.
+type tunnelRegistry struct {
+	mu       sync.RWMutex
+	sessions map[string]*yamux.Session
+}
+
+func newTunnelRegistry() *tunnelRegistry {
+	return &tunnelRegistry{sessions: make(map[string]*yamux.Session)}
+}
+
+// add replaces any existing session for the worker.
+func (t *tunnelRegistry) add(name string, sess *yamux.Session) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if old, ok := t.sessions[name]; ok {
+		_ = old.Close()
+	}
+	t.sessions[name] = sess
+}
+
+// open opens a new stream to the named worker.
+func (t *tunnelRegistry) open(name string) (net.Conn, error) {
+	t.mu.RLock()
+	sess, ok := t.sessions[name]
+	t.mu.RUnlock()
+	if !ok || sess.IsClosed() {
+		return nil, fmt.Errorf("no tunnel session for worker %q", name)
+	}
+	return sess.Open()
+}
+
+// serveTunnels accepts reverse-tunnel connections from workers. Each
+// connection starts with a single handshake line carrying the worker name,
+// then becomes a yamux session with the worker as the stream server.
+func (c *Coordinator) serveTunnels(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			if err := c.acceptTunnel(conn); err != nil {
+				log.Printf("tunnel: %v", err)
+				_ = conn.Close()
+			}
+		}()
+	}
+}
+
+func (c *Coordinator) acceptTunnel(conn net.Conn) error {
+	// The listener handed us a TLS conn; complete the handshake so the
+	// client certificate is verified before trusting the name.
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return fmt.Errorf("tunnel connection is not TLS")
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return fmt.Errorf("tunnel TLS handshake: %w", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	name, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("reading tunnel handshake: %w", err)
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("tunnel handshake carried no worker name")
+	}
+
+	sess, err := yamux.Client(conn, nil)
+	if err != nil {
+		return fmt.Errorf("starting yamux session: %w", err)
+	}
+	c.tunnels.add(name, sess)
+	log.Printf("tunnel established for worker %q", name)
+	return nil
+}
+
+// tunnelDialer is the gRPC context dialer for tunnel:<name> addresses.
+func (c *Coordinator) tunnelDialer(ctx context.Context, addr string) (net.Conn, error) {
+	name := strings.TrimPrefix(addr, TunnelAddrPrefix)
+	return c.tunnels.open(name)
+}
@@ -0,0 +1,136 @@
+// Package crypt encrypts persisted job data at rest with AES-256-CTR so
+// a stolen data directory does not leak job output. Each file starts
+// with a small header carrying a random IV; the key never touches disk
+// alongside the data. The scheme protects confidentiality only —
+// readers are authenticated at the gRPC layer, and the audit trail
+// covers integrity of history — so no per-file MAC is kept.
+package crypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// magic identifies an encrypted file; version bumps change the suffix.
+var magic = []byte("jwcrypt1")
+
+// HeaderLen is the bytes of overhead at the start of every encrypted
+// file: the magic followed by the IV.
+const HeaderLen = 8 + aes.BlockSize
+
+// ParseKey decodes a 64-character hex string into the 32-byte AES key.
+func ParseKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("data key is not hex: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("data key is %d bytes, want 32", len(key))
+	}
+	return key, nil
+}
+
+// stream builds the CTR stream for iv, advanced past offset bytes of
+// ciphertext so appends continue the keystream where the file left off.
+func stream(key, iv []byte, offset int64) (cipher.Stream, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	st := cipher.NewCTR(block, iv)
+	scratch := make([]byte, 32*1024)
+	for offset > 0 {
+		n := int64(len(scratch))
+		if offset < n {
+			n = offset
+		}
+		st.XORKeyStream(scratch[:n], scratch[:n])
+		offset -= n
+	}
+	return st, nil
+}
+
+// writer XORs plaintext into w.
+type writer struct {
+	w  io.Writer
+	st cipher.Stream
+}
+
+func (e *writer) Write(p []byte) (int, error) {
+	buf := make([]byte, len(p))
+	e.st.XORKeyStream(buf, p)
+	return e.w.Write(buf)
+}
+
+// NewWriter starts a fresh encrypted stream on w, writing the header
+// first.
+func NewWriter(w io.Writer, key []byte) (io.Writer, error) {
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append(append([]byte{}, magic...), iv...)); err != nil {
+		return nil, err
+	}
+	st, err := stream(key, iv, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{w: w, st: st}, nil
+}
+
+// ResumeWriter continues an existing encrypted stream on w, which must
+// be positioned after offset bytes of ciphertext (the header excluded).
+func ResumeWriter(w io.Writer, key, iv []byte, offset int64) (io.Writer, error) {
+	st, err := stream(key, iv, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{w: w, st: st}, nil
+}
+
+// ReadHeader consumes and validates the header, returning the IV.
+func ReadHeader(r io.Reader) ([]byte, error) {
+	header := make([]byte, HeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("reading encryption header: %w", err)
+	}
+	if string(header[:len(magic)]) != string(magic) {
+		return nil, fmt.Errorf("not an encrypted file (bad magic)")
+	}
+	return header[len(magic):], nil
+}
+
+// NewReader decrypts the stream on r, consuming the header first.
+func NewReader(r io.Reader, key []byte) (io.Reader, error) {
+	iv, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	st, err := stream(key, iv, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &cipher.StreamReader{S: st, R: r}, nil
+}
+
+// NewEncryptingReader returns a reader yielding the header followed by
+// the ciphertext of everything read from r, for callers that hand data
+// to store APIs taking a reader.
+func NewEncryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	st, err := stream(key, iv, 0)
+	if err != nil {
+		return nil, err
+	}
+	header := append(append([]byte{}, magic...), iv...)
+	return io.MultiReader(bytes.NewReader(header), &cipher.StreamReader{S: st, R: r}), nil
+}
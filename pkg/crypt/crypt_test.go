@@ -0,0 +1,105 @@
+package crypt
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func testKey(t *testing.T) []byte {
+	t.Helper()
+	key, err := ParseKey(strings.Repeat("ab", 32))
+	if err != nil {
+		t.Fatalf("ParseKey: %v", err)
+	}
+	return key
+}
+
+func TestRoundTrip(t *testing.T) {
+	key := testKey(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, key)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	plain := "some job output\nmore output\n"
+	if _, err := w.Write([]byte(plain)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if strings.Contains(buf.String(), "job output") {
+		t.Fatal("ciphertext contains the plaintext")
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), key)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != plain {
+		t.Errorf("decrypted %q, want %q", got, plain)
+	}
+}
+
+func TestResumeWriterContinuesStream(t *testing.T) {
+	key := testKey(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, key)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if _, err := w.Write([]byte("first half, ")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	// Reopen mid-stream, as appending to an existing file does.
+	iv, err := ReadHeader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadHeader: %v", err)
+	}
+	w2, err := ResumeWriter(&buf, key, iv, int64(buf.Len()-HeaderLen))
+	if err != nil {
+		t.Fatalf("ResumeWriter: %v", err)
+	}
+	if _, err := w2.Write([]byte("second half")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), key)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	if string(got) != "first half, second half" {
+		t.Errorf("decrypted %q, want both halves joined", got)
+	}
+}
+
+func TestEncryptingReader(t *testing.T) {
+	key := testKey(t)
+	enc, err := NewEncryptingReader(strings.NewReader("artifact bytes"), key)
+	if err != nil {
+		t.Fatalf("NewEncryptingReader: %v", err)
+	}
+	sealed, err := io.ReadAll(enc)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	r, err := NewReader(bytes.NewReader(sealed), key)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	if string(got) != "artifact bytes" {
+		t.Errorf("decrypted %q, want the original", got)
+	}
+}
+
+func TestReaderRejectsWrongKeyHeader(t *testing.T) {
+	if _, err := NewReader(strings.NewReader("plain old log file contents"), testKey(t)); err == nil {
+		t.Error("NewReader accepted a file without the encryption header")
+	}
+}
@@ -0,0 +1,86 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/logship"
+)
+
+// eventMessage is the JSON payload published for each job state change.
+type eventMessage struct {
+	JobID    string `json:"job_id"`
+	Owner    string `json:"owner"`
+	Event    string `json:"event"`
+	Command  string `json:"command"`
+	Status   string `json:"status"`
+	ExitCode *int   `json:"exit_code,omitempty"`
+	UnixTime int64  `json:"unix_time"`
+}
+
+// outputMessage is the JSON payload published for each batch of output
+// lines.
+type outputMessage struct {
+	JobID string   `json:"job_id"`
+	Lines []string `json:"lines"`
+}
+
+// Bridge wires a publisher into the job manager: state changes go to
+// EventsSubject and output batches to OutputSubject.
+type Bridge struct {
+	pub Publisher
+}
+
+// NewBridge returns a bridge publishing through pub.
+func NewBridge(pub Publisher) *Bridge {
+	return &Bridge{pub: pub}
+}
+
+// EventListener returns the manager listener publishing state changes.
+func (b *Bridge) EventListener() job.Listener {
+	return func(ev job.Event) {
+		j := ev.Job
+		st, exitCode := j.Status()
+		msg := eventMessage{
+			JobID:    j.ID,
+			Owner:    j.Owner,
+			Event:    ev.Type.String(),
+			Command:  j.Spec.Command,
+			Status:   st.String(),
+			UnixTime: j.StartedAt().Unix(),
+		}
+		if ev.Type == job.EventFinished {
+			msg.ExitCode = &exitCode
+			msg.UnixTime = j.EndedAt().Unix()
+		}
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			return
+		}
+		if err := b.pub.Publish(EventsSubject, j.ID, payload); err != nil {
+			log.Printf("eventbus: publishing event for job %s: %v", j.ID, err)
+		}
+	}
+}
+
+// OutputListener returns the manager listener publishing output batches,
+// reusing the logship follower for batching.
+func (b *Bridge) OutputListener() job.Listener {
+	return logship.NewShipper(&publisherSink{pub: b.pub}).Listener()
+}
+
+// publisherSink adapts a Publisher into a logship.Sink.
+type publisherSink struct {
+	pub Publisher
+}
+
+func (s *publisherSink) Ship(jobID string, lines []string) error {
+	payload, err := json.Marshal(outputMessage{JobID: jobID, Lines: lines})
+	if err != nil {
+		return err
+	}
+	return s.pub.Publish(OutputSubject, jobID, payload)
+}
+
+func (s *publisherSink) Close() error { return nil }
@@ -0,0 +1,41 @@
+package eventbus
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaPublisher publishes to Kafka topics named after subjects, with the
+// job ID as the message key so per-job ordering is preserved within a
+// partition.
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func newKafkaPublisher(hosts string) (Publisher, error) {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(strings.Split(hosts, ",")...),
+			Balancer:               &kafka.Hash{},
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           100 * time.Millisecond,
+		},
+	}, nil
+}
+
+func (p *kafkaPublisher) Publish(subject, key string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: subject,
+		Key:   []byte(key),
+		Value: payload,
+	})
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,33 @@
+package eventbus
+
+import (
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsPublisher publishes to a NATS server. Output for a job goes to
+// OutputSubject + "." + jobID so consumers can subscribe per job.
+type natsPublisher struct {
+	conn *nats.Conn
+}
+
+func newNATSPublisher(url string) (Publisher, error) {
+	conn, err := nats.Connect(url, nats.RetryOnFailedConnect(true))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to nats: %w", err)
+	}
+	return &natsPublisher{conn: conn}, nil
+}
+
+func (p *natsPublisher) Publish(subject, key string, payload []byte) error {
+	if key != "" {
+		subject = subject + "." + key
+	}
+	return p.conn.Publish(subject, payload)
+}
+
+func (p *natsPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
@@ -0,0 +1,43 @@
+// Package eventbus publishes job state-change events and job output to a
+// message bus (NATS or Kafka) so external systems can consume them without
+// polling the API.
+package eventbus
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Subjects/topics used by the bridge. On Kafka, dots in subject names are
+// kept as-is; output is published to one topic keyed by job ID rather than
+// a topic per job.
+const (
+	EventsSubject = "jobs.events"
+	OutputSubject = "jobs.output"
+)
+
+// Publisher sends payloads to a subject (NATS) or topic (Kafka). The key
+// orders messages for the same job on partitioned buses; NATS ignores it.
+type Publisher interface {
+	Publish(subject, key string, payload []byte) error
+	Close() error
+}
+
+// New builds a publisher from a URL:
+//
+//	nats://host:4222
+//	kafka://host:9092[,host2:9092]
+func New(rawURL string) (Publisher, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing bus url: %w", err)
+	}
+	switch u.Scheme {
+	case "nats":
+		return newNATSPublisher(rawURL)
+	case "kafka":
+		return newKafkaPublisher(u.Host)
+	default:
+		return nil, fmt.Errorf("unsupported bus scheme %q", u.Scheme)
+	}
+}
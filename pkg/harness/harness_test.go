@@ -0,0 +1,1809 @@
+package harness
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/server"
+)
+
+func TestStartWaitAndStreamOutput(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo one; echo two"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Errorf("status = %v, want COMPLETED", wait.Job.Status)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 2 || lines[0] != "one" || lines[1] != "two" {
+		t.Errorf("output = %q, want [one two]", lines)
+	}
+}
+
+func TestStopJob(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_STOPPED {
+		t.Errorf("status = %v, want STOPPED", st.Job.Status)
+	}
+}
+
+func TestUploadInputIntoWorkspace(t *testing.T) {
+	workspaces := t.TempDir()
+	h := New(t, func(cfg *server.Config) { cfg.WorkspaceRoot = workspaces })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	content := []byte("hello from the upload")
+	sum := sha256.Sum256(content)
+	stream, err := c.UploadInput(ctx)
+	if err != nil {
+		t.Fatalf("UploadInput: %v", err)
+	}
+	header := &jobworkerv1.UploadInputRequest{
+		Msg: &jobworkerv1.UploadInputRequest_File{File: &jobworkerv1.UploadFile{
+			Path:      "data/input.txt",
+			SizeBytes: int64(len(content)),
+			Sha256:    hex.EncodeToString(sum[:]),
+		}},
+	}
+	if err := stream.Send(header); err != nil {
+		t.Fatalf("Send header: %v", err)
+	}
+	if err := stream.Send(&jobworkerv1.UploadInputRequest{
+		Msg: &jobworkerv1.UploadInputRequest_Data{Data: content},
+	}); err != nil {
+		t.Fatalf("Send data: %v", err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv: %v", err)
+	}
+	if resp.TotalBytes != int64(len(content)) {
+		t.Errorf("TotalBytes = %d, want %d", resp.TotalBytes, len(content))
+	}
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "cat", Args: []string{"data/input.txt"},
+		UploadId: resp.UploadId,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Errorf("status = %v, want COMPLETED", wait.Job.Status)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 1 || lines[0] != string(content) {
+		t.Errorf("output = %q, want %q", lines, content)
+	}
+
+	// The upload is consumed by the start; replaying it must fail.
+	_, err = c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", UploadId: resp.UploadId})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("replayed upload = %v, want NotFound", err)
+	}
+}
+
+func TestUploadInputRejectsChecksumMismatch(t *testing.T) {
+	h := New(t, func(cfg *server.Config) { cfg.WorkspaceRoot = t.TempDir() })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	stream, err := c.UploadInput(ctx)
+	if err != nil {
+		t.Fatalf("UploadInput: %v", err)
+	}
+	header := &jobworkerv1.UploadInputRequest{
+		Msg: &jobworkerv1.UploadInputRequest_File{File: &jobworkerv1.UploadFile{
+			Path:   "input.txt",
+			Sha256: strings.Repeat("0", 64),
+		}},
+	}
+	if err := stream.Send(header); err != nil {
+		t.Fatalf("Send header: %v", err)
+	}
+	if err := stream.Send(&jobworkerv1.UploadInputRequest{
+		Msg: &jobworkerv1.UploadInputRequest_Data{Data: []byte("not the right bytes")},
+	}); err != nil {
+		t.Fatalf("Send data: %v", err)
+	}
+	if _, err := stream.CloseAndRecv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CloseAndRecv = %v, want InvalidArgument", err)
+	}
+}
+
+func TestUploadInputRejectsEscapingPaths(t *testing.T) {
+	h := New(t, func(cfg *server.Config) { cfg.WorkspaceRoot = t.TempDir() })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, path := range []string{"..", "../evil.txt", "a/../../evil.txt", "/etc/evil.txt", "."} {
+		stream, err := c.UploadInput(ctx)
+		if err != nil {
+			t.Fatalf("UploadInput: %v", err)
+		}
+		if err := stream.Send(&jobworkerv1.UploadInputRequest{
+			Msg: &jobworkerv1.UploadInputRequest_File{File: &jobworkerv1.UploadFile{Path: path}},
+		}); err != nil {
+			t.Fatalf("Send header: %v", err)
+		}
+		if _, err := stream.CloseAndRecv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("path %q = %v, want InvalidArgument", path, err)
+		}
+	}
+}
+
+func TestWriteStdinFeedsTheJob(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "cat"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	stream, err := c.WriteStdin(ctx)
+	if err != nil {
+		t.Fatalf("WriteStdin: %v", err)
+	}
+	if err := stream.Send(&jobworkerv1.WriteStdinRequest{JobId: start.JobId, Data: []byte("hello\n")}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv: %v", err)
+	}
+	if resp.BytesWritten != 6 {
+		t.Errorf("BytesWritten = %d, want 6", resp.BytesWritten)
+	}
+
+	// Closing stdin is cat's cue to exit.
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Errorf("status = %v, want COMPLETED", wait.Job.Status)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 1 || lines[0] != "hello" {
+		t.Errorf("output = %q, want [hello]", lines)
+	}
+}
+
+func TestJobsAreOwnerScoped(t *testing.T) {
+	h := New(t)
+	alice := h.Dial(t, "alice")
+	bob := h.Dial(t, "bob")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	_, err = bob.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetJobStatus as bob = %v, want NotFound", err)
+	}
+}
+
+func TestRoleLimitCeilingsReject(t *testing.T) {
+	policy := `{
+		"defaults": {"cpu_budget_seconds": 30},
+		"ceilings": {"batch": {"cpu_budget_seconds": 60}, "*": {"cpu_budget_seconds": 10}}
+	}`
+	h := New(t, func(cfg *server.Config) {
+		cfg.LimitsFile = writeFile(t, t.TempDir(), "limits.json", []byte(policy))
+	})
+	c := h.DialRoles(t, "alice", "batch")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Over the batch ceiling: rejected, since the policy does not clamp.
+	_, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", CpuBudgetSeconds: 120})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("StartJob over ceiling = %v, want InvalidArgument", err)
+	}
+
+	// Unset: the server default is applied and echoed back.
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if start.CpuBudgetSeconds != 30 {
+		t.Errorf("effective cpu budget = %d, want the default 30", start.CpuBudgetSeconds)
+	}
+
+	// A caller with no listed role falls back to the "*" ceiling.
+	stranger := h.Dial(t, "bob")
+	_, err = stranger.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", CpuBudgetSeconds: 20})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("StartJob over fallback ceiling = %v, want InvalidArgument", err)
+	}
+}
+
+func TestRoleLimitCeilingsClamp(t *testing.T) {
+	policy := `{"clamp": true, "ceilings": {"*": {"cpu_budget_seconds": 60, "max_output_bytes": 1000}}}`
+	h := New(t, func(cfg *server.Config) {
+		cfg.LimitsFile = writeFile(t, t.TempDir(), "limits.json", []byte(policy))
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", CpuBudgetSeconds: 120})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if start.CpuBudgetSeconds != 60 {
+		t.Errorf("effective cpu budget = %d, want clamped to 60", start.CpuBudgetSeconds)
+	}
+	if start.MaxOutputBytes != 1000 {
+		t.Errorf("effective output cap = %d, want clamped to 1000", start.MaxOutputBytes)
+	}
+}
+
+func TestGPUAllocation(t *testing.T) {
+	h := New(t, func(cfg *server.Config) {
+		cfg.GPUs = []string{"0", "1"}
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The job sees its allocation as CUDA_VISIBLE_DEVICES.
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo $CUDA_VISIBLE_DEVICES"}, Gpus: 1,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 1 || lines[0] != "0" {
+		t.Errorf("output = %q, want [0]", lines)
+	}
+
+	// More GPUs than the worker has is an immediate error, not a queue.
+	_, err = c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", Gpus: 3})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("StartJob with 3 GPUs = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGPUStartQueuesUntilFree(t *testing.T) {
+	h := New(t, func(cfg *server.Config) {
+		cfg.GPUs = []string{"0"}
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	holder, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"}, Gpus: 1,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+
+	// With the only device held, a second GPU start queues until its
+	// context gives up.
+	qctx, qcancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer qcancel()
+	_, err = c.StartJob(qctx, &jobworkerv1.StartJobRequest{Command: "true", Gpus: 1})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("queued StartJob = %v, want DeadlineExceeded", err)
+	}
+
+	// Freeing the device lets the next start through.
+	if _, err := c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: holder.JobId}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true", Gpus: 1})
+	if err != nil {
+		t.Fatalf("StartJob after release: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+}
+
+func TestPortAllocation(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo $PORT0; echo $PORT1"}, Ports: 2,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 2 || lines[0] == "" || lines[0] == lines[1] {
+		t.Fatalf("output = %q, want two distinct ports", lines)
+	}
+	resp, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if len(resp.Job.Ports) != 2 {
+		t.Fatalf("job reports %d ports, want 2", len(resp.Job.Ports))
+	}
+	for i, p := range resp.Job.Ports {
+		if lines[i] != strconv.Itoa(int(p)) {
+			t.Errorf("PORT%d = %s, but metadata says %d", i, lines[i], p)
+		}
+	}
+}
+
+func TestStdinKeepOpenForAttach(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "cat"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+
+	// An attach session ends its stdin stream with keep_open: the job's
+	// stdin must stay open so a later attach can keep typing.
+	stream, err := c.WriteStdin(ctx)
+	if err != nil {
+		t.Fatalf("WriteStdin: %v", err)
+	}
+	if err := stream.Send(&jobworkerv1.WriteStdinRequest{JobId: start.JobId, KeepOpen: true, Data: []byte("hello\n")}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatalf("CloseAndRecv: %v", err)
+	}
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_RUNNING {
+		t.Fatalf("status after detach = %v, want RUNNING", st.Job.Status)
+	}
+
+	// A plain stdin stream still delivers EOF, which is cat's cue to exit.
+	stream, err = c.WriteStdin(ctx)
+	if err != nil {
+		t.Fatalf("WriteStdin: %v", err)
+	}
+	if err := stream.Send(&jobworkerv1.WriteStdinRequest{JobId: start.JobId, Data: []byte("bye\n")}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatalf("CloseAndRecv: %v", err)
+	}
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Errorf("status = %v, want COMPLETED", wait.Job.Status)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 2 || lines[0] != "hello" || lines[1] != "bye" {
+		t.Errorf("output = %q, want [hello bye]", lines)
+	}
+}
+
+func TestStopReasonAndStopperRecorded(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: start.JobId, Reason: "superseded by deploy 42"}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.StoppedBy != "alice" {
+		t.Errorf("StoppedBy = %q, want alice", st.Job.StoppedBy)
+	}
+	if st.Job.StopReason != "superseded by deploy 42" {
+		t.Errorf("StopReason = %q, want the supplied reason", st.Job.StopReason)
+	}
+	if st.Job.StopRequestedAtUnix == 0 {
+		t.Error("StopRequestedAtUnix is zero, want the request time")
+	}
+}
+
+func TestStreamOutputResumesFromOffset(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo one; echo two; echo three"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	// A reconnecting follower passes the number of lines it already has;
+	// the stream picks up right after them.
+	stream, err := c.StreamOutput(ctx, &jobworkerv1.StreamOutputRequest{JobId: start.JobId, Offset: 2})
+	if err != nil {
+		t.Fatalf("StreamOutput: %v", err)
+	}
+	var lines []string
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		lines = append(lines, chunk.Lines...)
+	}
+	if len(lines) != 1 || lines[0] != "three" {
+		t.Errorf("resumed output = %q, want [three]", lines)
+	}
+}
+
+func TestImportJob(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.ImportJob(ctx, &jobworkerv1.ImportJobRequest{
+		Job: &jobworkerv1.Job{
+			Id:       "job-from-elsewhere",
+			Owner:    "bob",
+			Command:  "make",
+			Args:     []string{"test"},
+			Status:   jobworkerv1.JobStatus_JOB_STATUS_FAILED,
+			ExitCode: 2,
+		},
+		Output: []*jobworkerv1.OutputLine{
+			{Line: "building", Stream: "stdout"},
+			{Line: "boom", Stream: "stderr"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ImportJob: %v", err)
+	}
+	if resp.JobId == "" || resp.JobId == "job-from-elsewhere" {
+		t.Fatalf("JobId = %q, want a fresh ID", resp.JobId)
+	}
+
+	// The importer owns the copy, whatever the archive said.
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: resp.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.Owner != "alice" {
+		t.Errorf("Owner = %q, want alice", st.Job.Owner)
+	}
+	if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_FAILED || st.Job.ExitCode != 2 {
+		t.Errorf("status = %v/%d, want FAILED/2", st.Job.Status, st.Job.ExitCode)
+	}
+	lines := CollectOutput(ctx, t, c, resp.JobId)
+	if len(lines) != 2 || lines[0] != "building" || lines[1] != "boom" {
+		t.Errorf("output = %q, want [building boom]", lines)
+	}
+
+	// A still-running job cannot be imported.
+	_, err = c.ImportJob(ctx, &jobworkerv1.ImportJobRequest{
+		Job: &jobworkerv1.Job{Command: "x", Status: jobworkerv1.JobStatus_JOB_STATUS_RUNNING},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("importing a running job: %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetRunStatsGroupsByName(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, command := range []string{"true", "true", "false"} {
+		resp, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: command, Name: "nightly"})
+		if err != nil {
+			t.Fatalf("StartJob: %v", err)
+		}
+		if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: resp.JobId}); err != nil {
+			t.Fatalf("WaitJob: %v", err)
+		}
+	}
+
+	stats, err := c.GetRunStats(ctx, &jobworkerv1.GetRunStatsRequest{})
+	if err != nil {
+		t.Fatalf("GetRunStats: %v", err)
+	}
+	if len(stats.Groups) != 1 {
+		t.Fatalf("got %d groups, want 1", len(stats.Groups))
+	}
+	g := stats.Groups[0]
+	if g.Group != "nightly" || g.Runs != 3 || g.Failures != 1 {
+		t.Errorf("group = {%s runs=%d failures=%d}, want {nightly runs=3 failures=1}", g.Group, g.Runs, g.Failures)
+	}
+	if g.LastStartedAtUnix == 0 {
+		t.Error("LastStartedAtUnix not set")
+	}
+
+	// Another user sees none of alice's runs.
+	if stats, err := h.Dial(t, "bob").GetRunStats(ctx, &jobworkerv1.GetRunStatsRequest{}); err != nil {
+		t.Fatalf("GetRunStats as bob: %v", err)
+	} else if len(stats.Groups) != 0 {
+		t.Errorf("bob sees %d groups, want 0", len(stats.Groups))
+	}
+}
+
+func TestQuotaEnforcedAtStart(t *testing.T) {
+	policy := `{"period": "daily", "owners": {"alice": {"jobs": 2}}}`
+	h := New(t, func(cfg *server.Config) {
+		cfg.QuotasFile = writeFile(t, t.TempDir(), "quotas.json", []byte(policy))
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"}); err != nil {
+			t.Fatalf("StartJob %d: %v", i, err)
+		}
+	}
+	_, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("StartJob past quota = %v, want ResourceExhausted", err)
+	}
+
+	usage, err := c.GetQuotaUsage(ctx, &jobworkerv1.GetQuotaUsageRequest{})
+	if err != nil {
+		t.Fatalf("GetQuotaUsage: %v", err)
+	}
+	if len(usage.Owners) != 1 {
+		t.Fatalf("got %d usage rows, want 1", len(usage.Owners))
+	}
+	row := usage.Owners[0]
+	if row.Owner != "alice" || row.Jobs != 2 || row.JobsLimit != 2 {
+		t.Errorf("usage = {%s jobs=%d limit=%d}, want {alice jobs=2 limit=2}", row.Owner, row.Jobs, row.JobsLimit)
+	}
+
+	// Quotas are per owner: bob can still start jobs, and sees only his
+	// own (empty) usage row.
+	bob := h.Dial(t, "bob")
+	if _, err := bob.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"}); err != nil {
+		t.Fatalf("StartJob as bob: %v", err)
+	}
+	usage, err = bob.GetQuotaUsage(ctx, &jobworkerv1.GetQuotaUsageRequest{})
+	if err != nil {
+		t.Fatalf("GetQuotaUsage as bob: %v", err)
+	}
+	if len(usage.Owners) != 1 || usage.Owners[0].Owner != "bob" {
+		t.Errorf("bob sees rows %v, want only his own", usage.Owners)
+	}
+}
+
+func TestAdminAdmissionControl(t *testing.T) {
+	h := New(t)
+	admin := h.DialAdmin(t, "root", "admin")
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The admin role is required, not just authentication.
+	if _, err := h.DialAdmin(t, "mallory").Drain(ctx, &jobworkerv1.DrainRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Drain without admin role = %v, want PermissionDenied", err)
+	}
+
+	if _, err := admin.SetAdmission(ctx, &jobworkerv1.SetAdmissionRequest{Paused: true, Reason: "maintenance"}); err != nil {
+		t.Fatalf("SetAdmission: %v", err)
+	}
+	_, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if status.Code(err) != codes.Unavailable || !strings.Contains(err.Error(), "maintenance") {
+		t.Fatalf("StartJob while paused = %v, want Unavailable with reason", err)
+	}
+
+	if _, err := admin.SetAdmission(ctx, &jobworkerv1.SetAdmissionRequest{}); err != nil {
+		t.Fatalf("SetAdmission resume: %v", err)
+	}
+	if _, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"}); err != nil {
+		t.Fatalf("StartJob after resume: %v", err)
+	}
+
+	// Draining rejects starts until admission is explicitly resumed.
+	if _, err := admin.Drain(ctx, &jobworkerv1.DrainRequest{}); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if _, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("StartJob while draining = %v, want Unavailable", err)
+	}
+}
+
+func TestAdminReloadAndDiagnostics(t *testing.T) {
+	h := New(t)
+	admin := h.DialAdmin(t, "root", "admin")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	reload, err := admin.ReloadConfig(ctx, &jobworkerv1.ReloadConfigRequest{})
+	if err != nil {
+		t.Fatalf("ReloadConfig: %v", err)
+	}
+	if len(reload.Reloaded) == 0 || reload.Reloaded[0] != "certificate" {
+		t.Errorf("Reloaded = %v, want certificate first", reload.Reloaded)
+	}
+
+	diag, err := admin.DumpDiagnostics(ctx, &jobworkerv1.DumpDiagnosticsRequest{})
+	if err != nil {
+		t.Fatalf("DumpDiagnostics: %v", err)
+	}
+	if diag.Goroutines == 0 || len(diag.GoroutineDump) == 0 {
+		t.Error("diagnostics missing goroutine information")
+	}
+	if !strings.Contains(string(diag.GoroutineDump), "goroutine") {
+		t.Error("goroutine dump does not look like a stack dump")
+	}
+}
+
+func TestMaintenanceWindowHoldsStarts(t *testing.T) {
+	// A window spanning the current time, every day of the week.
+	now := time.Now().UTC()
+	policy := fmt.Sprintf(`{
+		"windows": [{"start": %q, "end": %q, "reason": "patching"}],
+		"exempt": ["deploy"]
+	}`, now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"))
+	h := New(t, func(cfg *server.Config) {
+		cfg.MaintenanceFile = writeFile(t, t.TempDir(), "maintenance.json", []byte(policy))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c := h.Dial(t, "alice")
+	m, err := c.GetMaintenance(ctx, &jobworkerv1.GetMaintenanceRequest{})
+	if err != nil {
+		t.Fatalf("GetMaintenance: %v", err)
+	}
+	if !m.Active || m.Reason != "patching" || m.UntilUnix == 0 {
+		t.Fatalf("GetMaintenance = {active=%v reason=%q until=%d}, want active with reason patching", m.Active, m.Reason, m.UntilUnix)
+	}
+
+	// A non-exempt start is held; giving up surfaces the reason.
+	heldCtx, heldCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer heldCancel()
+	_, err = c.StartJob(heldCtx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if status.Code(err) != codes.Unavailable || !strings.Contains(err.Error(), "patching") {
+		t.Fatalf("StartJob during maintenance = %v, want Unavailable mentioning patching", err)
+	}
+
+	// The exempt role runs through the window.
+	exempt := h.DialRoles(t, "bob", "deploy")
+	if _, err := exempt.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"}); err != nil {
+		t.Fatalf("StartJob with exempt role: %v", err)
+	}
+}
+
+func TestRPCRateLimit(t *testing.T) {
+	h := New(t, func(cfg *server.Config) {
+		cfg.RPCRateLimit = 2
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The bucket allows a burst of two, then the third immediate call is
+	// over the limit.
+	var err error
+	for i := 0; i < 3; i++ {
+		_, err = c.ListJobs(ctx, &jobworkerv1.ListJobsRequest{})
+		if i < 2 && err != nil {
+			t.Fatalf("ListJobs %d: %v", i, err)
+		}
+	}
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("ListJobs past rate limit = %v, want ResourceExhausted", err)
+	}
+
+	// Another identity has its own bucket.
+	if _, err := h.Dial(t, "bob").ListJobs(ctx, &jobworkerv1.ListJobsRequest{}); err != nil {
+		t.Fatalf("ListJobs as bob: %v", err)
+	}
+}
+
+func TestStartRequestValidationListsViolations(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	args := make([]string, 1025)
+	for i := range args {
+		args[i] = "x"
+	}
+	args[3] = "bad\x00arg"
+	_, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "echo", Args: args})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("StartJob = %v, want InvalidArgument", err)
+	}
+
+	// Every violation is reported at once, as BadRequest field violations.
+	var br *errdetails.BadRequest
+	for _, d := range st.Details() {
+		if b, ok := d.(*errdetails.BadRequest); ok {
+			br = b
+		}
+	}
+	if br == nil {
+		t.Fatalf("no BadRequest detail in %v", st.Details())
+	}
+	if len(br.FieldViolations) != 2 {
+		t.Fatalf("got %d violations, want 2: %v", len(br.FieldViolations), br.FieldViolations)
+	}
+	if br.FieldViolations[0].Field != "args" || br.FieldViolations[1].Field != "args[3]" {
+		t.Errorf("violation fields = %s, %s; want args, args[3]", br.FieldViolations[0].Field, br.FieldViolations[1].Field)
+	}
+}
+
+func TestSecretEnvInjection(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "db-pass"), []byte("hunter2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, func(cfg *server.Config) { cfg.SecretsDir = dir })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo $DB_PASS"},
+		SecretEnv: []string{"DB_PASS=file:db-pass"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	// The value reaches the process but is blanked from captured output.
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 1 || lines[0] != "[REDACTED]" {
+		t.Errorf("output = %q, want [[REDACTED]]", lines)
+	}
+
+	// Job metadata carries the variable name, never the value.
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if len(st.Job.EnvKeys) != 1 || st.Job.EnvKeys[0] != "DB_PASS" {
+		t.Errorf("EnvKeys = %v, want [DB_PASS]", st.Job.EnvKeys)
+	}
+
+	// An unresolvable reference fails the start.
+	_, err = c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "true", SecretEnv: []string{"MISSING=file:no-such-secret"},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("StartJob with missing secret = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestOutputRedaction(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "api-key"), []byte("tok_abc123"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rules := `{"patterns": ["(?i)password=\\S+"]}`
+	h := New(t, func(cfg *server.Config) {
+		cfg.SecretsDir = dir
+		cfg.RedactFile = writeFile(t, t.TempDir(), "redact.json", []byte(rules))
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Both the configured pattern and the resolved secret value are
+	// blanked before the output is stored.
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo password=abc; echo key is $API_KEY"},
+		SecretEnv: []string{"API_KEY=file:api-key"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+	lines := CollectOutput(ctx, t, c, start.JobId)
+	if len(lines) != 2 || lines[0] != "[REDACTED]" || lines[1] != "key is [REDACTED]" {
+		t.Errorf("output = %q, want both lines redacted", lines)
+	}
+}
+
+func TestAuditTrailVerification(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+	h := New(t, func(cfg *server.Config) { cfg.AuditFile = auditFile })
+	admin := h.DialAdmin(t, "root", "admin")
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	// The finish entry is appended by a listener goroutine; poll until the
+	// trail covers both lifecycle events.
+	var verify *jobworkerv1.VerifyAuditResponse
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		verify, err = admin.VerifyAudit(ctx, &jobworkerv1.VerifyAuditRequest{})
+		if err != nil {
+			t.Fatalf("VerifyAudit: %v", err)
+		}
+		if verify.Entries >= 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if verify.Entries < 2 {
+		t.Fatalf("audit trail has %d entries, want start and finish", verify.Entries)
+	}
+
+	// Rewriting history must fail verification.
+	data, err := os.ReadFile(auditFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.Replace(string(data), `"actor":"alice"`, `"actor":"mallory"`, 1)
+	if err := os.WriteFile(auditFile, []byte(tampered), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = admin.VerifyAudit(ctx, &jobworkerv1.VerifyAuditRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("VerifyAudit on tampered trail = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestNodeInventory(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	nodes, err := c.ListNodes(ctx, &jobworkerv1.ListNodesRequest{})
+	if err != nil {
+		t.Fatalf("ListNodes: %v", err)
+	}
+	if len(nodes.Nodes) != 1 {
+		t.Fatalf("standalone worker reported %d nodes, want 1", len(nodes.Nodes))
+	}
+	n := nodes.Nodes[0]
+	if !n.Alive || n.Cpus <= 0 || n.Version == "" || n.LastHeartbeatUnix == 0 {
+		t.Errorf("node = %+v, want capacity, version, and heartbeat filled in", n)
+	}
+
+	// A standalone worker answers GetNode for itself (and "") only.
+	got, err := c.GetNode(ctx, &jobworkerv1.GetNodeRequest{Name: n.Name})
+	if err != nil {
+		t.Fatalf("GetNode: %v", err)
+	}
+	if got.Node.Name != n.Name {
+		t.Errorf("GetNode returned %q, want %q", got.Node.Name, n.Name)
+	}
+	if _, err := c.GetNode(ctx, &jobworkerv1.GetNodeRequest{Name: "no-such-node"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetNode for unknown node = %v, want NotFound", err)
+	}
+}
+
+func TestPreemptionStopsLowerPriorityJob(t *testing.T) {
+	h := New(t, func(cfg *server.Config) {
+		cfg.GPUs = []string{"0"}
+		cfg.Preemption = "lower-priority"
+	})
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	low, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"}, Gpus: 1,
+	})
+	if err != nil {
+		t.Fatalf("StartJob low: %v", err)
+	}
+
+	// With the only device held by a priority-0 job, a higher-priority
+	// start preempts it instead of queueing.
+	high, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "true", Gpus: 1, Priority: 5,
+	})
+	if err != nil {
+		t.Fatalf("StartJob high: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: high.JobId}); err != nil {
+		t.Fatalf("WaitJob high: %v", err)
+	}
+
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: low.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus low: %v", err)
+	}
+	if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_STOPPED {
+		t.Errorf("preempted job status = %v, want STOPPED", st.Job.Status)
+	}
+	if st.Job.StoppedBy != "scheduler" || !strings.Contains(st.Job.StopReason, "preempted") {
+		t.Errorf("preempted job StopInfo = %q/%q, want scheduler/preempted", st.Job.StoppedBy, st.Job.StopReason)
+	}
+
+	// The victim is requeued once the device frees up, linked to the
+	// stopped attempt through RescheduledFrom.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		list, err := c.ListJobs(ctx, &jobworkerv1.ListJobsRequest{})
+		if err != nil {
+			t.Fatalf("ListJobs: %v", err)
+		}
+		var requeued bool
+		for _, j := range list.Jobs {
+			if j.RescheduledFrom == low.JobId {
+				requeued = true
+			}
+		}
+		if requeued {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("preempted job was never requeued")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestFairShareAdmission(t *testing.T) {
+	sharesFile := filepath.Join(t.TempDir(), "shares.json")
+	if err := os.WriteFile(sharesFile, []byte(`{"max_running_jobs": 2}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, func(cfg *server.Config) { cfg.SharesFile = sharesFile })
+	alice := h.Dial(t, "alice")
+	bob := h.Dial(t, "bob")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Alice fills both slots.
+	var held []string
+	for i := 0; i < 2; i++ {
+		start, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command: "sleep", Args: []string{"60"},
+		})
+		if err != nil {
+			t.Fatalf("StartJob alice %d: %v", i, err)
+		}
+		held = append(held, start.JobId)
+	}
+
+	// A third alice start and a first bob start both queue at the cap.
+	aliceDone := make(chan error, 1)
+	bobDone := make(chan error, 1)
+	go func() {
+		_, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "sleep", Args: []string{"60"}})
+		aliceDone <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	go func() {
+		_, err := bob.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "sleep", Args: []string{"60"}})
+		bobDone <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case err := <-aliceDone:
+		t.Fatalf("alice start admitted over the cap: %v", err)
+	case err := <-bobDone:
+		t.Fatalf("bob start admitted over the cap: %v", err)
+	default:
+	}
+
+	// One slot frees; bob, with no running jobs, goes before alice even
+	// though alice queued first.
+	if _, err := alice.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: held[0]}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	select {
+	case err := <-bobDone:
+		if err != nil {
+			t.Fatalf("bob start after slot freed: %v", err)
+		}
+	case err := <-aliceDone:
+		t.Fatalf("alice start admitted before bob's (err=%v)", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no start admitted after a slot freed")
+	}
+
+	// Alice stays queued until a second slot frees.
+	select {
+	case err := <-aliceDone:
+		t.Fatalf("alice start admitted at the cap (err=%v)", err)
+	default:
+	}
+	if _, err := alice.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: held[1]}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	select {
+	case err := <-aliceDone:
+		if err != nil {
+			t.Fatalf("alice start after slot freed: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("alice start never admitted")
+	}
+}
+
+func TestBatchStopByLabelSelector(t *testing.T) {
+	h := New(t)
+	alice := h.Dial(t, "alice")
+	bob := h.Dial(t, "bob")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	nightly := map[string]string{"batch": "nightly"}
+	var tagged []string
+	for i := 0; i < 2; i++ {
+		start, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command: "sleep", Args: []string{"60"}, Labels: nightly,
+		})
+		if err != nil {
+			t.Fatalf("StartJob tagged %d: %v", i, err)
+		}
+		tagged = append(tagged, start.JobId)
+	}
+	plain, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob plain: %v", err)
+	}
+	bobJob, err := bob.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"}, Labels: nightly,
+	})
+	if err != nil {
+		t.Fatalf("StartJob bob: %v", err)
+	}
+
+	// A bulk call with no selector at all must be rejected.
+	if _, err := alice.BatchStop(ctx, &jobworkerv1.BatchStopRequest{}); err == nil {
+		t.Error("BatchStop without a selector succeeded; want an error")
+	}
+
+	resp, err := alice.BatchStop(ctx, &jobworkerv1.BatchStopRequest{
+		Labels: nightly, Reason: "nightly batch cancelled",
+	})
+	if err != nil {
+		t.Fatalf("BatchStop: %v", err)
+	}
+	stopped := map[string]bool{}
+	for _, r := range resp.Results {
+		if r.Error != "" {
+			t.Errorf("job %s: %s", r.JobId, r.Error)
+		}
+		stopped[r.JobId] = true
+	}
+	if len(stopped) != 2 || !stopped[tagged[0]] || !stopped[tagged[1]] {
+		t.Errorf("stopped %v, want exactly %v", stopped, tagged)
+	}
+
+	// Alice's unlabeled job and bob's labeled one are untouched.
+	for _, id := range []string{plain.JobId, bobJob.JobId} {
+		c := alice
+		if id == bobJob.JobId {
+			c = bob
+		}
+		st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: id})
+		if err != nil {
+			t.Fatalf("GetJobStatus %s: %v", id, err)
+		}
+		if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_RUNNING {
+			t.Errorf("job %s status = %v, want RUNNING", id, st.Job.Status)
+		}
+	}
+}
+
+func TestProtectedJobRequiresForce(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"},
+		Labels: map[string]string{"protected": "true"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	_, err = c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: start.JobId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("StopJob without force: %v, want FailedPrecondition", err)
+	}
+	if _, err := c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: start.JobId, Force: true}); err != nil {
+		t.Fatalf("StopJob with force: %v", err)
+	}
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_STOPPED {
+		t.Errorf("status = %v, want STOPPED", st.Job.Status)
+	}
+}
+
+func TestLockKeySingletonJobs(t *testing.T) {
+	h := New(t)
+	alice := h.Dial(t, "alice")
+	bob := h.Dial(t, "bob")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	holder, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sleep", Args: []string{"60"}, LockKey: "migrate",
+	})
+	if err != nil {
+		t.Fatalf("StartJob holder: %v", err)
+	}
+
+	// A second start on the same key fails fast without lock_wait.
+	_, err = alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "true", LockKey: "migrate",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("contending start = %v, want FailedPrecondition", err)
+	}
+
+	// Keys are owner-scoped: bob's migrate does not contend with alice's.
+	bobStart, err := bob.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "true", LockKey: "migrate",
+	})
+	if err != nil {
+		t.Fatalf("StartJob bob: %v", err)
+	}
+	if _, err := bob.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: bobStart.JobId}); err != nil {
+		t.Fatalf("WaitJob bob: %v", err)
+	}
+
+	// With lock_wait the start queues until the holder finishes.
+	queued := make(chan error, 1)
+	go func() {
+		_, err := alice.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command: "true", LockKey: "migrate", LockWait: true,
+		})
+		queued <- err
+	}()
+	select {
+	case err := <-queued:
+		t.Fatalf("queued start returned while the key was held: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	if _, err := alice.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: holder.JobId}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	select {
+	case err := <-queued:
+		if err != nil {
+			t.Fatalf("queued start after release: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("queued start never admitted after the key freed")
+	}
+}
+
+func TestConcurrencyGroupLimitsParallelism(t *testing.T) {
+	groupsFile := filepath.Join(t.TempDir(), "groups.json")
+	if err := os.WriteFile(groupsFile, []byte(`{"groups": {"build": 2}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, func(cfg *server.Config) { cfg.GroupsFile = groupsFile })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The group admits two jobs immediately.
+	var held []string
+	for i := 0; i < 2; i++ {
+		start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command: "sleep", Args: []string{"60"}, ConcurrencyGroup: "build",
+		})
+		if err != nil {
+			t.Fatalf("StartJob %d: %v", i, err)
+		}
+		held = append(held, start.JobId)
+	}
+
+	// The third queues until a slot frees.
+	queued := make(chan error, 1)
+	go func() {
+		_, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+			Command: "true", ConcurrencyGroup: "build",
+		})
+		queued <- err
+	}()
+	select {
+	case err := <-queued:
+		t.Fatalf("third start returned at the limit: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	if _, err := c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: held[0]}); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	select {
+	case err := <-queued:
+		if err != nil {
+			t.Fatalf("queued start after a slot freed: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("queued start never admitted after a slot freed")
+	}
+}
+
+func TestPostJobHookRunsOnFailure(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command:   "sh",
+		Args:      []string{"-c", "exit 7"},
+		OnFailure: "echo handled $PARENT_JOB_STATUS $PARENT_JOB_EXIT_CODE",
+		OnSuccess: "echo never",
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	// The hook job is started by a listener after the parent finishes;
+	// poll the job list until it shows up.
+	var hook *jobworkerv1.Job
+	deadline := time.Now().Add(10 * time.Second)
+	for hook == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("hook job never appeared")
+		}
+		list, err := c.ListJobs(ctx, &jobworkerv1.ListJobsRequest{})
+		if err != nil {
+			t.Fatalf("ListJobs: %v", err)
+		}
+		for _, j := range list.Jobs {
+			if j.ParentId == start.JobId {
+				hook = j
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: hook.Id})
+	if err != nil {
+		t.Fatalf("WaitJob hook: %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Fatalf("hook status = %v, want COMPLETED", wait.Job.Status)
+	}
+	lines := CollectOutput(ctx, t, c, hook.Id)
+	if want := "handled FAILED 7"; len(lines) != 1 || lines[0] != want {
+		t.Errorf("hook output = %q, want [%q]", lines, want)
+	}
+}
+
+func TestAnnotateJobAppendsMarker(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo before; sleep 60"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	defer c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: start.JobId})
+
+	if _, err := c.AnnotateJob(ctx, &jobworkerv1.AnnotateJobRequest{
+		JobId: start.JobId, Text: "deploy step 2 started",
+	}); err != nil {
+		t.Fatalf("AnnotateJob: %v", err)
+	}
+	if _, err := c.AnnotateJob(ctx, &jobworkerv1.AnnotateJobRequest{JobId: start.JobId}); err == nil {
+		t.Error("AnnotateJob without text succeeded; want an error")
+	}
+
+	out, err := c.GetOutput(ctx, &jobworkerv1.GetOutputRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetOutput: %v", err)
+	}
+	var marker *jobworkerv1.OutputLine
+	for _, e := range out.Entries {
+		if e.Stream == "marker" {
+			marker = e
+		}
+	}
+	if marker == nil {
+		t.Fatalf("no marker line in output %v", out.Lines)
+	}
+	if marker.Line != "deploy step 2 started" {
+		t.Errorf("marker text = %q, want deploy step 2 started", marker.Line)
+	}
+}
+
+func TestStreamOutputGrepFiltersLines(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo starting up; echo 'ERROR: boom'; echo done"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	stream, err := c.StreamOutput(ctx, &jobworkerv1.StreamOutputRequest{
+		JobId: start.JobId, Grep: "ERROR|WARN",
+	})
+	if err != nil {
+		t.Fatalf("StreamOutput: %v", err)
+	}
+	var entries []*jobworkerv1.OutputLine
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		entries = append(entries, chunk.Entries...)
+	}
+	if len(entries) != 1 || entries[0].Line != "ERROR: boom" {
+		t.Fatalf("filtered entries = %v, want just the ERROR line", entries)
+	}
+	if entries[0].Level != "error" {
+		t.Errorf("Level = %q, want error", entries[0].Level)
+	}
+
+	// A malformed pattern is rejected up front.
+	bad, err := c.StreamOutput(ctx, &jobworkerv1.StreamOutputRequest{JobId: start.JobId, Grep: "("})
+	if err == nil {
+		if _, err = bad.Recv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("bad pattern = %v, want InvalidArgument", err)
+		}
+	}
+}
+
+func TestDebugJobValidation(t *testing.T) {
+	h := New(t, func(cfg *server.Config) {
+		cfg.WorkspaceRoot = t.TempDir()
+		cfg.ArtifactStoreURL = "file://" + t.TempDir()
+	})
+	admin := h.DialAdmin(t, "root", "admin")
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	started, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	defer c.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: started.JobId})
+
+	// The admin role is required, not just authentication.
+	req := &jobworkerv1.DebugJobRequest{JobId: started.JobId, Tool: "strace"}
+	if _, err := h.DialAdmin(t, "mallory").DebugJob(ctx, req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("DebugJob without admin role = %v, want PermissionDenied", err)
+	}
+
+	if _, err := admin.DebugJob(ctx, &jobworkerv1.DebugJobRequest{JobId: started.JobId, Tool: "gdb"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("DebugJob with unknown tool = %v, want InvalidArgument", err)
+	}
+	if _, err := admin.DebugJob(ctx, &jobworkerv1.DebugJobRequest{JobId: "no-such-job", Tool: "strace"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("DebugJob for unknown job = %v, want NotFound", err)
+	}
+}
+
+func TestManifestAndRerun(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo ran"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	mresp, err := c.GetJobManifest(ctx, &jobworkerv1.GetJobManifestRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("GetJobManifest: %v", err)
+	}
+	m := mresp.Manifest
+	if m.Command != "sh" || m.Hostname == "" || m.Kernel == "" || m.GoVersion == "" {
+		t.Errorf("manifest missing fields: %+v", m)
+	}
+	var found bool
+	for _, e := range m.Env {
+		if strings.HasPrefix(e, "PATH=") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("manifest env lacks PATH: %v", m.Env)
+	}
+
+	rerun, err := c.RerunJob(ctx, &jobworkerv1.RerunJobRequest{JobId: start.JobId})
+	if err != nil {
+		t.Fatalf("RerunJob: %v", err)
+	}
+	if rerun.JobId == start.JobId {
+		t.Fatalf("rerun returned the original job ID %s", rerun.JobId)
+	}
+	wait, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: rerun.JobId})
+	if err != nil {
+		t.Fatalf("WaitJob(rerun): %v", err)
+	}
+	if wait.Job.Status != jobworkerv1.JobStatus_JOB_STATUS_COMPLETED {
+		t.Errorf("rerun status = %v, want COMPLETED", wait.Job.Status)
+	}
+
+	// Another user's job is invisible to both RPCs.
+	other := h.Dial(t, "bob")
+	if _, err := other.RerunJob(ctx, &jobworkerv1.RerunJobRequest{JobId: start.JobId}); status.Code(err) != codes.NotFound {
+		t.Errorf("RerunJob as bob = %v, want NotFound", err)
+	}
+}
+
+func TestRerunOverrides(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartJob(ctx, &jobworkerv1.StartJobRequest{
+		Command: "sh", Args: []string{"-c", "echo run"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: start.JobId}); err != nil {
+		t.Fatalf("WaitJob: %v", err)
+	}
+
+	rerun, err := c.RerunJob(ctx, &jobworkerv1.RerunJobRequest{
+		JobId:      start.JobId,
+		Set:        map[string]string{"nice": "5", "name": "tuned"},
+		AppendArgs: []string{"ignored"},
+	})
+	if err != nil {
+		t.Fatalf("RerunJob: %v", err)
+	}
+	if _, err := c.WaitJob(ctx, &jobworkerv1.WaitJobRequest{JobId: rerun.JobId}); err != nil {
+		t.Fatalf("WaitJob(rerun): %v", err)
+	}
+	st, err := c.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: rerun.JobId})
+	if err != nil {
+		t.Fatalf("GetJobStatus: %v", err)
+	}
+	if st.Job.Nice != 5 {
+		t.Errorf("Nice = %d, want 5", st.Job.Nice)
+	}
+	if st.Job.Name != "tuned" {
+		t.Errorf("Name = %q, want tuned", st.Job.Name)
+	}
+
+	// Bad overrides are rejected before anything starts.
+	if _, err := c.RerunJob(ctx, &jobworkerv1.RerunJobRequest{
+		JobId: start.JobId, Set: map[string]string{"timeout": "1h"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown override = %v, want InvalidArgument", err)
+	}
+	if _, err := c.RerunJob(ctx, &jobworkerv1.RerunJobRequest{
+		JobId: start.JobId, Set: map[string]string{"cpu-budget": "soon"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("bad override value = %v, want InvalidArgument", err)
+	}
+}
+
+// waitPipeline polls until the pipeline's aggregate status leaves RUNNING.
+func waitPipeline(t *testing.T, ctx context.Context, c jobworkerv1.JobServiceClient, id string) *jobworkerv1.Pipeline {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := c.GetPipeline(ctx, &jobworkerv1.GetPipelineRequest{PipelineId: id})
+		if err != nil {
+			t.Fatalf("GetPipeline: %v", err)
+		}
+		if resp.Pipeline.Status != "RUNNING" {
+			return resp.Pipeline
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("pipeline %s still running", id)
+	return nil
+}
+
+func TestPipelineRunsStagesInOrder(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	marker := filepath.Join(t.TempDir(), "built")
+	start, err := c.StartPipeline(ctx, &jobworkerv1.StartPipelineRequest{
+		Name: "release",
+		Stages: []*jobworkerv1.PipelineStage{
+			{Name: "build", Job: &jobworkerv1.StartJobRequest{
+				Command: "sh", Args: []string{"-c", "touch " + marker},
+			}},
+			{Name: "test", Needs: []string{"build"}, Job: &jobworkerv1.StartJobRequest{
+				Command: "test", Args: []string{"-f", marker},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("StartPipeline: %v", err)
+	}
+
+	p := waitPipeline(t, ctx, c, start.PipelineId)
+	if p.Status != "COMPLETED" {
+		t.Fatalf("pipeline status = %s, want COMPLETED: %+v", p.Status, p.Stages)
+	}
+	for _, st := range p.Stages {
+		if st.State != "COMPLETED" || st.JobId == "" {
+			t.Errorf("stage %s = %s (job %q), want COMPLETED with a job ID", st.Name, st.State, st.JobId)
+		}
+	}
+
+	// Another owner cannot see the pipeline.
+	bob := h.Dial(t, "bob")
+	if _, err := bob.GetPipeline(ctx, &jobworkerv1.GetPipelineRequest{PipelineId: start.PipelineId}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetPipeline as bob = %v, want NotFound", err)
+	}
+}
+
+func TestPipelineFailFastSkipsDownstream(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartPipeline(ctx, &jobworkerv1.StartPipelineRequest{
+		Stages: []*jobworkerv1.PipelineStage{
+			{Name: "boom", Job: &jobworkerv1.StartJobRequest{Command: "false"}},
+			{Name: "after", Needs: []string{"boom"}, Job: &jobworkerv1.StartJobRequest{Command: "true"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("StartPipeline: %v", err)
+	}
+	p := waitPipeline(t, ctx, c, start.PipelineId)
+	if p.Status != "FAILED" {
+		t.Fatalf("pipeline status = %s, want FAILED", p.Status)
+	}
+	states := map[string]string{}
+	for _, st := range p.Stages {
+		states[st.Name] = st.State
+	}
+	if states["boom"] != "FAILED" || states["after"] != "SKIPPED" {
+		t.Errorf("stage states = %v, want boom FAILED and after SKIPPED", states)
+	}
+}
+
+func TestPipelineRejectsCycles(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := c.StartPipeline(ctx, &jobworkerv1.StartPipelineRequest{
+		Stages: []*jobworkerv1.PipelineStage{
+			{Name: "a", Needs: []string{"b"}, Job: &jobworkerv1.StartJobRequest{Command: "true"}},
+			{Name: "b", Needs: []string{"a"}, Job: &jobworkerv1.StartJobRequest{Command: "true"}},
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("cyclic pipeline = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSweepFansOutTemplate(t *testing.T) {
+	templates := filepath.Join(t.TempDir(), "templates.json")
+	if err := os.WriteFile(templates, []byte(`{"templates": [{
+		"name": "shardwork",
+		"command": "echo",
+		"args": ["{{region}}", "{{shard}}"],
+		"params": [{"name": "region", "required": true}, {"name": "shard", "required": true}]
+	}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, func(cfg *server.Config) { cfg.TemplatesFile = templates })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartSweep(ctx, &jobworkerv1.StartSweepRequest{
+		Template: "shardwork",
+		Matrix:   map[string]string{"region": "us,eu", "shard": "0..2"},
+	})
+	if err != nil {
+		t.Fatalf("StartSweep: %v", err)
+	}
+	if start.Started != 6 {
+		t.Fatalf("Started = %d, want 6 (2 regions x 3 shards)", start.Started)
+	}
+
+	var sw *jobworkerv1.Sweep
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := c.GetSweep(ctx, &jobworkerv1.GetSweepRequest{SweepId: start.SweepId})
+		if err != nil {
+			t.Fatalf("GetSweep: %v", err)
+		}
+		sw = resp.Sweep
+		if sw.Status != "RUNNING" {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if sw.Status != "COMPLETED" || sw.Completed != 6 || sw.Failed != 0 {
+		t.Fatalf("sweep = %s (%d done, %d failed), want COMPLETED 6/0", sw.Status, sw.Completed, sw.Failed)
+	}
+	seen := map[string]bool{}
+	for _, ch := range sw.Children {
+		seen[ch.Params["region"]+"/"+ch.Params["shard"]] = true
+	}
+	if len(seen) != 6 || !seen["eu/2"] {
+		t.Errorf("child params = %v, want all 6 combinations", seen)
+	}
+
+	// A runaway matrix is rejected before anything starts.
+	if _, err := c.StartSweep(ctx, &jobworkerv1.StartSweepRequest{
+		Template: "shardwork",
+		Matrix:   map[string]string{"region": "us", "shard": "0..5000"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("oversized sweep = %v, want InvalidArgument", err)
+	}
+}
+
+func TestStopSweepStopsChildren(t *testing.T) {
+	templates := filepath.Join(t.TempDir(), "templates.json")
+	if err := os.WriteFile(templates, []byte(`{"templates": [{
+		"name": "wait",
+		"command": "sleep",
+		"args": ["60"],
+		"params": [{"name": "i", "required": true}]
+	}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := New(t, func(cfg *server.Config) { cfg.TemplatesFile = templates })
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start, err := c.StartSweep(ctx, &jobworkerv1.StartSweepRequest{
+		Template: "wait",
+		Matrix:   map[string]string{"i": "1,2,3"},
+	})
+	if err != nil {
+		t.Fatalf("StartSweep: %v", err)
+	}
+	stop, err := c.StopSweep(ctx, &jobworkerv1.StopSweepRequest{SweepId: start.SweepId})
+	if err != nil {
+		t.Fatalf("StopSweep: %v", err)
+	}
+	if stop.Stopped != 3 {
+		t.Errorf("Stopped = %d, want 3", stop.Stopped)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		resp, err := c.GetSweep(ctx, &jobworkerv1.GetSweepRequest{SweepId: start.SweepId})
+		if err != nil {
+			t.Fatalf("GetSweep: %v", err)
+		}
+		if resp.Sweep.Status != "RUNNING" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("sweep still RUNNING after stop")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestJobHistoryRequiresStore(t *testing.T) {
+	h := New(t)
+	c := h.Dial(t, "alice")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The harness runs without a -job-store; history must say so rather
+	// than silently return nothing.
+	_, err := c.ListJobHistory(ctx, &jobworkerv1.ListJobHistoryRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ListJobHistory without a store = %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,59 @@
+package job
+
+import "strings"
+
+// StripANSI removes ANSI terminal escape sequences from a line: CSI sequences
+// (colors, cursor movement), OSC sequences (window titles, hyperlinks), and
+// lone two-byte escapes. Many tools emit them even when not attached to a
+// TTY, and they render as garbage in captured logs.
+func StripANSI(s string) string {
+	if !strings.ContainsRune(s, 0x1b) {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		if s[i] != 0x1b {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		i++
+		if i >= len(s) {
+			break
+		}
+		switch s[i] {
+		case '[':
+			// CSI: parameter bytes, then intermediate bytes, then one
+			// final byte.
+			i++
+			for i < len(s) && s[i] >= 0x30 && s[i] <= 0x3f {
+				i++
+			}
+			for i < len(s) && s[i] >= 0x20 && s[i] <= 0x2f {
+				i++
+			}
+			if i < len(s) {
+				i++
+			}
+		case ']':
+			// OSC: runs until BEL or the ESC-\ string terminator.
+			i++
+			for i < len(s) {
+				if s[i] == 0x07 {
+					i++
+					break
+				}
+				if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+					i += 2
+					break
+				}
+				i++
+			}
+		default:
+			// Two-byte escape, e.g. ESC c (reset).
+			i++
+		}
+	}
+	return b.String()
+}
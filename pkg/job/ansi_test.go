@@ -0,0 +1,34 @@
+package job
+
+import "testing"
+
+func TestStripANSI(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"plain text", "plain text"},
+		{"\x1b[31mred\x1b[0m", "red"},
+		{"\x1b[1;32;40mbold green\x1b[m", "bold green"},
+		{"move\x1b[2Kcursor\x1b[1A up", "movecursor up"},
+		{"\x1b]0;window title\x07after", "after"},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"reset\x1bc", "reset"},
+		{"trailing\x1b", "trailing"},
+	}
+	for _, c := range cases {
+		if got := StripANSI(c.in); got != c.want {
+			t.Errorf("StripANSI(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestOutputBufferStripsANSI(t *testing.T) {
+	b := NewOutputBuffer()
+	b.stripANSI = true
+	b.Append("\x1b[33mwarn\x1b[0m: disk low")
+
+	lines, _ := b.Lines(0)
+	if len(lines) != 1 || lines[0] != "warn: disk low" {
+		t.Errorf("lines = %q, want [warn: disk low]", lines)
+	}
+}
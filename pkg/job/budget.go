@@ -0,0 +1,37 @@
+package job
+
+import "fmt"
+
+// CPUBudgetPolicy says what happens when a job's process has consumed its
+// CPU budget.
+type CPUBudgetPolicy int
+
+const (
+	// CPUBudgetPause suspends the process, which stops it accruing CPU;
+	// the owner can resume it later.
+	CPUBudgetPause CPUBudgetPolicy = iota
+	// CPUBudgetKill terminates the job immediately; it finishes with
+	// StatusCPUBudget.
+	CPUBudgetKill
+)
+
+// ParseCPUBudgetPolicy parses a policy name as supplied over the API. The
+// empty string means pause.
+func ParseCPUBudgetPolicy(name string) (CPUBudgetPolicy, error) {
+	switch name {
+	case "", "pause":
+		return CPUBudgetPause, nil
+	case "kill":
+		return CPUBudgetKill, nil
+	default:
+		return 0, fmt.Errorf("unknown cpu budget policy %q; want pause or kill", name)
+	}
+}
+
+// String is the inverse of ParseCPUBudgetPolicy.
+func (p CPUBudgetPolicy) String() string {
+	if p == CPUBudgetKill {
+		return "kill"
+	}
+	return "pause"
+}
@@ -0,0 +1,75 @@
+package job
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CaptureKind selects how much of a job's output is kept.
+type CaptureKind int
+
+const (
+	// CaptureFull keeps every line.
+	CaptureFull CaptureKind = iota
+	// CaptureTail keeps only the most recent N lines.
+	CaptureTail
+	// CaptureSample keeps every Nth line.
+	CaptureSample
+	// CaptureDiscard keeps nothing; only status and exit code survive.
+	CaptureDiscard
+)
+
+// CaptureMode is a job's output retention policy. The zero value captures
+// everything.
+type CaptureMode struct {
+	Kind CaptureKind
+	// N is the line count for CaptureTail and the stride for CaptureSample.
+	N int
+}
+
+// ParseCaptureMode converts a mode name, as used in start requests, to a
+// CaptureMode: "full" (or empty), "discard", "tail:N", or "sample:N".
+func ParseCaptureMode(name string) (CaptureMode, error) {
+	switch {
+	case name == "" || name == "full":
+		return CaptureMode{Kind: CaptureFull}, nil
+	case name == "discard":
+		return CaptureMode{Kind: CaptureDiscard}, nil
+	case strings.HasPrefix(name, "tail:"):
+		n, err := captureModeCount(strings.TrimPrefix(name, "tail:"))
+		if err != nil {
+			return CaptureMode{}, fmt.Errorf("capture mode %q: %w", name, err)
+		}
+		return CaptureMode{Kind: CaptureTail, N: n}, nil
+	case strings.HasPrefix(name, "sample:"):
+		n, err := captureModeCount(strings.TrimPrefix(name, "sample:"))
+		if err != nil {
+			return CaptureMode{}, fmt.Errorf("capture mode %q: %w", name, err)
+		}
+		return CaptureMode{Kind: CaptureSample, N: n}, nil
+	default:
+		return CaptureMode{}, fmt.Errorf("unknown capture mode %q; want full, discard, tail:N, or sample:N", name)
+	}
+}
+
+func captureModeCount(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("want a positive line count, got %q", s)
+	}
+	return n, nil
+}
+
+func (m CaptureMode) String() string {
+	switch m.Kind {
+	case CaptureTail:
+		return fmt.Sprintf("tail:%d", m.N)
+	case CaptureSample:
+		return fmt.Sprintf("sample:%d", m.N)
+	case CaptureDiscard:
+		return "discard"
+	default:
+		return "full"
+	}
+}
@@ -0,0 +1,130 @@
+package job
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestParseCaptureMode(t *testing.T) {
+	cases := []struct {
+		in   string
+		want CaptureMode
+	}{
+		{"", CaptureMode{}},
+		{"full", CaptureMode{Kind: CaptureFull}},
+		{"discard", CaptureMode{Kind: CaptureDiscard}},
+		{"tail:10", CaptureMode{Kind: CaptureTail, N: 10}},
+		{"sample:3", CaptureMode{Kind: CaptureSample, N: 3}},
+	}
+	for _, c := range cases {
+		got, err := ParseCaptureMode(c.in)
+		if err != nil {
+			t.Errorf("ParseCaptureMode(%q) error: %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseCaptureMode(%q) = %+v, want %+v", c.in, got, c.want)
+		}
+	}
+	for _, in := range []string{"bogus", "tail:", "tail:0", "tail:-1", "sample:x"} {
+		if _, err := ParseCaptureMode(in); err == nil {
+			t.Errorf("ParseCaptureMode(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestCaptureTailKeepsLastN(t *testing.T) {
+	b := NewOutputBufferMode(CaptureMode{Kind: CaptureTail, N: 2})
+	b.Append("a")
+	b.Append("b")
+	b.Append("c")
+
+	lines, next, _ := b.LinesSince(0)
+	if len(lines) != 2 || lines[0] != "b" || lines[1] != "c" {
+		t.Errorf("lines = %q, want [b c]", lines)
+	}
+	if next != 3 {
+		t.Errorf("next = %d, want 3", next)
+	}
+	if b.Len() != 3 {
+		t.Errorf("Len = %d, want 3 (absolute count)", b.Len())
+	}
+
+	// A follower resuming from the returned index sees only new lines even
+	// though trimming shifted the retained window.
+	b.Append("d")
+	lines, next, _ = b.LinesSince(next)
+	if len(lines) != 1 || lines[0] != "d" {
+		t.Errorf("resumed lines = %q, want [d]", lines)
+	}
+	if next != 4 {
+		t.Errorf("next = %d, want 4", next)
+	}
+}
+
+func TestCaptureSampleKeepsEveryNth(t *testing.T) {
+	b := NewOutputBufferMode(CaptureMode{Kind: CaptureSample, N: 3})
+	for _, s := range []string{"0", "1", "2", "3", "4", "5", "6"} {
+		b.Append(s)
+	}
+	lines, _, _ := b.LinesSince(0)
+	if len(lines) != 3 || lines[0] != "0" || lines[1] != "3" || lines[2] != "6" {
+		t.Errorf("lines = %q, want [0 3 6]", lines)
+	}
+}
+
+func TestCaptureDiscardStoresNothing(t *testing.T) {
+	b := NewOutputBufferMode(CaptureMode{Kind: CaptureDiscard})
+	b.Append("a")
+	b.Append("b")
+	if b.Len() != 0 {
+		t.Errorf("Len = %d, want 0", b.Len())
+	}
+	b.Close()
+	if _, _, closed := b.LinesSince(0); !closed {
+		t.Error("buffer not reported closed after Close")
+	}
+}
+
+// TestCaptureTailSnapshotsSurviveTrim exercises readers iterating Entries
+// and EntriesSince snapshots while appends trim the tail window; run
+// under -race it catches trimming that mutates the array the snapshots
+// alias.
+func TestCaptureTailSnapshotsSurviveTrim(t *testing.T) {
+	b := NewOutputBufferMode(CaptureMode{Kind: CaptureTail, N: 4})
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				entries, _ := b.Entries(0)
+				for _, e := range entries {
+					if e.Text == "" {
+						t.Error("snapshot contains an empty line")
+						return
+					}
+				}
+				since, _, _ := b.EntriesSince(0)
+				for _, e := range since {
+					if e.Text == "" {
+						t.Error("snapshot contains an empty line")
+						return
+					}
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20000; i++ {
+		b.Append(strconv.Itoa(i + 1))
+	}
+	close(stop)
+	wg.Wait()
+}
@@ -0,0 +1,103 @@
+package job
+
+import "fmt"
+
+// EventType classifies a job state transition.
+type EventType int
+
+const (
+	// EventStarted fires when a job's process has been launched.
+	EventStarted EventType = iota
+	// EventFinished fires when a job reaches a terminal status.
+	EventFinished
+	// EventProgress fires when a job's reported progress changes; read it
+	// through Job.Progress.
+	EventProgress
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventStarted:
+		return "started"
+	case EventFinished:
+		return "finished"
+	case EventProgress:
+		return "progress"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes a job state transition.
+type Event struct {
+	Type EventType
+	Job  *Job
+}
+
+// Listener receives job events. Listeners are called from their own
+// goroutine per event and must not retain the job beyond the call if they
+// mutate it.
+type Listener func(Event)
+
+// AddListener registers a listener for all future job events. Listeners
+// cannot be removed; register them at startup.
+func (m *Manager) AddListener(l Listener) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners = append(m.listeners, l)
+}
+
+// Watch returns a channel of future job events and a cancel function that
+// must be called to release the subscription. The channel is buffered; if a
+// watcher falls far enough behind to fill it, events are dropped rather
+// than blocking job management.
+func (m *Manager) Watch() (<-chan Event, func()) {
+	ch := make(chan Event, watchBufferSize)
+	m.mu.Lock()
+	id := m.nextWatcherID
+	m.nextWatcherID++
+	m.watchers[id] = ch
+	m.mu.Unlock()
+
+	cancel := func() {
+		m.mu.Lock()
+		delete(m.watchers, id)
+		m.mu.Unlock()
+	}
+	return ch, cancel
+}
+
+// watchBufferSize is the per-watcher event buffer.
+const watchBufferSize = 64
+
+// notify fans an event out to all listeners and watchers.
+func (m *Manager) notify(ev Event) {
+	m.mu.RLock()
+	listeners := m.listeners
+	for _, ch := range m.watchers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+	m.mu.RUnlock()
+	for _, l := range listeners {
+		l := l
+		go func() {
+			// A listener panic must not take the server down with it.
+			defer func() {
+				if r := recover(); r != nil {
+					logPanic(fmt.Sprintf("%s listener for job %s", ev.Type, ev.Job.ID), r)
+				}
+			}()
+			l(ev)
+		}()
+	}
+}
+
+// watchJob emits EventFinished once the job completes.
+func (m *Manager) watchJob(j *Job) {
+	<-j.Done()
+	m.releaseName(j)
+	m.notify(Event{Type: EventFinished, Job: j})
+}
@@ -0,0 +1,204 @@
+package job
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// FakeRunner is a Runner for tests: it spawns no processes, replays output
+// scripted by the test, and exits when told to, so the server and scheduler
+// can be exercised hermetically without real commands or sleeps.
+type FakeRunner struct {
+	// StartErr, when set, makes every Start fail with this error.
+	StartErr error
+
+	mu    sync.Mutex
+	procs []*FakeProcess
+}
+
+// Start implements Runner.
+func (r *FakeRunner) Start(spec Spec, out *OutputBuffer) (Process, error) {
+	if r.StartErr != nil {
+		return nil, r.StartErr
+	}
+	p := &FakeProcess{
+		Spec:   spec,
+		out:    out,
+		exited: make(chan struct{}),
+	}
+	r.mu.Lock()
+	r.procs = append(r.procs, p)
+	r.mu.Unlock()
+	return p, nil
+}
+
+// Proc returns the i-th process started through this runner.
+func (r *FakeRunner) Proc(i int) *FakeProcess {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.procs[i]
+}
+
+// Started reports how many processes have been started.
+func (r *FakeRunner) Started() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.procs)
+}
+
+// FakeProcess is the handle a FakeRunner hands out. Tests drive it: Emit
+// writes output lines, Exit finishes the process with a chosen code.
+type FakeProcess struct {
+	Spec Spec
+
+	// IgnoreSignals keeps the process alive through Signal, for testing
+	// escalation paths. By default the first signal exits the process
+	// with code -1, as a real process dying to SIGTERM would.
+	IgnoreSignals bool
+
+	out *OutputBuffer
+
+	mu          sync.Mutex
+	signals     []os.Signal
+	code        int
+	exited      chan struct{}
+	stdin       []byte
+	stdinClosed bool
+	cpuTime     time.Duration
+	rss         int64
+	oomKilled   bool
+}
+
+// SetCPUTime scripts the CPU time CPUTime reports, for budget tests.
+func (p *FakeProcess) SetCPUTime(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cpuTime = d
+}
+
+// CPUTime implements Process.
+func (p *FakeProcess) CPUTime() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.cpuTime
+}
+
+// SetRSSBytes scripts the resident memory RSSBytes reports.
+func (p *FakeProcess) SetRSSBytes(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rss = n
+}
+
+// RSSBytes implements Process.
+func (p *FakeProcess) RSSBytes() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.rss
+}
+
+// ExitOOM finishes the process as the kernel's OOM killer would: exit
+// code -1 with OOMKilled reporting true.
+func (p *FakeProcess) ExitOOM() {
+	p.mu.Lock()
+	p.oomKilled = true
+	p.mu.Unlock()
+	p.Exit(-1)
+}
+
+// OOMKilled implements Process.
+func (p *FakeProcess) OOMKilled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.oomKilled
+}
+
+// Pid implements Process; fake processes have no OS process behind them.
+func (p *FakeProcess) Pid() int {
+	return 0
+}
+
+// fakeStdin adapts a FakeProcess into the io.WriteCloser Stdin returns.
+type fakeStdin struct{ p *FakeProcess }
+
+func (w fakeStdin) Write(b []byte) (int, error) {
+	w.p.mu.Lock()
+	defer w.p.mu.Unlock()
+	if w.p.stdinClosed {
+		return 0, fmt.Errorf("stdin is closed")
+	}
+	w.p.stdin = append(w.p.stdin, b...)
+	return len(b), nil
+}
+
+func (w fakeStdin) Close() error {
+	w.p.mu.Lock()
+	defer w.p.mu.Unlock()
+	w.p.stdinClosed = true
+	return nil
+}
+
+// Stdin implements Process.
+func (p *FakeProcess) Stdin() io.WriteCloser {
+	return fakeStdin{p: p}
+}
+
+// StdinBytes returns everything written to the process's stdin so far, and
+// whether it has been closed.
+func (p *FakeProcess) StdinBytes() ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]byte(nil), p.stdin...), p.stdinClosed
+}
+
+// Emit appends scripted output lines to the given stream.
+func (p *FakeProcess) Emit(stream Stream, lines ...string) {
+	for _, line := range lines {
+		p.out.AppendStream(stream, line)
+	}
+}
+
+// Exit finishes the process with the given code. Calling Exit twice panics,
+// like a real process exiting twice would be a bug.
+func (p *FakeProcess) Exit(code int) {
+	p.mu.Lock()
+	p.code = code
+	p.mu.Unlock()
+	close(p.exited)
+}
+
+// Signal records the signal and, unless IgnoreSignals is set, exits with
+// code -1.
+func (p *FakeProcess) Signal(sig os.Signal) error {
+	p.mu.Lock()
+	p.signals = append(p.signals, sig)
+	ignore := p.IgnoreSignals
+	p.mu.Unlock()
+	if !ignore {
+		select {
+		case <-p.exited:
+			return fmt.Errorf("process already exited")
+		default:
+			p.Exit(-1)
+		}
+	}
+	return nil
+}
+
+// Signals returns the signals delivered so far.
+func (p *FakeProcess) Signals() []os.Signal {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]os.Signal(nil), p.signals...)
+}
+
+// Wait implements Process.
+func (p *FakeProcess) Wait() int {
+	<-p.exited
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.code
+}
@@ -0,0 +1,266 @@
+package job
+
+import (
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestFakeRunnerScriptedJob(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "build"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if st, _ := j.Status(); st != StatusRunning {
+		t.Fatalf("status = %v, want RUNNING", st)
+	}
+
+	p := fr.Proc(0)
+	p.Emit(StreamStdout, "compiling", "linking")
+	p.Emit(StreamStderr, "warning: deprecated flag")
+	p.Exit(0)
+
+	select {
+	case <-j.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("job never finished")
+	}
+	if st, code := j.Status(); st != StatusCompleted || code != 0 {
+		t.Errorf("status = %v/%d, want COMPLETED/0", st, code)
+	}
+	lines := j.Output().ReadAll()
+	if len(lines) != 3 {
+		t.Fatalf("got %d output lines, want 3", len(lines))
+	}
+	if lines[2].Stream != StreamStderr {
+		t.Errorf("line 3 stream = %q, want stderr", lines[2].Stream)
+	}
+}
+
+func TestFakeRunnerExitCode(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "flaky"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).Exit(3)
+	<-j.Done()
+	if st, code := j.Status(); st != StatusFailed || code != 3 {
+		t.Errorf("status = %v/%d, want FAILED/3", st, code)
+	}
+}
+
+func TestFakeRunnerStop(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "sleepy"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if err := j.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if st, _ := j.Status(); st != StatusStopped {
+		t.Errorf("status = %v, want STOPPED", st)
+	}
+	sigs := fr.Proc(0).Signals()
+	if len(sigs) != 1 || sigs[0] != syscall.SIGTERM {
+		t.Errorf("signals = %v, want [SIGTERM]", sigs)
+	}
+}
+
+func TestWriteStdin(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "reader"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	if _, err := j.WriteStdin([]byte("input")); err != nil {
+		t.Fatalf("WriteStdin: %v", err)
+	}
+	if err := j.CloseStdin(); err != nil {
+		t.Fatalf("CloseStdin: %v", err)
+	}
+	data, closed := fr.Proc(0).StdinBytes()
+	if string(data) != "input" || !closed {
+		t.Errorf("stdin = %q closed=%v, want %q closed", data, closed, "input")
+	}
+
+	fr.Proc(0).Exit(0)
+	<-j.Done()
+	if _, err := j.WriteStdin([]byte("late")); err != ErrNotRunning {
+		t.Errorf("WriteStdin after exit = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestPauseAndResume(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "worker"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).IgnoreSignals = true
+
+	if err := j.Pause(); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+	if st, _ := j.Status(); st != StatusPaused {
+		t.Errorf("status = %v, want PAUSED", st)
+	}
+	if err := j.Pause(); err != ErrNotRunning {
+		t.Errorf("second Pause = %v, want ErrNotRunning", err)
+	}
+	if err := j.Resume(); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	if st, _ := j.Status(); st != StatusRunning {
+		t.Errorf("status = %v, want RUNNING", st)
+	}
+	if err := j.Resume(); err != ErrNotPaused {
+		t.Errorf("second Resume = %v, want ErrNotPaused", err)
+	}
+	sigs := fr.Proc(0).Signals()
+	if len(sigs) != 2 || sigs[0] != syscall.SIGSTOP || sigs[1] != syscall.SIGCONT {
+		t.Errorf("signals = %v, want [SIGSTOP SIGCONT]", sigs)
+	}
+
+	fr.Proc(0).Exit(0)
+	<-j.Done()
+	if st, _ := j.Status(); st != StatusCompleted {
+		t.Errorf("status = %v, want COMPLETED", st)
+	}
+}
+
+func TestCPUBudgetAutoPauses(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "burner", CPUBudget: time.Hour})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).IgnoreSignals = true
+	fr.Proc(0).SetCPUTime(2 * time.Hour)
+
+	deadline := time.After(5 * time.Second)
+	for {
+		if st, _ := j.Status(); st == StatusPaused {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("job was not auto-paused after exceeding its CPU budget")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+func TestHistoryTimeline(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "build"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).IgnoreSignals = true
+	if err := j.Pause(); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+	if err := j.Resume(); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	fr.Proc(0).Exit(0)
+	<-j.Done()
+
+	var got []Status
+	for _, tr := range j.History() {
+		if tr.At.IsZero() {
+			t.Errorf("transition %v has no timestamp", tr)
+		}
+		got = append(got, tr.Status)
+	}
+	want := []Status{StatusRunning, StatusPaused, StatusRunning, StatusCompleted}
+	if len(got) != len(want) {
+		t.Fatalf("history statuses = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("history statuses = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCPUBudgetKillPolicy(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{
+		Command:         "spinner",
+		CPUBudget:       time.Hour,
+		CPUBudgetPolicy: CPUBudgetKill,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).SetCPUTime(2 * time.Hour)
+
+	<-j.Done()
+	if st, _ := j.Status(); st != StatusCPUBudget {
+		t.Errorf("status = %v, want CPU_BUDGET_EXCEEDED", st)
+	}
+}
+
+func TestOOMKillStatus(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "hog"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).ExitOOM()
+
+	<-j.Done()
+	if st, _ := j.Status(); st != StatusOOM {
+		t.Errorf("status = %v, want OOM_KILLED", st)
+	}
+}
+
+func TestPeakRSSHighWaterMark(t *testing.T) {
+	fr := &FakeRunner{}
+	m := NewManager()
+	m.SetRunner(fr)
+
+	j, err := m.StartJob("alice", Spec{Command: "hog"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	fr.Proc(0).SetRSSBytes(4 << 20)
+	j.RSSBytes()
+	fr.Proc(0).SetRSSBytes(1 << 20)
+	j.RSSBytes()
+
+	if got := j.PeakRSSBytes(); got != 4<<20 {
+		t.Errorf("PeakRSSBytes() = %d, want %d", got, 4<<20)
+	}
+}
@@ -0,0 +1,165 @@
+package job
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrSlowSubscriber terminates a subscription whose queue overflowed under
+// PolicyDisconnect.
+var ErrSlowSubscriber = errors.New("subscriber too slow to keep up with output")
+
+// SubscriberPolicy selects what happens to a subscriber whose queue is full
+// when a new line arrives.
+type SubscriberPolicy int
+
+const (
+	// PolicyThrottle holds the line until the subscriber drains its queue.
+	// The subscriber lags; capture is unaffected.
+	PolicyThrottle SubscriberPolicy = iota
+	// PolicyDrop discards lines that do not fit and counts them in
+	// Dropped.
+	PolicyDrop
+	// PolicyDisconnect ends the subscription with ErrSlowSubscriber.
+	PolicyDisconnect
+)
+
+// ParseSubscriberPolicy converts a policy name, as used in flags, to a
+// SubscriberPolicy.
+func ParseSubscriberPolicy(name string) (SubscriberPolicy, error) {
+	switch name {
+	case "throttle":
+		return PolicyThrottle, nil
+	case "drop":
+		return PolicyDrop, nil
+	case "disconnect":
+		return PolicyDisconnect, nil
+	default:
+		return 0, errors.New("unknown backpressure policy; want throttle, drop, or disconnect")
+	}
+}
+
+// Subscription delivers an OutputBuffer's lines through a bounded queue so
+// one slow consumer cannot make the server hold unbounded per-consumer
+// state. Lines are pumped from the shared buffer into the queue according
+// to the configured policy.
+type Subscription struct {
+	buf    *OutputBuffer
+	policy SubscriberPolicy
+	ch     chan Line
+	done   chan struct{}
+	once   sync.Once
+
+	delivered atomic.Int64
+	dropped   atomic.Int64
+	err       error
+}
+
+// Subscribe starts delivering the buffer's lines, history first, through a
+// queue of queueSize lines. The caller must drain Lines and call Close when
+// finished.
+func (b *OutputBuffer) Subscribe(queueSize int, policy SubscriberPolicy) *Subscription {
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	s := &Subscription{
+		buf:    b,
+		policy: policy,
+		ch:     make(chan Line, queueSize),
+		done:   make(chan struct{}),
+	}
+	go s.pump()
+	return s
+}
+
+func (s *Subscription) pump() {
+	defer close(s.ch)
+	offset := 0
+	for {
+		// Grab the wait channel before snapshotting so an append between
+		// the two cannot be missed.
+		wait := s.buf.Wait()
+		entries, next, closed := s.buf.EntriesSince(offset)
+		if len(entries) == 0 {
+			if closed {
+				return
+			}
+			select {
+			case <-wait:
+				continue
+			case <-s.done:
+				return
+			}
+		}
+		offset = next
+		for _, e := range entries {
+			if !s.deliver(e) {
+				return
+			}
+		}
+	}
+}
+
+// deliver pushes one line into the queue per the policy, reporting whether
+// the subscription should continue.
+func (s *Subscription) deliver(e Line) bool {
+	switch s.policy {
+	case PolicyDrop:
+		select {
+		case <-s.done:
+			return false
+		case s.ch <- e:
+			s.delivered.Add(1)
+		default:
+			s.dropped.Add(1)
+		}
+	case PolicyDisconnect:
+		select {
+		case <-s.done:
+			return false
+		case s.ch <- e:
+			s.delivered.Add(1)
+		default:
+			s.err = ErrSlowSubscriber
+			return false
+		}
+	default: // PolicyThrottle
+		select {
+		case <-s.done:
+			return false
+		case s.ch <- e:
+			s.delivered.Add(1)
+		}
+	}
+	return true
+}
+
+// Lines is the subscriber's queue. It is closed when the buffer closes, the
+// subscription is closed, or the policy disconnects the subscriber; check
+// Err afterwards.
+func (s *Subscription) Lines() <-chan Line {
+	return s.ch
+}
+
+// Err reports why the subscription ended, once Lines is closed. It is nil
+// for a normal end of output.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Lag reports how many captured lines the subscriber has not yet been
+// handed, including lines still queued.
+func (s *Subscription) Lag() int64 {
+	return int64(s.buf.Len()) - s.delivered.Load() - s.dropped.Load()
+}
+
+// Dropped reports how many lines PolicyDrop discarded.
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close releases the subscription. It is safe to call multiple times.
+func (s *Subscription) Close() {
+	s.once.Do(func() { close(s.done) })
+}
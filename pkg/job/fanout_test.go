@@ -0,0 +1,93 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionDeliversHistoryAndLive(t *testing.T) {
+	b := NewOutputBuffer()
+	b.Append("a")
+	sub := b.Subscribe(4, PolicyThrottle)
+	defer sub.Close()
+
+	b.Append("b")
+	b.Close()
+
+	var got []string
+	for e := range sub.Lines() {
+		got = append(got, e.Text)
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("lines = %q, want [a b]", got)
+	}
+	if err := sub.Err(); err != nil {
+		t.Errorf("Err = %v, want nil", err)
+	}
+}
+
+func TestSubscriptionDropPolicy(t *testing.T) {
+	b := NewOutputBuffer()
+	for i := 0; i < 10; i++ {
+		b.Append("line")
+	}
+	sub := b.Subscribe(2, PolicyDrop)
+	defer sub.Close()
+	b.Close()
+
+	var got int
+	for range sub.Lines() {
+		got++
+	}
+	if got+int(sub.Dropped()) != 10 {
+		t.Errorf("delivered %d + dropped %d, want 10 total", got, sub.Dropped())
+	}
+	if sub.Dropped() == 0 {
+		t.Error("expected drops with a queue of 2 and 10 buffered lines")
+	}
+}
+
+func TestSubscriptionDisconnectPolicy(t *testing.T) {
+	b := NewOutputBuffer()
+	for i := 0; i < 10; i++ {
+		b.Append("line")
+	}
+	sub := b.Subscribe(2, PolicyDisconnect)
+	defer sub.Close()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-sub.Lines():
+			if ok {
+				// Never drain, so the queue stays full long enough to
+				// trip the policy on the next delivery.
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			if !errors.Is(sub.Err(), ErrSlowSubscriber) {
+				t.Errorf("Err = %v, want ErrSlowSubscriber", sub.Err())
+			}
+			return
+		case <-deadline:
+			t.Fatal("subscription never disconnected")
+		}
+	}
+}
+
+func TestParseSubscriberPolicy(t *testing.T) {
+	for name, want := range map[string]SubscriberPolicy{
+		"throttle":   PolicyThrottle,
+		"drop":       PolicyDrop,
+		"disconnect": PolicyDisconnect,
+	} {
+		got, err := ParseSubscriberPolicy(name)
+		if err != nil || got != want {
+			t.Errorf("ParseSubscriberPolicy(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseSubscriberPolicy("bogus"); err == nil {
+		t.Error("ParseSubscriberPolicy(bogus) succeeded, want error")
+	}
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"expvar"
+	"fmt"
+	"log"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// panics counts recovered panics in per-job goroutines, exported at
+// /debug/vars so a nonzero value is visible before anyone reads the logs.
+var panics = expvar.NewInt("job_panics")
+
+// guard runs fn, containing a panic to this job instead of crashing the
+// whole server: the job is marked failed with the panic recorded in its
+// log and timeline, and the job_panics metric is incremented.
+func (j *Job) guard(name string, fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			j.failFromPanic(name, r)
+		}
+	}()
+	fn()
+}
+
+// failFromPanic is the recovery path for a panicking job goroutine. The
+// process, if still alive, is killed: with its watchers gone it would
+// otherwise run unsupervised.
+func (j *Job) failFromPanic(name string, r any) {
+	panics.Add(1)
+	buf := make([]byte, 64<<10)
+	buf = buf[:runtime.Stack(buf, false)]
+	log.Printf("job %s: panic in %s: %v\n%s", j.ID, name, r, buf)
+	j.output.Append(fmt.Sprintf("[worker panic in %s: %v]", name, r))
+	j.mu.Lock()
+	if j.status == StatusRunning || j.status == StatusPaused {
+		if j.proc != nil {
+			_ = j.proc.Signal(syscall.SIGKILL)
+		}
+		j.status = StatusFailed
+		j.exitCode = -1
+		j.endedAt = time.Now()
+		j.recordLocked(StatusFailed, fmt.Sprintf("panic in %s: %v", name, r))
+	}
+	j.mu.Unlock()
+	j.output.Close()
+	j.closeDone()
+}
+
+// closeDone closes the done channel exactly once; both the normal reap
+// path and panic recovery may reach it.
+func (j *Job) closeDone() {
+	j.doneOnce.Do(func() { close(j.done) })
+}
+
+// logPanic records a recovered panic in a goroutine that is not tied to
+// one job's lifecycle, such as an event listener or an output scanner.
+func logPanic(where string, r any) {
+	panics.Add(1)
+	buf := make([]byte, 64<<10)
+	buf = buf[:runtime.Stack(buf, false)]
+	log.Printf("panic in %s: %v\n%s", where, r, buf)
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuardContainsPanicToTheJob(t *testing.T) {
+	m := NewManager()
+	j, err := m.StartJob("alice", Spec{Command: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+
+	j.guard("test watcher", func() { panic("boom") })
+
+	waitDone(t, j)
+	if st, code := j.Status(); st != StatusFailed || code != -1 {
+		t.Errorf("job = %v/%d, want failed/-1", st, code)
+	}
+	var found bool
+	for _, l := range j.Output().ReadAll() {
+		if strings.Contains(l.Text, "panic in test watcher: boom") || strings.Contains(l.Text, "[worker panic in test watcher: boom]") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("panic not recorded in the job's output")
+	}
+
+	// The manager survives and can start more jobs.
+	next, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob after panic: %v", err)
+	}
+	waitDone(t, next)
+}
+
+func TestListenerPanicIsContained(t *testing.T) {
+	m := NewManager()
+	m.AddListener(func(Event) { panic("listener boom") })
+	got := make(chan Event, 4)
+	m.AddListener(func(ev Event) { got <- ev })
+
+	j, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	waitDone(t, j)
+
+	// The well-behaved listener still sees both events.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-got:
+		case <-time.After(5 * time.Second):
+			t.Fatal("listener event not delivered")
+		}
+	}
+}
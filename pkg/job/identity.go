@@ -0,0 +1,64 @@
+package job
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// metadataName is the identity file written into per-job workspaces when
+// SetIdentityEnv is on; dotted so it stays out of artifact globs.
+const metadataName = ".job.json"
+
+// identityEnv renders the variables telling a job who launched it and
+// where it runs.
+func identityEnv(id, owner string, labels map[string]string) []string {
+	return []string{
+		"JOB_ID=" + id,
+		"JOB_OWNER=" + owner,
+		"JOB_LABELS=" + formatLabels(labels),
+	}
+}
+
+// formatLabels renders labels as sorted key=value pairs, comma-joined,
+// so scripts can parse them deterministically.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// jobMetadata is the .job.json schema.
+type jobMetadata struct {
+	ID        string            `json:"id"`
+	Owner     string            `json:"owner"`
+	Name      string            `json:"name,omitempty"`
+	Command   string            `json:"command"`
+	Args      []string          `json:"args,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	StartedAt time.Time         `json:"started_at"`
+}
+
+// writeJobMetadata drops the identity file into the job's workspace.
+func writeJobMetadata(id string, req StartRequest, labels map[string]string) error {
+	data, err := json.MarshalIndent(jobMetadata{
+		ID:        id,
+		Owner:     req.Owner,
+		Name:      req.Name,
+		Command:   req.Spec.Command,
+		Args:      req.Spec.Args,
+		Labels:    labels,
+		StartedAt: time.Now().UTC(),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding job metadata: %w", err)
+	}
+	return os.WriteFile(filepath.Join(req.Spec.Dir, metadataName), append(data, '\n'), 0o600)
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIdentityEnvExposed(t *testing.T) {
+	m := NewManager()
+	m.SetWorkspaceRoot(t.TempDir())
+	m.SetIdentityEnv(map[string]string{"zone": "a", "gpu": "true"})
+
+	j, err := m.StartNamedJob("alice", "report", Spec{
+		Command: "sh", Args: []string{"-c", "echo $JOB_OWNER/$JOB_ID/$JOB_LABELS"},
+	})
+	if err != nil {
+		t.Fatalf("StartNamedJob: %v", err)
+	}
+	waitDone(t, j)
+
+	lines := j.Output().ReadAll()
+	if len(lines) != 1 {
+		t.Fatalf("output = %q, want one line", lines)
+	}
+	want := "alice/" + j.ID + "/gpu=true,zone=a"
+	if lines[0].Text != want {
+		t.Errorf("output = %q, want %q", lines[0].Text, want)
+	}
+
+	// The workspace carries the same identity as a metadata file.
+	data, err := os.ReadFile(filepath.Join(j.Spec.Dir, metadataName))
+	if err != nil {
+		t.Fatalf("reading %s: %v", metadataName, err)
+	}
+	var meta jobMetadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		t.Fatalf("parsing %s: %v", metadataName, err)
+	}
+	if meta.ID != j.ID || meta.Owner != "alice" || meta.Name != "report" {
+		t.Errorf("metadata = %+v, want the job's identity", meta)
+	}
+}
+
+func TestIdentityEnvOffByDefault(t *testing.T) {
+	m := NewManager()
+	j, err := m.StartJob("alice", Spec{
+		Command: "sh", Args: []string{"-c", "echo owner:$JOB_OWNER"},
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	waitDone(t, j)
+	lines := j.Output().ReadAll()
+	if len(lines) != 1 || strings.TrimSpace(lines[0].Text) != "owner:" {
+		t.Errorf("output = %q, want no identity injected", lines)
+	}
+}
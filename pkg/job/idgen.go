@@ -0,0 +1,62 @@
+package job
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// IDGenerator produces job IDs. Generators must be safe for concurrent use;
+// the manager detects the (vanishingly unlikely) collision and retries, so
+// generators need not guarantee uniqueness themselves.
+type IDGenerator interface {
+	NewID() string
+}
+
+// UUIDv7Generator is the default scheme: time-ordered UUIDs, so job IDs
+// sort by creation time.
+type UUIDv7Generator struct{}
+
+// NewID returns a UUIDv7, falling back to a random UUIDv4 if the system
+// clock or entropy source misbehaves.
+func (UUIDv7Generator) NewID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
+
+// ULIDGenerator produces ULIDs: also time-ordered, but shorter and
+// case-insensitive, which reads better in logs and URLs.
+type ULIDGenerator struct {
+	mu      sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+// NewID returns a ULID. Monotonic entropy keeps IDs generated within the
+// same millisecond ordered.
+func (g *ULIDGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.entropy == nil {
+		g.entropy = ulid.Monotonic(rand.Reader, 0)
+	}
+	return ulid.MustNew(ulid.Timestamp(time.Now()), g.entropy).String()
+}
+
+// ParseIDGenerator maps a scheme name from configuration to a generator.
+func ParseIDGenerator(scheme string) (IDGenerator, error) {
+	switch scheme {
+	case "", "uuidv7":
+		return UUIDv7Generator{}, nil
+	case "ulid":
+		return &ULIDGenerator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown id scheme %q (want uuidv7 or ulid)", scheme)
+	}
+}
@@ -0,0 +1,48 @@
+package job
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseIDGenerator(t *testing.T) {
+	for _, scheme := range []string{"", "uuidv7", "ulid"} {
+		if _, err := ParseIDGenerator(scheme); err != nil {
+			t.Errorf("ParseIDGenerator(%q): %v", scheme, err)
+		}
+	}
+	if _, err := ParseIDGenerator("snowflake"); err == nil {
+		t.Error("ParseIDGenerator accepted an unknown scheme")
+	}
+}
+
+func TestULIDGeneratorFormat(t *testing.T) {
+	g := &ULIDGenerator{}
+	prev := ""
+	for i := 0; i < 10; i++ {
+		id := g.NewID()
+		if len(id) != 26 {
+			t.Fatalf("ULID %q has length %d, want 26", id, len(id))
+		}
+		if prev != "" && !(prev < id) {
+			t.Fatalf("ULIDs not monotonic: %q then %q", prev, id)
+		}
+		prev = id
+	}
+}
+
+// fixedIDs always returns the same ID, forcing collisions.
+type fixedIDs struct{ id string }
+
+func (g fixedIDs) NewID() string { return g.id }
+
+func TestStartDetectsIDCollision(t *testing.T) {
+	m := NewManagerWithIDs(fixedIDs{id: "constant"})
+	if _, err := m.StartJob("alice", Spec{Command: "true"}); err != nil {
+		t.Fatalf("first Start: %v", err)
+	}
+	_, err := m.StartJob("alice", Spec{Command: "true"})
+	if err == nil || !strings.Contains(err.Error(), "collision") {
+		t.Errorf("second Start = %v, want collision error", err)
+	}
+}
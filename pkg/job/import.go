@@ -0,0 +1,50 @@
+package job
+
+import (
+	"fmt"
+	"time"
+)
+
+// Import registers a finished job rebuilt from an export: its spec, final
+// status, and captured output. The job gets a fresh ID and the caller
+// becomes its owner; output lines keep their text and stream but are
+// re-timestamped as they are replayed into the buffer.
+func (m *Manager) Import(owner string, spec Spec, st Status, exitCode int, startedAt, endedAt time.Time, lines []Line) (*Job, error) {
+	switch st {
+	case StatusCompleted, StatusFailed, StatusStopped, StatusOutputLimit, StatusCPUBudget, StatusOOM:
+	default:
+		return nil, fmt.Errorf("cannot import a job in state %s", st)
+	}
+	if spec.Command == "" {
+		return nil, fmt.Errorf("command must not be empty")
+	}
+
+	out := NewOutputBuffer()
+	for _, l := range lines {
+		out.AppendStream(l.Stream, l.Text)
+	}
+	out.Close()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, err := m.newIDLocked()
+	if err != nil {
+		return nil, err
+	}
+	j := &Job{
+		ID:        id,
+		Owner:     owner,
+		Spec:      spec,
+		output:    out,
+		runner:    m.runner,
+		status:    st,
+		exitCode:  exitCode,
+		startedAt: startedAt,
+		endedAt:   endedAt,
+		done:      make(chan struct{}),
+		history:   []Transition{{Status: st, At: time.Now(), Note: "imported"}},
+	}
+	j.closeDone()
+	m.jobs[id] = j
+	return j, nil
+}
@@ -0,0 +1,681 @@
+// Package job implements worker jobs and their management: a Job is an
+// arbitrary command executed in its own process group with stdout and
+// stderr captured line by line, each line tagged with its source stream,
+// and a Manager tracks the set of jobs on a worker, mediating their
+// creation, lookup, and termination.
+package job
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Status is a job's position in its lifecycle.
+type Status int
+
+const (
+	StatusUnknown Status = iota
+	StatusRunning
+	// StatusCompleted means the process exited with code zero.
+	StatusCompleted
+	// StatusFailed means the process exited non-zero of its own accord.
+	StatusFailed
+	// StatusStopped means the process was terminated by StopJob.
+	StatusStopped
+	// StatusPaused means the process group is suspended by SIGSTOP and
+	// can be resumed.
+	StatusPaused
+	// StatusOutputLimit means the process was killed for exceeding its
+	// output byte cap under the kill policy.
+	StatusOutputLimit
+	// StatusCPUBudget means the process was killed for exhausting its
+	// CPU budget under the kill policy.
+	StatusCPUBudget
+	// StatusOOM means the process was killed by the kernel's
+	// out-of-memory killer.
+	StatusOOM
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusRunning:
+		return "RUNNING"
+	case StatusCompleted:
+		return "COMPLETED"
+	case StatusFailed:
+		return "FAILED"
+	case StatusStopped:
+		return "STOPPED"
+	case StatusPaused:
+		return "PAUSED"
+	case StatusOutputLimit:
+		return "OUTPUT_LIMIT_EXCEEDED"
+	case StatusCPUBudget:
+		return "CPU_BUDGET_EXCEEDED"
+	case StatusOOM:
+		return "OOM_KILLED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ErrNotRunning is returned by Stop when the job has already finished.
+var ErrNotRunning = errors.New("job is not running")
+
+// ErrNotPaused is returned by Resume when the job is not paused.
+var ErrNotPaused = errors.New("job is not paused")
+
+// stopGracePeriod is how long Stop waits after SIGTERM before escalating to
+// SIGKILL.
+const stopGracePeriod = 5 * time.Second
+
+// Spec describes the command a job runs.
+type Spec struct {
+	Command string
+	Args    []string
+	// Env is extra environment, as KEY=VALUE pairs, appended to the
+	// server's environment. Nil inherits the server's environment as is.
+	Env []string
+	// Dir is the working directory; empty inherits the server's. The
+	// manager fills it in when per-job workspaces are enabled.
+	Dir string
+	// Artifacts are glob patterns, relative to Dir, of result files to
+	// collect when the job finishes.
+	Artifacts []string
+	// CPUBudget, when positive, caps how much CPU time the job's process
+	// may consume; CPUBudgetPolicy says what happens when it is reached.
+	CPUBudget       time.Duration
+	CPUBudgetPolicy CPUBudgetPolicy
+	// Capture is the output retention policy; the zero value keeps every
+	// line.
+	Capture CaptureMode
+	// StripANSI removes terminal escape sequences from output lines as
+	// they are captured.
+	StripANSI bool
+	// Redact rewrites sensitive substrings in output lines before they
+	// are stored or streamed; nil keeps lines verbatim.
+	Redact *Redactor
+	// MaxOutputBytes, when positive, caps the job's total output;
+	// OutputLimit says what happens when the cap is crossed.
+	MaxOutputBytes int64
+	OutputLimit    OutputLimitPolicy
+	// Nice adjusts the process group's scheduling priority, -20 (highest)
+	// to 19 (lowest); zero inherits the server's.
+	Nice int
+	// Priority orders jobs for preemption; a higher-priority start may
+	// stop and requeue this job when the worker is out of GPUs. Zero is
+	// the lowest priority.
+	Priority int
+	// IOClass and IOPriority set the IO scheduling class and its level
+	// (0-7, realtime and best-effort only); IOClassNone inherits.
+	IOClass    IOClass
+	IOPriority int
+	// CPUAffinity pins the process to these CPU indices; empty means all.
+	CPUAffinity []int
+	// GPUs are the device IDs allocated to this job; the server fills
+	// them in and exposes them to the process via CUDA_VISIBLE_DEVICES.
+	GPUs []string
+	// Ports are TCP ports allocated for the job to listen on; the server
+	// fills them in and exposes them as PORT0..PORTn-1.
+	Ports []int
+	// CoreLimit, when positive, raises RLIMIT_CORE on the process so a
+	// crash dumps a core file up to this many bytes into the job's
+	// workspace, where artifact collection can pick it up. Zero inherits
+	// the server's limit, which usually forbids cores.
+	CoreLimit int64
+	// Restart says whether the process is relaunched when it exits; the
+	// job keeps its ID and output across relaunches. Stop is always
+	// terminal.
+	Restart RestartPolicy
+	// MaxRestarts, when positive, caps how many relaunches Restart may
+	// perform; zero means unlimited.
+	MaxRestarts int
+	// Probe, when set, is a liveness check; after enough consecutive
+	// failures the process is killed so Restart can relaunch it.
+	Probe *Probe
+	// MaxSilence, when positive, is the longest the job may run without
+	// producing output (a "::heartbeat" directive also counts) before it
+	// is flagged stalled; OnStall says what happens then.
+	MaxSilence time.Duration
+	OnStall    StallPolicy
+	// RescheduledFrom is the ID of the lost job this one was re-run
+	// from, if a coordinator migrated it off a dead worker.
+	RescheduledFrom string
+	// Labels are free-form key/value tags used to select groups of jobs
+	// in bulk operations; they have no effect on execution.
+	Labels map[string]string
+	// LockKey is the mutual-exclusion key the job holds while it runs;
+	// the server guarantees one running job per owner and key.
+	LockKey string
+	// ConcurrencyGroup is the worker-wide group whose max-parallelism
+	// limit the job counts against while it runs.
+	ConcurrencyGroup string
+	// ExitStatuses maps exit codes to custom terminal status labels,
+	// refining the binary completed/failed classification. A classified
+	// exit is relaunched under RestartOnFailure only when its label is
+	// ExitStatusRetryable, whatever the code's value.
+	ExitStatuses map[int]string
+	// OnSuccess and OnFailure are follow-up shell commands run as child
+	// jobs when this job finishes cleanly or fails; stop triggers
+	// neither. The manager launches them through its hook listener.
+	OnSuccess string
+	OnFailure string
+	// ParentID is the job whose post-job hook started this one; the
+	// system fills it in on hook jobs.
+	ParentID string
+	// Notify lists notification target URLs told when the job finishes,
+	// on top of the worker's per-owner notify policy.
+	Notify []string
+	// JSONLogs treats output lines as JSON objects, indexing their level
+	// and msg fields for filtering and rendering.
+	JSONLogs bool
+}
+
+// ExitStatusRetryable is the one exit-status label with retry semantics:
+// under RestartOnFailure, exits classified with it are relaunched and all
+// other classified exits are terminal.
+const ExitStatusRetryable = "retryable"
+
+// Job is a single managed process. All exported methods are safe for
+// concurrent use.
+type Job struct {
+	ID    string
+	Owner string
+	// Name is an optional caller-chosen handle, resolvable wherever a job
+	// ID is accepted. Set it before Start.
+	Name string
+	Spec Spec
+
+	output *OutputBuffer
+	runner Runner
+
+	mu            sync.Mutex
+	proc          Process
+	status        Status
+	statusLabel   string
+	exitCode      int
+	stopped       bool
+	outputLimited bool
+	overBudget    bool
+	stalled       bool
+	peakRSS       int64
+	restarts      int
+	stoppedBy     string
+	stopReason    string
+	stopAskedAt   time.Time
+	history       []Transition
+	startedAt     time.Time
+	endedAt       time.Time
+
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+// New builds a job in its initial state; call Start to launch the process.
+func New(id, owner string, spec Spec) *Job {
+	out := NewOutputBufferMode(spec.Capture)
+	out.stripANSI = spec.StripANSI
+	out.redact = spec.Redact
+	out.maxBytes = spec.MaxOutputBytes
+	out.parseJSON = spec.JSONLogs
+	j := &Job{
+		ID:     id,
+		Owner:  owner,
+		Spec:   spec,
+		output: out,
+		runner: ExecRunner{},
+		done:   make(chan struct{}),
+	}
+	if spec.MaxOutputBytes > 0 && spec.OutputLimit == OutputLimitKill {
+		out.onLimit = j.killForOutputLimit
+	}
+	return j
+}
+
+// Start launches the job's process through its runner. It returns once the
+// process has started; the job then runs until exit or Stop.
+func (j *Job) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	proc, err := j.runner.Start(j.Spec, j.output)
+	if err != nil {
+		return err
+	}
+	j.proc = proc
+	j.status = StatusRunning
+	j.startedAt = time.Now()
+	j.recordLocked(StatusRunning, "started")
+
+	// Each watcher runs under guard so a panic fails this job rather
+	// than crashing the server.
+	go j.guard("reap", j.reap)
+	go j.guard("memory watcher", j.watchMemory)
+	if j.Spec.CPUBudget > 0 {
+		go j.guard("cpu budget watcher", j.watchCPUBudget)
+	}
+	if j.Spec.Probe != nil {
+		go j.guard("probe watcher", j.watchProbe)
+	}
+	if j.Spec.MaxSilence > 0 {
+		go j.guard("stall watcher", j.watchStall)
+	}
+	return nil
+}
+
+// cpuBudgetPollInterval is how often a budgeted job's CPU time is sampled.
+const cpuBudgetPollInterval = time.Second
+
+// watchCPUBudget samples the process's CPU time and enforces the budget
+// once it is exhausted: pause under the default policy (a paused job stops
+// accruing CPU, so no further samples are needed), kill under
+// CPUBudgetKill.
+func (j *Job) watchCPUBudget() {
+	ticker := time.NewTicker(cpuBudgetPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-ticker.C:
+			j.mu.Lock()
+			proc := j.proc
+			j.mu.Unlock()
+			if proc.CPUTime() > j.Spec.CPUBudget {
+				if j.Spec.CPUBudgetPolicy == CPUBudgetKill {
+					j.killForCPUBudget()
+				} else {
+					_ = j.Pause()
+				}
+				return
+			}
+		}
+	}
+}
+
+// reap waits for the process to exit, relaunches it while the restart
+// policy allows, and records the final state once an exit is terminal.
+func (j *Job) reap() {
+	j.mu.Lock()
+	proc := j.proc
+	j.mu.Unlock()
+	for {
+		code := proc.Wait()
+
+		j.mu.Lock()
+		if j.shouldRestartLocked(code) {
+			next, err := j.runner.Start(j.Spec, j.output)
+			if err == nil {
+				j.restarts++
+				j.proc = next
+				j.status = StatusRunning
+				j.recordLocked(StatusRunning, fmt.Sprintf("restart %d after exit code %d", j.restarts, code))
+				proc = next
+				j.mu.Unlock()
+				continue
+			}
+			// The relaunch itself failed; fall through and finish with
+			// the exit we already have.
+		}
+		j.output.Close()
+		j.endedAt = time.Now()
+		switch {
+		case j.outputLimited:
+			j.status = StatusOutputLimit
+			j.exitCode = code
+		case j.overBudget:
+			j.status = StatusCPUBudget
+			j.exitCode = code
+		case j.stopped:
+			j.status = StatusStopped
+			j.exitCode = code
+		case proc.OOMKilled():
+			j.status = StatusOOM
+			j.exitCode = code
+		case code == 0:
+			j.status = StatusCompleted
+			j.exitCode = 0
+		default:
+			j.status = StatusFailed
+			j.exitCode = code
+		}
+		note := fmt.Sprintf("exit code %d", code)
+		if label, ok := j.Spec.ExitStatuses[code]; ok && !j.stopped && !j.outputLimited && !j.overBudget {
+			j.statusLabel = label
+			note += " (" + label + ")"
+		}
+		j.recordLocked(j.status, note)
+		j.mu.Unlock()
+		j.closeDone()
+		return
+	}
+}
+
+// recordLocked appends a timeline entry. Caller holds j.mu.
+func (j *Job) recordLocked(st Status, note string) {
+	j.history = append(j.history, Transition{Status: st, At: time.Now(), Note: note})
+}
+
+// Transition is one entry in a job's timeline: the status after the event,
+// when it happened, and what caused it.
+type Transition struct {
+	Status Status
+	At     time.Time
+	Note   string
+}
+
+// History returns the job's timeline so far, oldest first.
+func (j *Job) History() []Transition {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]Transition(nil), j.history...)
+}
+
+// shouldRestartLocked decides whether an exit with the given code is
+// relaunched rather than terminal. Caller holds j.mu.
+func (j *Job) shouldRestartLocked(code int) bool {
+	if j.stopped || j.outputLimited || j.overBudget {
+		return false
+	}
+	// An exit-status rule overrides the policy's zero/non-zero failure
+	// test: under RestartOnFailure a classified exit relaunches only when
+	// its label is ExitStatusRetryable.
+	if label, classified := j.Spec.ExitStatuses[code]; classified && j.Spec.Restart == RestartOnFailure {
+		if label != ExitStatusRetryable {
+			return false
+		}
+	} else if !j.Spec.Restart.shouldRestart(code) {
+		return false
+	}
+	return j.Spec.MaxRestarts == 0 || j.restarts < j.Spec.MaxRestarts
+}
+
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
+
+// Annotate appends an owner-written marker line to the job's output
+// timeline, tagged StreamMarker so readers can render it apart from
+// process output. Markers go through the same capture pipeline as output,
+// so they are redacted and counted like any other line.
+func (j *Job) Annotate(text string) error {
+	j.mu.Lock()
+	if j.status != StatusRunning && j.status != StatusPaused {
+		j.mu.Unlock()
+		return ErrNotRunning
+	}
+	j.mu.Unlock()
+	j.output.AppendStream(StreamMarker, text)
+	return nil
+}
+
+// Pause suspends the job's process group with SIGSTOP.
+func (j *Job) Pause() error {
+	j.mu.Lock()
+	if j.status != StatusRunning {
+		j.mu.Unlock()
+		return ErrNotRunning
+	}
+	j.status = StatusPaused
+	j.recordLocked(StatusPaused, "")
+	proc := j.proc
+	j.mu.Unlock()
+	return proc.Signal(syscall.SIGSTOP)
+}
+
+// Resume continues a paused job's process group with SIGCONT.
+func (j *Job) Resume() error {
+	j.mu.Lock()
+	if j.status != StatusPaused {
+		j.mu.Unlock()
+		return ErrNotPaused
+	}
+	j.status = StatusRunning
+	j.recordLocked(StatusRunning, "resumed")
+	proc := j.proc
+	j.mu.Unlock()
+	return proc.Signal(syscall.SIGCONT)
+}
+
+// killForOutputLimit terminates the job for exceeding its output cap under
+// the kill policy. No grace period: a log bomb gets SIGKILL outright.
+func (j *Job) killForOutputLimit() {
+	j.mu.Lock()
+	if j.status != StatusRunning && j.status != StatusPaused {
+		j.mu.Unlock()
+		return
+	}
+	paused := j.status == StatusPaused
+	j.outputLimited = true
+	proc := j.proc
+	j.mu.Unlock()
+
+	if paused {
+		_ = proc.Signal(syscall.SIGCONT)
+	}
+	_ = proc.Signal(syscall.SIGKILL)
+}
+
+// killForCPUBudget terminates the job for exhausting its CPU budget under
+// the kill policy. No grace period: a spinning process only burns more of
+// what it has already run out of.
+func (j *Job) killForCPUBudget() {
+	j.mu.Lock()
+	if j.status != StatusRunning && j.status != StatusPaused {
+		j.mu.Unlock()
+		return
+	}
+	paused := j.status == StatusPaused
+	j.overBudget = true
+	proc := j.proc
+	j.mu.Unlock()
+
+	if paused {
+		_ = proc.Signal(syscall.SIGCONT)
+	}
+	_ = proc.Signal(syscall.SIGKILL)
+}
+
+// Stop terminates the job's process: SIGTERM first, then SIGKILL if it has
+// not exited within the grace period. A paused job is continued first so
+// the SIGTERM can be delivered.
+func (j *Job) Stop() error {
+	return j.StopBy("", "")
+}
+
+// StopBy is Stop with attribution: who asked for the termination and why,
+// recorded for post-mortems and reported by StopInfo.
+func (j *Job) StopBy(by, reason string) error {
+	j.mu.Lock()
+	if j.status != StatusRunning && j.status != StatusPaused {
+		j.mu.Unlock()
+		return ErrNotRunning
+	}
+	paused := j.status == StatusPaused
+	j.stopped = true
+	j.stoppedBy = by
+	j.stopReason = reason
+	j.stopAskedAt = time.Now()
+	note := "stop requested"
+	if by != "" {
+		note += " by " + by
+	}
+	if reason != "" {
+		note += ": " + reason
+	}
+	j.recordLocked(j.status, note)
+	proc := j.proc
+	j.mu.Unlock()
+
+	if paused {
+		_ = proc.Signal(syscall.SIGCONT)
+	}
+	_ = proc.Signal(syscall.SIGTERM)
+	select {
+	case <-j.done:
+	case <-time.After(stopGracePeriod):
+		_ = proc.Signal(syscall.SIGKILL)
+		<-j.done
+	}
+	return nil
+}
+
+// WriteStdin writes b to the job's standard input. The write happens
+// outside the job lock: a full pipe must not block Status or Stop.
+func (j *Job) WriteStdin(b []byte) (int, error) {
+	j.mu.Lock()
+	if j.status != StatusRunning {
+		j.mu.Unlock()
+		return 0, ErrNotRunning
+	}
+	stdin := j.proc.Stdin()
+	j.mu.Unlock()
+	return stdin.Write(b)
+}
+
+// CloseStdin closes the job's standard input, delivering EOF.
+func (j *Job) CloseStdin() error {
+	j.mu.Lock()
+	if j.status != StatusRunning {
+		j.mu.Unlock()
+		return ErrNotRunning
+	}
+	stdin := j.proc.Stdin()
+	j.mu.Unlock()
+	return stdin.Close()
+}
+
+// Status returns the job's current status and, once finished, its exit code.
+func (j *Job) Status() (Status, int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status, j.exitCode
+}
+
+// StatusLabel returns the custom terminal status the job's exit code mapped
+// to under Spec.ExitStatuses; empty while running or when unclassified.
+func (j *Job) StatusLabel() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.statusLabel
+}
+
+// StopInfo reports who asked for the job's termination, why, and when the
+// request arrived. All zero unless the job was stopped through StopBy.
+func (j *Job) StopInfo() (by, reason string, at time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.stoppedBy, j.stopReason, j.stopAskedAt
+}
+
+// Restarts returns how many times the process has been relaunched by the
+// restart policy.
+func (j *Job) Restarts() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.restarts
+}
+
+// CPUTime reports the CPU time the job's process has consumed so far; for
+// a finished job, the final total of its last launch.
+func (j *Job) CPUTime() time.Duration {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.proc == nil {
+		return 0
+	}
+	return j.proc.CPUTime()
+}
+
+// RSSBytes reports the resident memory of the job's process; zero once it
+// has finished.
+func (j *Job) RSSBytes() int64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.proc == nil {
+		return 0
+	}
+	rss := j.proc.RSSBytes()
+	if rss > j.peakRSS {
+		j.peakRSS = rss
+	}
+	return rss
+}
+
+// memPollInterval is how often a job's resident memory is sampled for the
+// peak-usage figure.
+const memPollInterval = time.Second
+
+// watchMemory samples the process's resident memory so PeakRSSBytes has a
+// figure even when no one polled the job while it ran.
+func (j *Job) watchMemory() {
+	ticker := time.NewTicker(memPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-ticker.C:
+			j.RSSBytes()
+		}
+	}
+}
+
+// PeakRSSBytes reports the largest resident memory observed for the job's
+// process. It is a sampled high-water mark, so a short spike between
+// samples can be missed.
+func (j *Job) PeakRSSBytes() int64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.peakRSS
+}
+
+// Pid reports the OS process ID of the job's process while it is running
+// or paused; zero otherwise.
+func (j *Job) Pid() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.proc == nil || (j.status != StatusRunning && j.status != StatusPaused) {
+		return 0
+	}
+	return j.proc.Pid()
+}
+
+// Progress returns the job's last reported progress, parsed from
+// "::progress done/total [message]" lines in its output; zero total means
+// the job has not reported any.
+func (j *Job) Progress() (done, total int64, msg string) {
+	return j.output.Progress()
+}
+
+// StartedAt returns when the process was launched.
+func (j *Job) StartedAt() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.startedAt
+}
+
+// EndedAt returns when the process finished, or the zero time while running.
+func (j *Job) EndedAt() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.endedAt
+}
+
+// Done returns a channel closed when the job has finished.
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// Output returns the job's output buffer.
+func (j *Job) Output() *OutputBuffer {
+	return j.output
+}
@@ -0,0 +1,80 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func waitDone(t *testing.T, j *Job) {
+	t.Helper()
+	select {
+	case <-j.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for job to finish")
+	}
+}
+
+func TestJobCompletes(t *testing.T) {
+	j := New("id", "alice", Spec{Command: "sh", Args: []string{"-c", "echo one; echo two"}})
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+
+	status, exitCode := j.Status()
+	if status != StatusCompleted {
+		t.Errorf("status = %v, want %v", status, StatusCompleted)
+	}
+	if exitCode != 0 {
+		t.Errorf("exit code = %d, want 0", exitCode)
+	}
+	lines, closed := j.Output().Lines(0)
+	if !closed {
+		t.Error("output buffer not closed after job finished")
+	}
+	if len(lines) != 2 || lines[0] != "one" || lines[1] != "two" {
+		t.Errorf("lines = %q, want [one two]", lines)
+	}
+}
+
+func TestJobFails(t *testing.T) {
+	j := New("id", "alice", Spec{Command: "sh", Args: []string{"-c", "exit 3"}})
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+
+	status, exitCode := j.Status()
+	if status != StatusFailed {
+		t.Errorf("status = %v, want %v", status, StatusFailed)
+	}
+	if exitCode != 3 {
+		t.Errorf("exit code = %d, want 3", exitCode)
+	}
+}
+
+func TestJobStop(t *testing.T) {
+	j := New("id", "alice", Spec{Command: "sleep", Args: []string{"60"}})
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := j.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	waitDone(t, j)
+
+	status, _ := j.Status()
+	if status != StatusStopped {
+		t.Errorf("status = %v, want %v", status, StatusStopped)
+	}
+	if err := j.Stop(); err != ErrNotRunning {
+		t.Errorf("second Stop = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestJobStartBadCommand(t *testing.T) {
+	j := New("id", "alice", Spec{Command: "/no/such/binary"})
+	if err := j.Start(); err == nil {
+		t.Fatal("Start succeeded for a nonexistent binary")
+	}
+}
@@ -0,0 +1,37 @@
+package job
+
+import "fmt"
+
+// OutputLimitPolicy says what happens when a job's output exceeds its byte
+// cap.
+type OutputLimitPolicy int
+
+const (
+	// OutputLimitTruncate drops further output, leaving a single marker
+	// line; the job keeps running.
+	OutputLimitTruncate OutputLimitPolicy = iota
+	// OutputLimitKill terminates the job immediately; it finishes with
+	// StatusOutputLimit.
+	OutputLimitKill
+)
+
+// ParseOutputLimitPolicy parses a policy name as supplied over the API.
+// The empty string means truncate.
+func ParseOutputLimitPolicy(name string) (OutputLimitPolicy, error) {
+	switch name {
+	case "", "truncate":
+		return OutputLimitTruncate, nil
+	case "kill":
+		return OutputLimitKill, nil
+	default:
+		return 0, fmt.Errorf("unknown output limit policy %q; want truncate or kill", name)
+	}
+}
+
+// String is the inverse of ParseOutputLimitPolicy.
+func (p OutputLimitPolicy) String() string {
+	if p == OutputLimitKill {
+		return "kill"
+	}
+	return "truncate"
+}
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestParseOutputLimitPolicy(t *testing.T) {
+	for name, want := range map[string]OutputLimitPolicy{
+		"":         OutputLimitTruncate,
+		"truncate": OutputLimitTruncate,
+		"kill":     OutputLimitKill,
+	} {
+		got, err := ParseOutputLimitPolicy(name)
+		if err != nil {
+			t.Errorf("ParseOutputLimitPolicy(%q) error: %v", name, err)
+		} else if got != want {
+			t.Errorf("ParseOutputLimitPolicy(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if _, err := ParseOutputLimitPolicy("bogus"); err == nil {
+		t.Error("ParseOutputLimitPolicy(bogus) succeeded, want error")
+	}
+}
+
+func TestOutputLimitTruncates(t *testing.T) {
+	b := NewOutputBuffer()
+	b.maxBytes = 10
+	b.Append("12345")     // 6 bytes with newline
+	b.Append("6789")      // 11 total, over the cap
+	b.Append("straggler") // dropped
+
+	lines, _ := b.Lines(0)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines %q, want 2", len(lines), lines)
+	}
+	if lines[0] != "12345" {
+		t.Errorf("lines[0] = %q, want 12345", lines[0])
+	}
+	if !strings.Contains(lines[1], "output truncated") {
+		t.Errorf("lines[1] = %q, want a truncation marker", lines[1])
+	}
+}
+
+func TestOutputLimitKillsJob(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command:        "yes",
+		MaxOutputBytes: 10,
+		OutputLimit:    OutputLimitKill,
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	p := runner.Proc(0)
+	p.Emit(StreamStdout, "123456789012345")
+
+	select {
+	case <-j.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job never finished after exceeding its output cap")
+	}
+	if st, _ := j.Status(); st != StatusOutputLimit {
+		t.Errorf("status = %v, want OUTPUT_LIMIT_EXCEEDED", st)
+	}
+	sigs := p.Signals()
+	if len(sigs) == 0 || sigs[len(sigs)-1] != syscall.SIGKILL {
+		t.Errorf("signals = %v, want SIGKILL last", sigs)
+	}
+}
@@ -0,0 +1,458 @@
+package job
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned when a job ID does not exist.
+var ErrNotFound = errors.New("job not found")
+
+// ErrAmbiguous is returned when a job reference matches more than one job.
+var ErrAmbiguous = errors.New("ambiguous job reference")
+
+// idempotencyTTL is how long a start's idempotency key is remembered; a
+// replay after this window starts a fresh job.
+const idempotencyTTL = 24 * time.Hour
+
+// idemEntry records which job an idempotency key created and until when the
+// key is honored.
+type idemEntry struct {
+	jobID   string
+	expires time.Time
+}
+
+// resultCacheTTL is how long a cacheable job's successful result is reused
+// when the request does not set its own TTL.
+const resultCacheTTL = 24 * time.Hour
+
+// cacheEntry records which job a content key produced. Validity is checked
+// at lookup: only a successfully completed job within its TTL is reused.
+type cacheEntry struct {
+	jobID string
+	ttl   time.Duration
+}
+
+// Manager owns every job started on this worker. Jobs are kept after they
+// finish so their status and output remain queryable.
+type Manager struct {
+	ids    IDGenerator
+	runner Runner
+
+	// workspaceRoot, when set, gives every job a fresh working directory
+	// under it, named by job ID.
+	workspaceRoot string
+
+	// identityEnv, when enabled, tells each job who launched it and where
+	// it runs; see SetIdentityEnv.
+	identityEnv    bool
+	identityLabels map[string]string
+
+	// namePolicy says what a named start does when an active job already
+	// holds the name; see SetNameCollisionPolicy.
+	namePolicy NameCollisionPolicy
+
+	mu          sync.RWMutex
+	jobs        map[string]*Job
+	idempotency map[string]idemEntry
+	cache       map[string]cacheEntry
+	// names indexes active named jobs by owner and name, for collision
+	// checks; entries are dropped when the job finishes.
+	names         map[string]*Job
+	listeners     []Listener
+	watchers      map[int]chan Event
+	nextWatcherID int
+}
+
+// NewManager returns an empty manager using the default ID scheme.
+func NewManager() *Manager {
+	return NewManagerWithIDs(UUIDv7Generator{})
+}
+
+// NewManagerWithIDs returns an empty manager generating job IDs with gen.
+func NewManagerWithIDs(gen IDGenerator) *Manager {
+	return &Manager{
+		ids:         gen,
+		runner:      ExecRunner{},
+		jobs:        make(map[string]*Job),
+		idempotency: make(map[string]idemEntry),
+		cache:       make(map[string]cacheEntry),
+		names:       make(map[string]*Job),
+		watchers:    make(map[int]chan Event),
+	}
+}
+
+// SetRunner replaces how this manager's jobs launch processes; intended for
+// tests using a FakeRunner. Call it before the first Start.
+func (m *Manager) SetRunner(r Runner) {
+	m.runner = r
+}
+
+// SetWorkspaceRoot enables per-job workspaces under dir: each job runs in
+// its own fresh directory there unless its spec names one explicitly. Call
+// it before the first Start.
+func (m *Manager) SetWorkspaceRoot(dir string) {
+	m.workspaceRoot = dir
+}
+
+// SetIdentityEnv exposes the requester's identity to every job: JOB_ID,
+// JOB_OWNER, and JOB_LABELS environment variables, plus a .job.json
+// metadata file in jobs that get their own workspace. labels are the
+// worker's scheduling labels. Call it before the first Start.
+func (m *Manager) SetIdentityEnv(labels map[string]string) {
+	m.identityEnv = true
+	m.identityLabels = labels
+}
+
+// StartRequest describes a job to start.
+type StartRequest struct {
+	Owner string
+	// Name is an optional caller-chosen handle, usable wherever a job ID
+	// is accepted.
+	Name string
+	// IdempotencyKey, if set, makes the start replay-safe: a key seen
+	// within idempotencyTTL returns the job it created instead of
+	// launching another process. Keys are scoped to the owner.
+	IdempotencyKey string
+	// InputDir, if set, is a directory of staged input files moved into
+	// the job's workspace before launch; the start consumes it. Requires
+	// per-job workspaces.
+	InputDir string
+	// Cacheable marks the job deterministic: Start records its content
+	// key so a later identical start can reuse the result. Callers check
+	// CachedResult before Start (and before allocating per-job
+	// resources) to get the reuse. The key is a hash of command, args,
+	// and env unless CacheKey overrides it; keys are scoped to the
+	// owner.
+	Cacheable bool
+	CacheKey  string
+	// CacheTTL bounds how long after completion the result is reused;
+	// zero means resultCacheTTL.
+	CacheTTL time.Duration
+	// NoCache forces execution even when a cached result exists; the
+	// fresh run replaces the cache entry.
+	NoCache bool
+	Spec    Spec
+}
+
+// StartJob creates a job owned by owner, starts its process, and registers
+// it. The job is not registered if the process fails to start.
+func (m *Manager) StartJob(owner string, spec Spec) (*Job, error) {
+	return m.Start(StartRequest{Owner: owner, Spec: spec})
+}
+
+// StartNamedJob is StartJob with an optional caller-chosen name.
+func (m *Manager) StartNamedJob(owner, name string, spec Spec) (*Job, error) {
+	return m.Start(StartRequest{Owner: owner, Name: name, Spec: spec})
+}
+
+// Start creates a job from the request, starts its process, and registers
+// it. The job is not registered if the process fails to start. The lock is
+// held across the launch so a concurrently replayed idempotency key cannot
+// start a second process.
+func (m *Manager) Start(req StartRequest) (*Job, error) {
+	if req.Spec.Command == "" {
+		return nil, fmt.Errorf("command must not be empty")
+	}
+
+	m.mu.Lock()
+	var key string
+	if req.IdempotencyKey != "" {
+		key = req.Owner + "\x00" + req.IdempotencyKey
+		if e, ok := m.idempotency[key]; ok && time.Now().Before(e.expires) {
+			if j, ok := m.jobs[e.jobID]; ok {
+				m.mu.Unlock()
+				return j, nil
+			}
+		}
+	}
+
+	// Named starts go through the collision policy. A replace stops the
+	// current holder outside the lock, then retries from the top.
+	if req.Name != "" {
+		name, prev, err := m.resolveNameLocked(req.Owner, req.Name)
+		if err != nil {
+			m.mu.Unlock()
+			return nil, err
+		}
+		if prev != nil {
+			m.mu.Unlock()
+			_ = prev.StopBy("server", fmt.Sprintf("replaced by a new start of %q", req.Name))
+			return m.Start(req)
+		}
+		req.Name = name
+	}
+
+	var cacheKey string
+	if req.Cacheable {
+		cacheKey = req.cacheKey()
+	}
+
+	id, err := m.newIDLocked()
+	if err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	if m.workspaceRoot != "" && req.Spec.Dir == "" {
+		dir := filepath.Join(m.workspaceRoot, id)
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("creating job workspace: %w", err)
+		}
+		req.Spec.Dir = dir
+	}
+	if req.InputDir != "" {
+		if m.workspaceRoot == "" || req.Spec.Dir == "" {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("staged input requires per-job workspaces")
+		}
+		if err := moveInput(req.InputDir, req.Spec.Dir); err != nil {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("placing staged input: %w", err)
+		}
+	}
+	if m.identityEnv {
+		// Injected after the cache key is derived, so the per-job ID does
+		// not defeat result caching.
+		req.Spec.Env = append(req.Spec.Env, identityEnv(id, req.Owner, m.identityLabels)...)
+		if req.Spec.Dir != "" {
+			if err := writeJobMetadata(id, req, m.identityLabels); err != nil {
+				m.mu.Unlock()
+				return nil, fmt.Errorf("writing job metadata: %w", err)
+			}
+		}
+	}
+	j := New(id, req.Owner, req.Spec)
+	j.Name = req.Name
+	j.runner = m.runner
+	// Progress directives surface as manager events so watchers see
+	// updates without polling.
+	j.output.onProgress = func() { m.notify(Event{Type: EventProgress, Job: j}) }
+	if err := j.Start(); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	m.jobs[j.ID] = j
+	if j.Name != "" {
+		m.names[nameKey(j.Owner, j.Name)] = j
+	}
+	if key != "" {
+		m.pruneIdempotencyLocked()
+		m.idempotency[key] = idemEntry{jobID: j.ID, expires: time.Now().Add(idempotencyTTL)}
+	}
+	if cacheKey != "" {
+		m.cache[cacheKey] = cacheEntry{jobID: j.ID, ttl: req.CacheTTL}
+	}
+	m.mu.Unlock()
+
+	m.notify(Event{Type: EventStarted, Job: j})
+	go j.guard("finish watcher", func() { m.watchJob(j) })
+	return j, nil
+}
+
+// moveInput moves a staged upload's contents into the workspace. Staging
+// directories live under the workspace root, so a rename per entry suffices.
+func moveInput(from, to string) error {
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := os.Rename(filepath.Join(from, e.Name()), filepath.Join(to, e.Name())); err != nil {
+			return err
+		}
+	}
+	return os.Remove(from)
+}
+
+// idRetries bounds how often an ID collision is retried before the insert
+// is given up as misconfigured entropy.
+const idRetries = 5
+
+// newIDLocked generates a job ID that is not already registered; called
+// with the lock held so the ID stays unique until the job is inserted.
+func (m *Manager) newIDLocked() (string, error) {
+	for i := 0; i < idRetries; i++ {
+		id := m.ids.NewID()
+		if _, exists := m.jobs[id]; !exists {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("generating job ID: %d collisions in a row", idRetries)
+}
+
+// pruneIdempotencyLocked drops expired keys; called with the lock held on
+// the insert path so the map cannot grow without bound.
+func (m *Manager) pruneIdempotencyLocked() {
+	now := time.Now()
+	for k, e := range m.idempotency {
+		if now.After(e.expires) {
+			delete(m.idempotency, k)
+		}
+	}
+}
+
+// cacheKey is the owner-scoped content key this request caches under.
+func (req StartRequest) cacheKey() string {
+	content := req.CacheKey
+	if content == "" {
+		content = contentKey(req.Spec)
+	}
+	return req.Owner + "\x00" + content
+}
+
+// CachedResult returns a prior job whose result this cacheable request can
+// reuse, or nil when there is none (or the request is not cacheable, or
+// sets NoCache). Check it before Start, and before allocating any per-job
+// resources the reused job would not consume.
+func (m *Manager) CachedResult(req StartRequest) *Job {
+	if !req.Cacheable || req.NoCache {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cachedResultLocked(req.cacheKey())
+}
+
+// contentKey derives the default cache key from what determines a
+// deterministic job's result: the command, its arguments, and its
+// environment.
+func contentKey(spec Spec) string {
+	h := sha256.New()
+	for _, part := range append(append([]string{spec.Command}, spec.Args...), spec.Env...) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cachedResultLocked returns the job a cache key points at if it is still
+// reusable: present, completed successfully, and within its TTL. Entries
+// for failed or expired runs are dropped so the next start re-executes.
+func (m *Manager) cachedResultLocked(key string) *Job {
+	e, ok := m.cache[key]
+	if !ok {
+		return nil
+	}
+	j, ok := m.jobs[e.jobID]
+	if !ok {
+		delete(m.cache, key)
+		return nil
+	}
+	st, code := j.Status()
+	switch st {
+	case StatusCompleted:
+		if code != 0 {
+			delete(m.cache, key)
+			return nil
+		}
+	case StatusRunning, StatusPaused:
+		// Still in flight; the new start proceeds and takes over the
+		// entry when it registers.
+		return nil
+	default:
+		delete(m.cache, key)
+		return nil
+	}
+	ttl := e.ttl
+	if ttl == 0 {
+		ttl = resultCacheTTL
+	}
+	if time.Since(j.EndedAt()) > ttl {
+		delete(m.cache, key)
+		return nil
+	}
+	return j
+}
+
+// GetJob returns the job with the given ID.
+func (m *Manager) GetJob(id string) (*Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return j, nil
+}
+
+// ResolveJob finds owner's job by exact ID, by name, or by unique ID
+// prefix. Name and prefix matching are scoped to the owner so references
+// cannot probe other owners' jobs.
+func (m *Manager) ResolveJob(owner, ref string) (*Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if j, ok := m.jobs[ref]; ok {
+		return j, nil
+	}
+	// With a collision policy enforcing unique active names, the name
+	// index resolves a reused name to its current holder instead of
+	// reporting the finished earlier runs as ambiguous.
+	if m.namePolicy != NameCollisionAllow {
+		if j := m.activeNamedLocked(owner, ref); j != nil {
+			return j, nil
+		}
+	}
+	var matches []*Job
+	for _, j := range m.jobs {
+		if j.Owner != owner {
+			continue
+		}
+		if (j.Name != "" && j.Name == ref) || strings.HasPrefix(j.ID, ref) {
+			matches = append(matches, j)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	default:
+		return nil, fmt.Errorf("%w: %q matches %d jobs", ErrAmbiguous, ref, len(matches))
+	}
+}
+
+// StopJob stops the job with the given ID.
+func (m *Manager) StopJob(id string) error {
+	j, err := m.GetJob(id)
+	if err != nil {
+		return err
+	}
+	return j.Stop()
+}
+
+// RunningCount returns the number of jobs currently running.
+func (m *Manager) RunningCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	count := 0
+	for _, j := range m.jobs {
+		if st, _ := j.Status(); st == StatusRunning {
+			count++
+		}
+	}
+	return count
+}
+
+// ListJobs returns all jobs ordered by start time.
+func (m *Manager) ListJobs() []*Job {
+	m.mu.RLock()
+	jobs := make([]*Job, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		jobs = append(jobs, j)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, k int) bool {
+		return jobs[i].StartedAt().Before(jobs[k].StartedAt())
+	})
+	return jobs
+}
@@ -0,0 +1,237 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStartAndGetJob(t *testing.T) {
+	m := NewManager()
+	j, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	got, err := m.GetJob(j.ID)
+	if err != nil {
+		t.Fatalf("GetJob: %v", err)
+	}
+	if got != j {
+		t.Error("GetJob returned a different job")
+	}
+	select {
+	case <-j.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("job never finished")
+	}
+}
+
+func TestGetJobNotFound(t *testing.T) {
+	m := NewManager()
+	if _, err := m.GetJob("nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetJob = %v, want ErrNotFound", err)
+	}
+	if err := m.StopJob("nope"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("StopJob = %v, want ErrNotFound", err)
+	}
+}
+
+func TestResolveJob(t *testing.T) {
+	m := NewManager()
+	j, err := m.StartNamedJob("alice", "nightly", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartNamedJob: %v", err)
+	}
+
+	for _, ref := range []string{j.ID, "nightly", j.ID[:8]} {
+		got, err := m.ResolveJob("alice", ref)
+		if err != nil {
+			t.Fatalf("ResolveJob(%q): %v", ref, err)
+		}
+		if got != j {
+			t.Errorf("ResolveJob(%q) returned a different job", ref)
+		}
+	}
+
+	// Name and prefix matching are owner-scoped.
+	if _, err := m.ResolveJob("bob", "nightly"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ResolveJob as bob = %v, want ErrNotFound", err)
+	}
+}
+
+func TestResolveJobAmbiguous(t *testing.T) {
+	m := NewManager()
+	for i := 0; i < 2; i++ {
+		if _, err := m.StartNamedJob("alice", "dup", Spec{Command: "true"}); err != nil {
+			t.Fatalf("StartNamedJob: %v", err)
+		}
+	}
+	if _, err := m.ResolveJob("alice", "dup"); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("ResolveJob = %v, want ErrAmbiguous", err)
+	}
+}
+
+func TestStartJobEmptyCommand(t *testing.T) {
+	m := NewManager()
+	if _, err := m.StartJob("alice", Spec{}); err == nil {
+		t.Fatal("StartJob succeeded with empty command")
+	}
+}
+
+func TestWatchSeesStartAndFinish(t *testing.T) {
+	m := NewManager()
+	events, cancel := m.Watch()
+	defer cancel()
+
+	j, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+
+	for _, want := range []EventType{EventStarted, EventFinished} {
+		select {
+		case ev := <-events:
+			if ev.Type != want || ev.Job != j {
+				t.Errorf("event = {%v %s}, want {%v %s}", ev.Type, ev.Job.ID, want, j.ID)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("never received %v event", want)
+		}
+	}
+}
+
+func TestListJobsOrdered(t *testing.T) {
+	m := NewManager()
+	first, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	second, err := m.StartJob("alice", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	jobs := m.ListJobs()
+	if len(jobs) != 2 {
+		t.Fatalf("ListJobs returned %d jobs, want 2", len(jobs))
+	}
+	if jobs[0] != first || jobs[1] != second {
+		t.Error("ListJobs not ordered by start time")
+	}
+	<-first.Done()
+	<-second.Done()
+}
+
+func TestStartIdempotencyKey(t *testing.T) {
+	m := NewManager()
+	req := StartRequest{Owner: "alice", IdempotencyKey: "deploy-42", Spec: Spec{Command: "true"}}
+	first, err := m.Start(req)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	replay, err := m.Start(req)
+	if err != nil {
+		t.Fatalf("replayed Start: %v", err)
+	}
+	if replay != first {
+		t.Error("replayed key started a new job")
+	}
+
+	// The same key from another owner is a different key.
+	other, err := m.Start(StartRequest{Owner: "bob", IdempotencyKey: "deploy-42", Spec: Spec{Command: "true"}})
+	if err != nil {
+		t.Fatalf("Start as bob: %v", err)
+	}
+	if other == first {
+		t.Error("idempotency key leaked across owners")
+	}
+}
+
+func TestStartIdempotencyKeyExpires(t *testing.T) {
+	m := NewManager()
+	first, err := m.Start(StartRequest{Owner: "alice", IdempotencyKey: "k", Spec: Spec{Command: "true"}})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	m.mu.Lock()
+	e := m.idempotency["alice\x00k"]
+	e.expires = time.Now().Add(-time.Second)
+	m.idempotency["alice\x00k"] = e
+	m.mu.Unlock()
+	second, err := m.Start(StartRequest{Owner: "alice", IdempotencyKey: "k", Spec: Spec{Command: "true"}})
+	if err != nil {
+		t.Fatalf("Start after expiry: %v", err)
+	}
+	if second == first {
+		t.Error("expired key still replayed the old job")
+	}
+}
+
+func TestCachedResult(t *testing.T) {
+	m := NewManager()
+	req := StartRequest{Owner: "alice", Cacheable: true, Spec: Spec{Command: "true"}}
+	if m.CachedResult(req) != nil {
+		t.Fatal("cache hit before any run")
+	}
+	j, err := m.Start(req)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+
+	if got := m.CachedResult(req); got != j {
+		t.Errorf("CachedResult = %v, want the completed job", got)
+	}
+	other := req
+	other.Spec.Args = []string{"different"}
+	if m.CachedResult(other) != nil {
+		t.Error("cache hit for different args")
+	}
+	bob := req
+	bob.Owner = "bob"
+	if m.CachedResult(bob) != nil {
+		t.Error("cache hit across owners")
+	}
+	noCache := req
+	noCache.NoCache = true
+	if m.CachedResult(noCache) != nil {
+		t.Error("cache hit despite NoCache")
+	}
+	// A user-supplied key overrides the content hash entirely.
+	keyed := StartRequest{Owner: "alice", Cacheable: true, CacheKey: "build-v1", Spec: Spec{Command: "true"}}
+	k, err := m.Start(keyed)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, k)
+	keyed.Spec.Command = "false"
+	if got := m.CachedResult(keyed); got != k {
+		t.Errorf("CachedResult with user key = %v, want the keyed job", got)
+	}
+}
+
+func TestCachedResultSkipsFailedRuns(t *testing.T) {
+	m := NewManager()
+	req := StartRequest{Owner: "alice", Cacheable: true, Spec: Spec{Command: "false"}}
+	j, err := m.Start(req)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+	if m.CachedResult(req) != nil {
+		t.Error("cache hit on a failed run")
+	}
+}
+
+func TestCachedResultExpires(t *testing.T) {
+	m := NewManager()
+	req := StartRequest{Owner: "alice", Cacheable: true, CacheTTL: time.Nanosecond, Spec: Spec{Command: "true"}}
+	j, err := m.Start(req)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+	time.Sleep(10 * time.Millisecond)
+	if m.CachedResult(req) != nil {
+		t.Error("cache hit past the TTL")
+	}
+}
@@ -0,0 +1,51 @@
+package job
+
+import (
+	"os"
+	"time"
+)
+
+// Manifest is a job's reproducibility record: the resolved execution
+// environment as the worker launched it. Env entries pass through the
+// job's redactor, so secret values appear masked.
+type Manifest struct {
+	Command     string
+	Args        []string
+	Dir         string
+	Env         []string
+	Hostname    string
+	Kernel      string
+	Nice        int
+	IOClass     string
+	CPUAffinity []int
+	CoreLimit   int64
+	CPUBudget   time.Duration
+	StartedAt   time.Time
+}
+
+// Manifest reports the job's reproducibility record. The environment is
+// the server's own plus the spec's additions, exactly as the runner
+// resolves it for the process.
+func (j *Job) Manifest() Manifest {
+	env := os.Environ()
+	env = append(env[:len(env):len(env)], j.Spec.Env...)
+	redacted := make([]string, len(env))
+	for i, e := range env {
+		redacted[i] = j.Spec.Redact.Apply(e)
+	}
+	host, _ := os.Hostname()
+	return Manifest{
+		Command:     j.Spec.Command,
+		Args:        append([]string(nil), j.Spec.Args...),
+		Dir:         j.Spec.Dir,
+		Env:         redacted,
+		Hostname:    host,
+		Kernel:      kernelVersion(),
+		Nice:        j.Spec.Nice,
+		IOClass:     j.Spec.IOClass.String(),
+		CPUAffinity: append([]int(nil), j.Spec.CPUAffinity...),
+		CoreLimit:   j.Spec.CoreLimit,
+		CPUBudget:   j.Spec.CPUBudget,
+		StartedAt:   j.StartedAt(),
+	}
+}
@@ -0,0 +1,94 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNameTaken is returned by Start under NameCollisionReject when the
+// requested name is held by an active job of the same owner.
+var ErrNameTaken = errors.New("job name is already in use")
+
+// NameCollisionPolicy says what Start does when a named start collides
+// with an active (running or paused) job of the same owner and name.
+// Finished jobs never block a name: names outlive jobs only in history.
+type NameCollisionPolicy int
+
+const (
+	// NameCollisionAllow lets duplicate active names coexist; resolving
+	// such a name reports it ambiguous. This is the zero value and the
+	// manager's historical behavior.
+	NameCollisionAllow NameCollisionPolicy = iota
+	// NameCollisionReject fails the new start with ErrNameTaken.
+	NameCollisionReject
+	// NameCollisionReplace stops the active job, then starts the new one
+	// under the name.
+	NameCollisionReplace
+	// NameCollisionSuffix starts the new job under the first free
+	// numbered variant: name-2, name-3, and so on.
+	NameCollisionSuffix
+)
+
+// SetNameCollisionPolicy sets how named starts treat an active job
+// already holding the name. Call it before the first Start.
+func (m *Manager) SetNameCollisionPolicy(p NameCollisionPolicy) {
+	m.namePolicy = p
+}
+
+// nameKey scopes a name index entry to its owner.
+func nameKey(owner, name string) string {
+	return owner + "\x00" + name
+}
+
+// activeNamedLocked returns the active job holding the name, if any. Entries
+// for finished jobs may linger briefly until the finish watcher runs;
+// they are treated as free.
+func (m *Manager) activeNamedLocked(owner, name string) *Job {
+	j, ok := m.names[nameKey(owner, name)]
+	if !ok {
+		return nil
+	}
+	if st, _ := j.Status(); st != StatusRunning && st != StatusPaused {
+		return nil
+	}
+	return j
+}
+
+// resolveNameLocked applies the collision policy to a named start. It
+// returns the name the job should run under, or the active holder the
+// caller must stop and retry after (NameCollisionReplace only), or an
+// error (NameCollisionReject).
+func (m *Manager) resolveNameLocked(owner, name string) (string, *Job, error) {
+	prev := m.activeNamedLocked(owner, name)
+	if prev == nil {
+		return name, nil, nil
+	}
+	switch m.namePolicy {
+	case NameCollisionReject:
+		return "", nil, fmt.Errorf("%w: %q is held by job %s", ErrNameTaken, name, prev.ID)
+	case NameCollisionReplace:
+		return "", prev, nil
+	case NameCollisionSuffix:
+		for i := 2; ; i++ {
+			variant := fmt.Sprintf("%s-%d", name, i)
+			if m.activeNamedLocked(owner, variant) == nil {
+				return variant, nil, nil
+			}
+		}
+	default:
+		return name, nil, nil
+	}
+}
+
+// releaseName drops a finished job's name index entry, unless the name
+// has since been claimed by a newer job.
+func (m *Manager) releaseName(j *Job) {
+	if j.Name == "" {
+		return
+	}
+	m.mu.Lock()
+	if key := nameKey(j.Owner, j.Name); m.names[key] == j {
+		delete(m.names, key)
+	}
+	m.mu.Unlock()
+}
@@ -0,0 +1,84 @@
+package job
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNameCollisionReject(t *testing.T) {
+	m := NewManager()
+	m.SetNameCollisionPolicy(NameCollisionReject)
+
+	j, err := m.StartNamedJob("alice", "deploy", Spec{Command: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("StartNamedJob: %v", err)
+	}
+	defer m.StopJob(j.ID)
+
+	if _, err := m.StartNamedJob("alice", "deploy", Spec{Command: "true"}); !errors.Is(err, ErrNameTaken) {
+		t.Errorf("duplicate start = %v, want ErrNameTaken", err)
+	}
+	// Names are owner-scoped: bob may run his own deploy.
+	bobs, err := m.StartNamedJob("bob", "deploy", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartNamedJob as bob: %v", err)
+	}
+	<-bobs.Done()
+
+	// A finished job frees its name.
+	if err := m.StopJob(j.ID); err != nil {
+		t.Fatalf("StopJob: %v", err)
+	}
+	again, err := m.StartNamedJob("alice", "deploy", Spec{Command: "true"})
+	if err != nil {
+		t.Fatalf("StartNamedJob after stop: %v", err)
+	}
+	<-again.Done()
+}
+
+func TestNameCollisionReplace(t *testing.T) {
+	m := NewManager()
+	m.SetNameCollisionPolicy(NameCollisionReplace)
+
+	old, err := m.StartNamedJob("alice", "deploy", Spec{Command: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("StartNamedJob: %v", err)
+	}
+	repl, err := m.StartNamedJob("alice", "deploy", Spec{Command: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("replacing StartNamedJob: %v", err)
+	}
+	defer m.StopJob(repl.ID)
+
+	if repl == old {
+		t.Fatal("replacement returned the old job")
+	}
+	if st, _ := old.Status(); st != StatusStopped {
+		t.Errorf("old job status = %v, want STOPPED", st)
+	}
+	got, err := m.ResolveJob("alice", "deploy")
+	if err != nil {
+		t.Fatalf("ResolveJob: %v", err)
+	}
+	if got != repl {
+		t.Error("deploy does not resolve to the replacement job")
+	}
+}
+
+func TestNameCollisionSuffix(t *testing.T) {
+	m := NewManager()
+	m.SetNameCollisionPolicy(NameCollisionSuffix)
+
+	var names []string
+	for i := 0; i < 3; i++ {
+		j, err := m.StartNamedJob("alice", "deploy", Spec{Command: "sleep", Args: []string{"60"}})
+		if err != nil {
+			t.Fatalf("StartNamedJob %d: %v", i, err)
+		}
+		defer m.StopJob(j.ID)
+		names = append(names, j.Name)
+	}
+	if names[0] != "deploy" || names[1] != "deploy-2" || names[2] != "deploy-3" {
+		t.Errorf("names = %v, want [deploy deploy-2 deploy-3]", names)
+	}
+}
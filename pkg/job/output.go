@@ -0,0 +1,351 @@
+package job
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stream identifies which of the process's output streams a line came from.
+type Stream string
+
+const (
+	StreamStdout Stream = "stdout"
+	StreamStderr Stream = "stderr"
+	// StreamMarker tags annotation lines the owner appended through
+	// AnnotateJob rather than process output.
+	StreamMarker Stream = "marker"
+)
+
+// Line is one captured output line with its source stream and the moment it
+// was read. Time is the wall clock; Mono is the monotonic offset from buffer
+// creation and is safe to compare across wall-clock adjustments.
+type Line struct {
+	Text   string
+	Stream Stream
+	Time   time.Time
+	Mono   time.Duration
+	// Level and Msg are the indexed fields of a parsed JSON-lines entry;
+	// both empty unless the buffer runs in JSON-lines mode and the line
+	// parsed.
+	Level string
+	Msg   string
+}
+
+// OutputBuffer accumulates a job's combined stdout/stderr as lines and lets
+// any number of readers observe the full history plus live appends. Writers
+// and readers never block each other for longer than a map/slice append.
+type OutputBuffer struct {
+	mu     sync.Mutex
+	start  time.Time
+	mode   CaptureMode
+	lines  []Line
+	closed bool
+	// stripANSI removes terminal escape sequences from lines as they are
+	// appended.
+	stripANSI bool
+	// redact rewrites sensitive substrings before a line is stored; nil
+	// keeps lines verbatim.
+	redact *Redactor
+	// parseJSON treats lines as JSON objects, indexing their level and
+	// msg fields; lines that fail to parse are stored as is.
+	parseJSON bool
+	// Progress directives (::progress done/total [message]) are consumed
+	// from the stream into these fields rather than stored as lines.
+	// onProgress, if set, fires after each change of the rendered
+	// progress, outside the lock.
+	progressDone  int64
+	progressTotal int64
+	progressMsg   string
+	onProgress    func()
+	// lastAppend is when output last arrived, counting consumed
+	// directives; the stall watcher reads it through LastActivity.
+	lastAppend time.Time
+	// bytes counts what has been offered so far. maxBytes, when positive,
+	// caps the total bytes of output accepted; limited latches once the
+	// cap is crossed. onLimit, if set, fires once at that moment.
+	maxBytes int64
+	bytes    int64
+	limited  bool
+	onLimit  func()
+	// base is the absolute index of lines[0]: how many stored lines a
+	// tail capture mode has already discarded from the front.
+	base int
+	// seen counts lines offered to a sample capture mode, kept or not.
+	seen int
+	// wait is closed and replaced whenever new lines arrive or the buffer
+	// is closed, waking every blocked reader.
+	wait chan struct{}
+}
+
+// NewOutputBuffer returns an empty, open buffer capturing everything.
+func NewOutputBuffer() *OutputBuffer {
+	return NewOutputBufferMode(CaptureMode{})
+}
+
+// NewOutputBufferMode returns an empty, open buffer with the given capture
+// mode.
+func NewOutputBufferMode(mode CaptureMode) *OutputBuffer {
+	now := time.Now()
+	return &OutputBuffer{start: now, lastAppend: now, mode: mode, wait: make(chan struct{})}
+}
+
+// Append adds one stdout line to the buffer, stamping it with the current
+// time. Appending to a closed buffer is a no-op.
+func (b *OutputBuffer) Append(line string) {
+	b.AppendStream(StreamStdout, line)
+}
+
+// parseJSONLine extracts the indexed fields of one JSON-lines entry. A
+// line that is not a JSON object yields empty fields.
+func parseJSONLine(line string) (level, msg string) {
+	if !strings.HasPrefix(strings.TrimSpace(line), "{") {
+		return "", ""
+	}
+	var fields struct {
+		Level   string `json:"level"`
+		Msg     string `json:"msg"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return "", ""
+	}
+	if fields.Msg == "" {
+		fields.Msg = fields.Message
+	}
+	return strings.ToLower(fields.Level), fields.Msg
+}
+
+// progressPrefix marks a progress directive line, the convention long
+// jobs use to report completion: "::progress 42/100 optional message".
+// A bare percentage ("::progress 42%") is shorthand for 42/100.
+const progressPrefix = "::progress "
+
+// parseProgress decodes the payload of a progress directive. ok is false
+// when the payload is malformed, in which case the line is kept as
+// ordinary output.
+func parseProgress(payload string) (done, total int64, msg string, ok bool) {
+	ratio, msg, _ := strings.Cut(strings.TrimSpace(payload), " ")
+	if pct, found := strings.CutSuffix(ratio, "%"); found {
+		done, err := strconv.ParseInt(pct, 10, 64)
+		if err != nil || done < 0 {
+			return 0, 0, "", false
+		}
+		return done, 100, strings.TrimSpace(msg), true
+	}
+	doneStr, totalStr, found := strings.Cut(ratio, "/")
+	if !found {
+		return 0, 0, "", false
+	}
+	done, err := strconv.ParseInt(doneStr, 10, 64)
+	if err != nil || done < 0 {
+		return 0, 0, "", false
+	}
+	total, err = strconv.ParseInt(totalStr, 10, 64)
+	if err != nil || total <= 0 {
+		return 0, 0, "", false
+	}
+	return done, total, strings.TrimSpace(msg), true
+}
+
+// heartbeatDirective is a keepalive a quiet job can emit to satisfy a
+// max-silence requirement without adding log lines; like progress
+// directives, it is consumed rather than stored.
+const heartbeatDirective = "::heartbeat"
+
+// LastActivity reports when output last arrived, counting consumed
+// directives; before any output it is the buffer's creation time.
+func (b *OutputBuffer) LastActivity() time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastAppend
+}
+
+// Progress returns the job's last reported progress; zero total means the
+// job has not reported any.
+func (b *OutputBuffer) Progress() (done, total int64, msg string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.progressDone, b.progressTotal, b.progressMsg
+}
+
+// AppendStream adds one line from the given stream to the buffer, stamping
+// it with the current time. Appending to a closed buffer is a no-op.
+// Progress directives are consumed into the progress fields instead of
+// being stored, so frequent updates do not flood the log.
+func (b *OutputBuffer) AppendStream(stream Stream, line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.lastAppend = time.Now()
+	if strings.TrimSpace(line) == heartbeatDirective {
+		return
+	}
+	if payload, found := strings.CutPrefix(line, progressPrefix); found {
+		if done, total, msg, ok := parseProgress(payload); ok {
+			changed := done != b.progressDone || total != b.progressTotal || msg != b.progressMsg
+			b.progressDone, b.progressTotal, b.progressMsg = done, total, msg
+			if changed && b.onProgress != nil {
+				go b.onProgress()
+			}
+			return
+		}
+	}
+	if b.stripANSI {
+		line = StripANSI(line)
+	}
+	line = b.redact.Apply(line)
+	if b.limited {
+		return
+	}
+	b.bytes += int64(len(line)) + 1
+	if b.maxBytes > 0 {
+		if b.bytes > b.maxBytes {
+			b.limited = true
+			if b.onLimit != nil {
+				// Kill policy: fire outside the lock, since the callback
+				// walks back into the job's own locking.
+				go b.onLimit()
+				return
+			}
+			marker := fmt.Sprintf("[output truncated: %d byte limit exceeded]", b.maxBytes)
+			now := time.Now()
+			b.lines = append(b.lines, Line{Text: marker, Stream: stream, Time: now, Mono: now.Sub(b.start)})
+			b.broadcastLocked()
+			return
+		}
+	}
+	switch b.mode.Kind {
+	case CaptureDiscard:
+		return
+	case CaptureSample:
+		b.seen++
+		if (b.seen-1)%b.mode.N != 0 {
+			return
+		}
+	}
+	now := time.Now()
+	entry := Line{Text: line, Stream: stream, Time: now, Mono: now.Sub(b.start)}
+	if b.parseJSON {
+		entry.Level, entry.Msg = parseJSONLine(line)
+	}
+	b.lines = append(b.lines, entry)
+	if b.mode.Kind == CaptureTail && len(b.lines) > b.mode.N {
+		k := len(b.lines) - b.mode.N
+		b.base += k
+		// Copy into a fresh array rather than shifting in place: Entries
+		// hands out slices aliasing the current one, and readers iterate
+		// them outside the lock. The old array is freed once the last
+		// snapshot lets go of it.
+		trimmed := make([]Line, b.mode.N)
+		copy(trimmed, b.lines[k:])
+		b.lines = trimmed
+	}
+	b.broadcastLocked()
+}
+
+// Bytes reports the total size of output offered to the buffer so far,
+// counting one byte per line terminator, whatever the capture mode kept.
+func (b *OutputBuffer) Bytes() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bytes
+}
+
+// Close marks the buffer complete. Readers draining past the end observe
+// closed=true instead of blocking.
+func (b *OutputBuffer) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	b.broadcastLocked()
+}
+
+func (b *OutputBuffer) broadcastLocked() {
+	close(b.wait)
+	b.wait = make(chan struct{})
+}
+
+// Entries returns a snapshot of the retained lines at index from onward and
+// whether the buffer has been closed. The returned slice aliases the
+// internal storage and must not be modified.
+func (b *OutputBuffer) Entries(from int) ([]Line, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if from > len(b.lines) {
+		from = len(b.lines)
+	}
+	return b.lines[from:], b.closed
+}
+
+// EntriesSince returns the lines at absolute index from onward — indices
+// count every stored line, including ones a tail capture mode has since
+// discarded — plus the absolute index to resume from and whether the buffer
+// has been closed. Followers use it so trimming cannot replay lines.
+func (b *OutputBuffer) EntriesSince(from int) ([]Line, int, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	i := from - b.base
+	if i < 0 {
+		i = 0
+	}
+	if i > len(b.lines) {
+		i = len(b.lines)
+	}
+	return b.lines[i:], b.base + len(b.lines), b.closed
+}
+
+// Lines is like Entries but returns just the line text.
+func (b *OutputBuffer) Lines(from int) ([]string, bool) {
+	entries, closed := b.Entries(from)
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.Text
+	}
+	return lines, closed
+}
+
+// LinesSince is like EntriesSince but returns just the line text.
+func (b *OutputBuffer) LinesSince(from int) ([]string, int, bool) {
+	entries, next, closed := b.EntriesSince(from)
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.Text
+	}
+	return lines, next, closed
+}
+
+// Len returns the number of lines stored so far, including any a tail
+// capture mode has since discarded.
+func (b *OutputBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.base + len(b.lines)
+}
+
+// Wait returns a channel that is closed on the next append or close. Callers
+// should re-check Lines after the channel fires.
+func (b *OutputBuffer) Wait() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.wait
+}
+
+// ReadAll returns a snapshot of every captured line with its structure
+// (stream, timestamps) intact. Unlike a flattened string, the result can be
+// re-windowed and re-filtered without reparsing.
+func (b *OutputBuffer) ReadAll() []Line {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	lines := make([]Line, len(b.lines))
+	copy(lines, b.lines)
+	return lines
+}
@@ -0,0 +1,114 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutputBufferAppendAndLines(t *testing.T) {
+	b := NewOutputBuffer()
+	b.Append("a")
+	b.Append("b")
+
+	lines, closed := b.Lines(0)
+	if closed {
+		t.Error("buffer reported closed before Close")
+	}
+	if len(lines) != 2 || lines[0] != "a" || lines[1] != "b" {
+		t.Errorf("lines = %q, want [a b]", lines)
+	}
+
+	lines, _ = b.Lines(1)
+	if len(lines) != 1 || lines[0] != "b" {
+		t.Errorf("lines from 1 = %q, want [b]", lines)
+	}
+
+	b.Close()
+	if _, closed := b.Lines(0); !closed {
+		t.Error("buffer not reported closed after Close")
+	}
+}
+
+func TestOutputBufferWaitWakesOnAppend(t *testing.T) {
+	b := NewOutputBuffer()
+	wait := b.Wait()
+	go b.Append("line")
+	select {
+	case <-wait:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait channel never fired after Append")
+	}
+}
+
+func TestOutputBufferAppendAfterClose(t *testing.T) {
+	b := NewOutputBuffer()
+	b.Close()
+	b.Append("late")
+	if b.Len() != 0 {
+		t.Errorf("Len = %d after append-to-closed, want 0", b.Len())
+	}
+}
+
+func TestJSONLinesParsing(t *testing.T) {
+	b := NewOutputBuffer()
+	b.parseJSON = true
+	b.Append(`{"level":"ERROR","msg":"db unreachable"}`)
+	b.Append(`{"level":"info","message":"retrying"}`)
+	b.Append("plain text line")
+
+	lines := b.ReadAll()
+	if len(lines) != 3 {
+		t.Fatalf("len = %d, want 3", len(lines))
+	}
+	if lines[0].Level != "error" || lines[0].Msg != "db unreachable" {
+		t.Errorf("parsed fields = %q/%q, want error/db unreachable", lines[0].Level, lines[0].Msg)
+	}
+	// The message field is an accepted alias for msg; levels normalize to
+	// lower case.
+	if lines[1].Level != "info" || lines[1].Msg != "retrying" {
+		t.Errorf("parsed fields = %q/%q, want info/retrying", lines[1].Level, lines[1].Msg)
+	}
+	// Unparseable lines pass through with no indexed fields.
+	if lines[2].Level != "" || lines[2].Msg != "" {
+		t.Errorf("plain line fields = %q/%q, want empty", lines[2].Level, lines[2].Msg)
+	}
+	if lines[2].Text != "plain text line" {
+		t.Errorf("plain line text = %q", lines[2].Text)
+	}
+}
+
+func TestProgressDirectives(t *testing.T) {
+	b := NewOutputBuffer()
+	b.Append("::progress 3/10 warming up")
+	b.Append("regular output")
+	b.Append("::progress 40%")
+
+	// Directives are consumed by the capture pipeline, not stored.
+	lines := b.ReadAll()
+	if len(lines) != 1 || lines[0].Text != "regular output" {
+		t.Fatalf("stored lines = %v, want just the regular line", lines)
+	}
+	done, total, msg := b.Progress()
+	if done != 40 || total != 100 {
+		t.Errorf("progress = %d/%d, want 40/100", done, total)
+	}
+	// The percent shorthand carries no message; the last directive wins
+	// wholesale.
+	if msg != "" {
+		t.Errorf("progress msg = %q, want empty", msg)
+	}
+}
+
+func TestProgressDirectiveMalformed(t *testing.T) {
+	b := NewOutputBuffer()
+	b.Append("::progress soon")
+	done, total, _ := b.Progress()
+	if done != 0 || total != 0 {
+		t.Errorf("progress = %d/%d after malformed directive, want 0/0", done, total)
+	}
+	// A line that only resembles a directive is kept as ordinary output.
+	lines := b.ReadAll()
+	if len(lines) != 1 || lines[0].Text != "::progress soon" {
+		t.Fatalf("stored lines = %v, want the malformed directive verbatim", lines)
+	}
+}
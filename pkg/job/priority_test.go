@@ -0,0 +1,48 @@
+package job
+
+import "testing"
+
+func TestParseIOClass(t *testing.T) {
+	for name, want := range map[string]IOClass{
+		"":            IOClassNone,
+		"realtime":    IOClassRealtime,
+		"best-effort": IOClassBestEffort,
+		"idle":        IOClassIdle,
+	} {
+		got, err := ParseIOClass(name)
+		if err != nil {
+			t.Errorf("ParseIOClass(%q) error: %v", name, err)
+		} else if got != want {
+			t.Errorf("ParseIOClass(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if _, err := ParseIOClass("bogus"); err == nil {
+		t.Error("ParseIOClass(bogus) succeeded, want error")
+	}
+}
+
+func TestJobWithSchedulingControls(t *testing.T) {
+	// Lowering priority, the idle IO class, and pinning to CPU 0 are all
+	// allowed unprivileged; the point is that the syscalls succeed and the
+	// job still runs.
+	j := New("id", "alice", Spec{
+		Command:     "sh",
+		Args:        []string{"-c", "echo ok"},
+		Nice:        10,
+		IOClass:     IOClassIdle,
+		CPUAffinity: []int{0},
+	})
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	waitDone(t, j)
+
+	status, exitCode := j.Status()
+	if status != StatusCompleted || exitCode != 0 {
+		t.Errorf("status = %v exit = %d, want COMPLETED 0", status, exitCode)
+	}
+	lines, _ := j.Output().Lines(0)
+	if len(lines) != 1 || lines[0] != "ok" {
+		t.Errorf("lines = %q, want [ok]", lines)
+	}
+}
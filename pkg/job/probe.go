@@ -0,0 +1,148 @@
+package job
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ProbeKind is how a liveness probe checks the job.
+type ProbeKind int
+
+const (
+	// ProbeTCP succeeds when a TCP connection to the target is accepted.
+	ProbeTCP ProbeKind = iota
+	// ProbeHTTP succeeds when a GET on the target URL returns a status
+	// below 400.
+	ProbeHTTP
+	// ProbeExec succeeds when the target shell command exits zero.
+	ProbeExec
+)
+
+// Probe is a job's liveness check. The supervisor kills the process after
+// probeFailureThreshold consecutive failures, letting the restart policy
+// relaunch it.
+type Probe struct {
+	Kind   ProbeKind
+	Target string
+	// Interval between checks; zero means defaultProbeInterval.
+	Interval time.Duration
+}
+
+const (
+	defaultProbeInterval = 10 * time.Second
+	// probeFailureThreshold is how many checks must fail in a row before
+	// the process is killed; one blip is not an outage.
+	probeFailureThreshold = 3
+	// probeTimeout bounds a single check.
+	probeTimeout = 5 * time.Second
+)
+
+// ParseProbe parses a probe as supplied over the API: "tcp:host:port",
+// "http://..." or "https://...", or "exec:command". Empty means no probe.
+func ParseProbe(s string) (*Probe, error) {
+	switch {
+	case s == "":
+		return nil, nil
+	case strings.HasPrefix(s, "tcp:"):
+		target := strings.TrimPrefix(s, "tcp:")
+		if _, _, err := net.SplitHostPort(target); err != nil {
+			return nil, fmt.Errorf("tcp probe target %q: %w", target, err)
+		}
+		return &Probe{Kind: ProbeTCP, Target: target}, nil
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		return &Probe{Kind: ProbeHTTP, Target: s}, nil
+	case strings.HasPrefix(s, "exec:"):
+		target := strings.TrimPrefix(s, "exec:")
+		if target == "" {
+			return nil, fmt.Errorf("exec probe has no command")
+		}
+		return &Probe{Kind: ProbeExec, Target: target}, nil
+	default:
+		return nil, fmt.Errorf("unknown probe %q; want tcp:host:port, http(s)://url, or exec:command", s)
+	}
+}
+
+// String is the inverse of ParseProbe.
+func (p *Probe) String() string {
+	switch p.Kind {
+	case ProbeHTTP:
+		return p.Target
+	case ProbeExec:
+		return "exec:" + p.Target
+	default:
+		return "tcp:" + p.Target
+	}
+}
+
+// interval returns the effective time between checks.
+func (p *Probe) interval() time.Duration {
+	if p.Interval > 0 {
+		return p.Interval
+	}
+	return defaultProbeInterval
+}
+
+// check runs one probe attempt.
+func (p *Probe) check() error {
+	switch p.Kind {
+	case ProbeTCP:
+		conn, err := net.DialTimeout("tcp", p.Target, probeTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case ProbeHTTP:
+		client := http.Client{Timeout: probeTimeout}
+		resp, err := client.Get(p.Target)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("status %s", resp.Status)
+		}
+		return nil
+	default:
+		return exec.Command("sh", "-c", p.Target).Run()
+	}
+}
+
+// watchProbe runs the job's liveness probe until the job finishes, killing
+// the process group after enough consecutive failures. The restart policy
+// decides what happens next.
+func (j *Job) watchProbe() {
+	probe := j.Spec.Probe
+	ticker := time.NewTicker(probe.interval())
+	defer ticker.Stop()
+	failures := 0
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-ticker.C:
+		}
+		j.mu.Lock()
+		running := j.status == StatusRunning
+		proc := j.proc
+		j.mu.Unlock()
+		// A paused process cannot answer probes; that is not an outage.
+		if !running {
+			failures = 0
+			continue
+		}
+		if probe.check() == nil {
+			failures = 0
+			continue
+		}
+		failures++
+		if failures >= probeFailureThreshold {
+			_ = proc.Signal(syscall.SIGKILL)
+			failures = 0
+		}
+	}
+}
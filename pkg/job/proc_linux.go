@@ -0,0 +1,162 @@
+//go:build linux
+
+package job
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// This file is the Linux side of the platform layer: the prctl, ioprio,
+// affinity, and /proc pieces that have no portable equivalent. The
+// darwin/freebsd counterparts live in proc_other.go.
+
+// prSetChildSubreaper is PR_SET_CHILD_SUBREAPER from <linux/prctl.h>,
+// spelled out here to keep the stdlib-only build.
+const prSetChildSubreaper = 36
+
+// enableSubreaper marks this process as a child subreaper via prctl(2).
+func enableSubreaper() error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_PRCTL, prSetChildSubreaper, 1, 0); errno != 0 {
+		return fmt.Errorf("prctl(PR_SET_CHILD_SUBREAPER): %v", errno)
+	}
+	return nil
+}
+
+// ioprio_set(2) constants; the class lives in the top bits of the priority
+// word.
+const (
+	ioprioWhoPgrp    = 2
+	ioprioClassShift = 13
+)
+
+// setIOClass applies an IO scheduling class to a process group with
+// ioprio_set(2).
+func setIOClass(pgid int, class IOClass, priority int) error {
+	arg := uintptr(int(class)<<ioprioClassShift | priority)
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoPgrp, uintptr(pgid), arg); errno != 0 {
+		return fmt.Errorf("setting io class %s: %w", class, errno)
+	}
+	return nil
+}
+
+// maxAffinityCPUs bounds the CPU mask passed to sched_setaffinity(2).
+const maxAffinityCPUs = 1024
+
+// setCPUAffinity pins a process to the given CPUs with
+// sched_setaffinity(2).
+func setCPUAffinity(pid int, cpus []int) error {
+	var mask [maxAffinityCPUs / 8]byte
+	for _, cpu := range cpus {
+		if cpu < 0 || cpu >= maxAffinityCPUs {
+			return fmt.Errorf("cpu %d out of range", cpu)
+		}
+		mask[cpu/8] |= 1 << (cpu % 8)
+	}
+	if _, _, errno := syscall.Syscall(syscall.SYS_SCHED_SETAFFINITY, uintptr(pid), uintptr(len(mask)), uintptr(unsafe.Pointer(&mask[0]))); errno != 0 {
+		return fmt.Errorf("setting cpu affinity: %w", errno)
+	}
+	return nil
+}
+
+// kernelVersion reports the running kernel, e.g. "Linux 6.1.0", via
+// uname(2).
+func kernelVersion() string {
+	var u syscall.Utsname
+	if err := syscall.Uname(&u); err != nil {
+		return "Linux"
+	}
+	return utsString(u.Sysname[:]) + " " + utsString(u.Release[:])
+}
+
+// utsString converts one NUL-terminated utsname field.
+func utsString(field []int8) string {
+	buf := make([]byte, 0, len(field))
+	for _, c := range field {
+		if c == 0 {
+			break
+		}
+		buf = append(buf, byte(c))
+	}
+	return string(buf)
+}
+
+// setCoreLimit caps the core files a process may write via prlimit(2),
+// letting crashed jobs dump cores up to the limit even when the server
+// itself runs with cores disabled.
+func setCoreLimit(pid int, bytes int64) error {
+	rlim := syscall.Rlimit{Cur: uint64(bytes), Max: uint64(bytes)}
+	if _, _, errno := syscall.Syscall6(syscall.SYS_PRLIMIT64, uintptr(pid), syscall.RLIMIT_CORE, uintptr(unsafe.Pointer(&rlim)), 0, 0, 0); errno != 0 {
+		return fmt.Errorf("setting core limit: %v", errno)
+	}
+	return nil
+}
+
+// procCPUTime reads a live process's user plus system CPU time from
+// /proc. The clock-tick unit is fixed at 100Hz on Linux.
+func procCPUTime(pid int) time.Duration {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0
+	}
+	// The command name in field 2 may contain spaces; fields are counted
+	// from after its closing parenthesis. utime and stime are fields 14
+	// and 15, so indexes 11 and 12 of the remainder.
+	i := bytes.LastIndexByte(data, ')')
+	if i < 0 {
+		return 0
+	}
+	fields := strings.Fields(string(data[i+1:]))
+	if len(fields) < 13 {
+		return 0
+	}
+	utime, err1 := strconv.ParseInt(fields[11], 10, 64)
+	stime, err2 := strconv.ParseInt(fields[12], 10, 64)
+	if err1 != nil || err2 != nil {
+		return 0
+	}
+	return time.Duration(utime+stime) * time.Second / 100
+}
+
+// procOOMKills reads the kernel's cumulative OOM-kill counter from
+// /proc/vmstat; zero when the counter is absent (pre-4.13 kernels).
+func procOOMKills() int64 {
+	data, err := os.ReadFile("/proc/vmstat")
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if v, found := strings.CutPrefix(line, "oom_kill "); found {
+			n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return 0
+			}
+			return n
+		}
+	}
+	return 0
+}
+
+// procRSS reads a live process's resident set size from /proc: the
+// second statm field, in pages.
+func procRSS(pid int) int64 {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/statm", pid))
+	if err != nil {
+		return 0
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 2 {
+		return 0
+	}
+	pages, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return pages * int64(os.Getpagesize())
+}
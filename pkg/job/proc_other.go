@@ -0,0 +1,67 @@
+//go:build darwin || freebsd
+
+package job
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// This file is the darwin/freebsd side of the platform layer. Process
+// groups, signals, and nice levels work the same as on Linux; the
+// Linux-only pieces either degrade quietly (subreaper, /proc stats) or
+// fail loudly when a job asked for them (IO class, CPU affinity).
+
+// enableSubreaper is a no-op: these platforms have no subreaper
+// equivalent, so orphaned descendants of jobs reparent to init. The
+// per-group reaping in execProcess.Wait still collects anything that
+// stayed in the job's process group.
+func enableSubreaper() error {
+	return nil
+}
+
+// setIOClass rejects IO scheduling requests: ioprio_set(2) is
+// Linux-only, and silently ignoring an explicit spec field would be
+// worse than failing the start.
+func setIOClass(pgid int, class IOClass, priority int) error {
+	return fmt.Errorf("io class %s is not supported on %s", class, runtime.GOOS)
+}
+
+// setCPUAffinity rejects pinning requests for the same reason as
+// setIOClass.
+func setCPUAffinity(pid int, cpus []int) error {
+	return fmt.Errorf("cpu affinity is not supported on %s", runtime.GOOS)
+}
+
+// kernelVersion reports only the OS name: these platforms lack the
+// uname wrapper the Linux side uses.
+func kernelVersion() string {
+	return runtime.GOOS
+}
+
+// setCoreLimit rejects core dump requests: prlimit(2) is Linux-only, and
+// silently ignoring an explicit spec field would be worse than failing
+// the start.
+func setCoreLimit(pid int, bytes int64) error {
+	return fmt.Errorf("core dump capture is not supported on %s", runtime.GOOS)
+}
+
+// procCPUTime reports zero for live processes: there is no /proc here,
+// and the Process interface documents zero as "cannot be measured". The
+// final totals still come from the exit state once the process is
+// reaped.
+func procCPUTime(pid int) time.Duration {
+	return 0
+}
+
+// procOOMKills reports zero: there is no kernel OOM-kill counter to
+// read, so OOM detection degrades to never-detected.
+func procOOMKills() int64 {
+	return 0
+}
+
+// procRSS reports zero for the same reason as procCPUTime.
+func procRSS(pid int) int64 {
+	return 0
+}
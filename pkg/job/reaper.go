@@ -0,0 +1,49 @@
+package job
+
+import (
+	"syscall"
+	"time"
+)
+
+// EnableSubreaper marks this process as a child subreaper, so orphaned
+// descendants of jobs reparent to it instead of to init. Paired with the
+// per-group reaping in execProcess.Wait this keeps zombies from piling
+// up when the server runs as PID 1 in a container. Call it once at
+// startup; it is process-global. On platforms without a subreaper
+// mechanism it is a no-op.
+func EnableSubreaper() error {
+	return enableSubreaper()
+}
+
+// reapGroupTimeout bounds how long a terminated job's leftover
+// descendants are waited on; SIGKILL cannot be caught, so anything
+// still alive past this is stuck in the kernel.
+const reapGroupTimeout = 5 * time.Second
+
+// reapGroup kills whatever is left of a terminated job's process group
+// and collects the corpses, so descendants that outlived the job do not
+// linger as zombies. Only call it after the group leader itself has been
+// reaped. Descendants that moved themselves to a new process group are
+// out of reach here; the subreaper flag at least keeps them parented to
+// a live process.
+func reapGroup(pgid int) {
+	_ = syscall.Kill(-pgid, syscall.SIGKILL)
+	deadline := time.Now().Add(reapGroupTimeout)
+	for {
+		var ws syscall.WaitStatus
+		pid, err := syscall.Wait4(-pgid, &ws, syscall.WNOHANG, nil)
+		switch {
+		case pid > 0:
+			// Reaped one; there may be more.
+		case err == syscall.ECHILD:
+			// Nothing left in the group.
+			return
+		default:
+			// Children remain but none has exited yet.
+			if time.Now().After(deadline) {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestTerminatedJobGroupIsReaped(t *testing.T) {
+	// The subreaper flag makes this process adopt the job's orphaned
+	// background child, as it would when running as PID 1 in a container.
+	if err := EnableSubreaper(); err != nil {
+		t.Skipf("subreaper unavailable: %v", err)
+	}
+	m := NewManager()
+	// The child redirects away from the job's pipes so the job can reach
+	// EOF and terminate while the child lives on.
+	j, err := m.StartJob("alice", Spec{Command: "sh", Args: []string{"-c", "sleep 60 >/dev/null 2>&1 & echo $!"}})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	waitDone(t, j)
+
+	lines := j.Output().ReadAll()
+	if len(lines) == 0 {
+		t.Fatal("no output; wanted the background child's pid")
+	}
+	pid, err := strconv.Atoi(lines[0].Text)
+	if err != nil {
+		t.Fatalf("parsing child pid from %q: %v", lines[0].Text, err)
+	}
+
+	// The orphaned child must be killed and reaped, not left as a zombie.
+	deadline := time.Now().Add(5 * time.Second)
+	for syscall.Kill(pid, 0) == nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("pid %d still exists after the job terminated", pid)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,69 @@
+package job
+
+import (
+	"regexp"
+	"strings"
+)
+
+// redactedMarker replaces whatever a redaction rule matched.
+const redactedMarker = "[REDACTED]"
+
+// Redactor rewrites sensitive substrings in captured output before any
+// reader, store, or shipper sees them. It combines server-configured
+// regex rules with literal values known per job, such as resolved
+// secrets. A nil Redactor passes lines through unchanged.
+type Redactor struct {
+	patterns []*regexp.Regexp
+	values   []string
+}
+
+// NewRedactor compiles a redactor from regex rules.
+func NewRedactor(patterns []string) (*Redactor, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	r := &Redactor{}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// WithValues returns a redactor that additionally blanks the given
+// literal values; empty values are skipped. The receiver may be nil.
+func (r *Redactor) WithValues(values ...string) *Redactor {
+	var kept []string
+	for _, v := range values {
+		if v != "" {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == 0 {
+		return r
+	}
+	out := &Redactor{}
+	if r != nil {
+		out.patterns = r.patterns
+		out.values = append(out.values, r.values...)
+	}
+	out.values = append(out.values, kept...)
+	return out
+}
+
+// Apply rewrites one output line, replacing every rule match.
+func (r *Redactor) Apply(line string) string {
+	if r == nil {
+		return line
+	}
+	for _, v := range r.values {
+		line = strings.ReplaceAll(line, v, redactedMarker)
+	}
+	for _, re := range r.patterns {
+		line = re.ReplaceAllString(line, redactedMarker)
+	}
+	return line
+}
@@ -0,0 +1,53 @@
+package job
+
+import "testing"
+
+func TestRedactorApply(t *testing.T) {
+	r, err := NewRedactor([]string{`(?i)password=\S+`})
+	if err != nil {
+		t.Fatalf("NewRedactor: %v", err)
+	}
+	r = r.WithValues("hunter2", "")
+
+	cases := []struct{ in, want string }{
+		{"connecting with PASSWORD=abc123", "connecting with [REDACTED]"},
+		{"the token is hunter2, keep it safe", "the token is [REDACTED], keep it safe"},
+		{"nothing sensitive here", "nothing sensitive here"},
+	}
+	for _, c := range cases {
+		if got := r.Apply(c.in); got != c.want {
+			t.Errorf("Apply(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestRedactorNilPassesThrough(t *testing.T) {
+	var r *Redactor
+	if got := r.Apply("password=abc"); got != "password=abc" {
+		t.Errorf("nil Apply = %q, want the line unchanged", got)
+	}
+	// Values on a nil base still redact.
+	if got := r.WithValues("abc").Apply("password=abc"); got != "password=[REDACTED]" {
+		t.Errorf("WithValues on nil = %q, want password=[REDACTED]", got)
+	}
+}
+
+func TestRedactorInOutputCapture(t *testing.T) {
+	m := NewManager()
+	redact, err := NewRedactor([]string{`tok_[a-z0-9]+`})
+	if err != nil {
+		t.Fatalf("NewRedactor: %v", err)
+	}
+	j, err := m.StartJob("alice", Spec{
+		Command: "sh", Args: []string{"-c", "echo credential tok_abc123 issued"},
+		Redact: redact,
+	})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	waitDone(t, j)
+	lines := j.Output().ReadAll()
+	if len(lines) != 1 || lines[0].Text != "credential [REDACTED] issued" {
+		t.Errorf("output = %q, want the token blanked", lines)
+	}
+}
@@ -0,0 +1,55 @@
+package job
+
+import "fmt"
+
+// RestartPolicy says whether the job's process is relaunched when it exits.
+type RestartPolicy int
+
+const (
+	// RestartNever runs the process once; exits are terminal.
+	RestartNever RestartPolicy = iota
+	// RestartOnFailure relaunches after non-zero exits.
+	RestartOnFailure
+	// RestartAlways relaunches after every exit, clean or not.
+	RestartAlways
+)
+
+// ParseRestartPolicy parses a policy name as supplied over the API. The
+// empty string means never.
+func ParseRestartPolicy(name string) (RestartPolicy, error) {
+	switch name {
+	case "", "never":
+		return RestartNever, nil
+	case "on-failure":
+		return RestartOnFailure, nil
+	case "always":
+		return RestartAlways, nil
+	default:
+		return 0, fmt.Errorf("unknown restart policy %q; want never, on-failure, or always", name)
+	}
+}
+
+// String is the inverse of ParseRestartPolicy.
+func (p RestartPolicy) String() string {
+	switch p {
+	case RestartOnFailure:
+		return "on-failure"
+	case RestartAlways:
+		return "always"
+	default:
+		return "never"
+	}
+}
+
+// shouldRestart reports whether the policy relaunches after an exit with
+// the given code.
+func (p RestartPolicy) shouldRestart(code int) bool {
+	switch p {
+	case RestartOnFailure:
+		return code != 0
+	case RestartAlways:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,237 @@
+package job
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestParseRestartPolicy(t *testing.T) {
+	for name, want := range map[string]RestartPolicy{
+		"":           RestartNever,
+		"never":      RestartNever,
+		"on-failure": RestartOnFailure,
+		"always":     RestartAlways,
+	} {
+		got, err := ParseRestartPolicy(name)
+		if err != nil {
+			t.Errorf("ParseRestartPolicy(%q) error: %v", name, err)
+		} else if got != want {
+			t.Errorf("ParseRestartPolicy(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if _, err := ParseRestartPolicy("bogus"); err == nil {
+		t.Error("ParseRestartPolicy(bogus) succeeded, want error")
+	}
+}
+
+func TestParseProbe(t *testing.T) {
+	for in, want := range map[string]Probe{
+		"tcp:localhost:8080":  {Kind: ProbeTCP, Target: "localhost:8080"},
+		"http://host/healthz": {Kind: ProbeHTTP, Target: "http://host/healthz"},
+		"exec:true":           {Kind: ProbeExec, Target: "true"},
+	} {
+		got, err := ParseProbe(in)
+		if err != nil {
+			t.Errorf("ParseProbe(%q) error: %v", in, err)
+		} else if *got != want {
+			t.Errorf("ParseProbe(%q) = %+v, want %+v", in, *got, want)
+		}
+	}
+	if p, err := ParseProbe(""); err != nil || p != nil {
+		t.Errorf("ParseProbe(\"\") = %v, %v, want nil probe", p, err)
+	}
+	for _, in := range []string{"tcp:no-port", "exec:", "udp:host:1"} {
+		if _, err := ParseProbe(in); err == nil {
+			t.Errorf("ParseProbe(%q) succeeded, want error", in)
+		}
+	}
+}
+
+// waitStarted polls until the runner has launched n processes.
+func waitStarted(t *testing.T, runner *FakeRunner, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for runner.Started() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("runner started %d processes, want %d", runner.Started(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRestartOnFailure(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command:     "flaky",
+		Restart:     RestartOnFailure,
+		MaxRestarts: 2,
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	runner.Proc(0).Exit(1)
+	waitStarted(t, runner, 2)
+	runner.Proc(1).Exit(1)
+	waitStarted(t, runner, 3)
+
+	// The restart budget is spent; the third failure is terminal.
+	runner.Proc(2).Exit(1)
+	select {
+	case <-j.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job never finished after exhausting its restarts")
+	}
+	if st, code := j.Status(); st != StatusFailed || code != 1 {
+		t.Errorf("status = %v/%d, want FAILED/1", st, code)
+	}
+	if got := j.Restarts(); got != 2 {
+		t.Errorf("Restarts() = %d, want 2", got)
+	}
+}
+
+func TestRestartOnFailureSkipsCleanExit(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{Command: "once", Restart: RestartOnFailure})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	runner.Proc(0).Exit(0)
+	<-j.Done()
+	if st, _ := j.Status(); st != StatusCompleted {
+		t.Errorf("status = %v, want COMPLETED", st)
+	}
+	if runner.Started() != 1 {
+		t.Errorf("started %d processes, want 1", runner.Started())
+	}
+}
+
+func TestRestartAlwaysUntilStopped(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{Command: "serve", Restart: RestartAlways})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// Even a clean exit is relaunched under always.
+	runner.Proc(0).Exit(0)
+	waitStarted(t, runner, 2)
+
+	if err := j.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if st, _ := j.Status(); st != StatusStopped {
+		t.Errorf("status = %v, want STOPPED", st)
+	}
+	if got := j.Restarts(); got != 1 {
+		t.Errorf("Restarts() = %d, want 1", got)
+	}
+}
+
+func TestProbeFailureTriggersRestart(t *testing.T) {
+	// A listener that immediately closes stands in for a healthy service;
+	// once it is gone the TCP probe fails and the supervisor intervenes.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command: "serve",
+		Restart: RestartOnFailure,
+		Probe: &Probe{
+			Kind:     ProbeTCP,
+			Target:   ln.Addr().String(),
+			Interval: 5 * time.Millisecond,
+		},
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// Healthy for a few checks: no restarts.
+	time.Sleep(50 * time.Millisecond)
+	if got := runner.Started(); got != 1 {
+		t.Fatalf("started %d processes while healthy, want 1", got)
+	}
+
+	ln.Close()
+	waitStarted(t, runner, 2)
+	if got := j.Restarts(); got < 1 {
+		t.Errorf("Restarts() = %d, want at least 1", got)
+	}
+	_ = j.Stop()
+}
+
+func TestExitStatusSuppressesRestart(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command:      "skipper",
+		Restart:      RestartOnFailure,
+		ExitStatuses: map[int]string{4: "skipped"},
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// Exit 4 would normally relaunch under on-failure, but its rule makes
+	// it a terminal "skipped".
+	runner.Proc(0).Exit(4)
+	<-j.Done()
+	if st, code := j.Status(); st != StatusFailed || code != 4 {
+		t.Errorf("status = %v/%d, want FAILED/4", st, code)
+	}
+	if got := j.StatusLabel(); got != "skipped" {
+		t.Errorf("StatusLabel() = %q, want skipped", got)
+	}
+	if runner.Started() != 1 {
+		t.Errorf("started %d processes, want 1", runner.Started())
+	}
+}
+
+func TestExitStatusRetryableRestarts(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command:      "flaky",
+		Restart:      RestartOnFailure,
+		MaxRestarts:  1,
+		ExitStatuses: map[int]string{0: ExitStatusRetryable},
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// Even a clean exit relaunches when its rule marks it retryable.
+	runner.Proc(0).Exit(0)
+	waitStarted(t, runner, 2)
+
+	runner.Proc(1).Exit(0)
+	<-j.Done()
+	if st, _ := j.Status(); st != StatusCompleted {
+		t.Errorf("status = %v, want COMPLETED", st)
+	}
+	if got := j.StatusLabel(); got != ExitStatusRetryable {
+		t.Errorf("StatusLabel() = %q, want %q", got, ExitStatusRetryable)
+	}
+	if got := j.Restarts(); got != 1 {
+		t.Errorf("Restarts() = %d, want 1", got)
+	}
+}
@@ -0,0 +1,210 @@
+package job
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Runner abstracts how a job's process is launched, so tests can substitute
+// a fake that spawns nothing; see FakeRunner. The default is ExecRunner.
+type Runner interface {
+	// Start launches spec's command, feeding captured output into out, and
+	// returns once the process is running. The caller closes out after
+	// Wait returns.
+	Start(spec Spec, out *OutputBuffer) (Process, error)
+}
+
+// Process is a live handle on a started job process.
+type Process interface {
+	// Signal delivers sig to the process (and, for real processes, its
+	// whole process group).
+	Signal(sig os.Signal) error
+	// Wait blocks until the process exits and all output has been
+	// captured, then returns the exit code. A negative code means the
+	// process did not exit normally.
+	Wait() int
+	// Stdin returns the process's standard input for writing; closing it
+	// delivers EOF to the process.
+	Stdin() io.WriteCloser
+	// CPUTime reports the CPU time the process has consumed so far; zero
+	// when it cannot be measured.
+	CPUTime() time.Duration
+	// RSSBytes reports the process's current resident memory; zero when
+	// it cannot be measured or the process has exited.
+	RSSBytes() int64
+	// OOMKilled reports whether the exited process was killed by the
+	// kernel's out-of-memory killer; false while it runs or where the
+	// platform cannot tell.
+	OOMKilled() bool
+	// Pid reports the operating-system process ID; zero for processes
+	// that are not real OS processes.
+	Pid() int
+}
+
+// ExecRunner runs jobs as real operating-system processes, each in a fresh
+// process group so a signal can reach the whole tree.
+type ExecRunner struct{}
+
+// Start implements Runner.
+func (ExecRunner) Start(spec Spec, out *OutputBuffer) (Process, error) {
+	cmd := exec.Command(spec.Command, spec.Args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Dir = spec.Dir
+	if len(spec.Env) > 0 {
+		cmd.Env = append(os.Environ(), spec.Env...)
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating stdin pipe: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating stdout pipe: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating stderr pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting %q: %w", spec.Command, err)
+	}
+	if err := setProcessPriority(cmd.Process.Pid, spec); err != nil {
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		_ = cmd.Wait()
+		return nil, err
+	}
+
+	p := &execProcess{cmd: cmd, stdin: stdin, oomBaseline: procOOMKills()}
+	p.scans.Add(2)
+	go p.scan(out, StreamStdout, stdout)
+	go p.scan(out, StreamStderr, stderr)
+	return p, nil
+}
+
+// execProcess wraps a started exec.Cmd.
+type execProcess struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	scans sync.WaitGroup
+	// oomBaseline is the kernel's OOM-kill counter when the process
+	// started; OOMKilled compares against it.
+	oomBaseline int64
+
+	// mu guards the exit state below, recorded by Wait after the process
+	// is reaped. Pollers read it instead of cmd.ProcessState, which
+	// cmd.Wait writes with no synchronization of its own.
+	mu         sync.Mutex
+	exited     bool
+	finalCPU   time.Duration
+	waitStatus syscall.WaitStatus
+	haveStatus bool
+}
+
+// Stdin returns the process's standard input pipe.
+func (p *execProcess) Stdin() io.WriteCloser {
+	return p.stdin
+}
+
+// Pid implements Process.
+func (p *execProcess) Pid() int {
+	return p.cmd.Process.Pid
+}
+
+// CPUTime reports the process's user plus system CPU time. Once the
+// process has been reaped its final totals come from the exit state;
+// while it runs they come from the platform layer, which may report zero
+// where live stats cannot be read.
+func (p *execProcess) CPUTime() time.Duration {
+	p.mu.Lock()
+	if p.exited {
+		defer p.mu.Unlock()
+		return p.finalCPU
+	}
+	p.mu.Unlock()
+	return procCPUTime(p.cmd.Process.Pid)
+}
+
+// RSSBytes reports the process's current resident set size via the
+// platform layer; zero once the process has exited.
+func (p *execProcess) RSSBytes() int64 {
+	p.mu.Lock()
+	exited := p.exited
+	p.mu.Unlock()
+	if exited {
+		return 0
+	}
+	return procRSS(p.cmd.Process.Pid)
+}
+
+// OOMKilled reports whether the reaped process died to the kernel's OOM
+// killer: it exited to SIGKILL and the kernel's OOM-kill counter moved
+// while it ran. The counter is system-wide, so a concurrent OOM kill
+// elsewhere can cause a false positive; a SIGKILL sent by the server
+// itself is ruled out by the job's own bookkeeping before this is
+// consulted.
+func (p *execProcess) OOMKilled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.haveStatus || !p.waitStatus.Signaled() || p.waitStatus.Signal() != syscall.SIGKILL {
+		return false
+	}
+	return procOOMKills() > p.oomBaseline
+}
+
+// scan reads one output pipe line by line into the buffer, tagging each line
+// with its source stream.
+func (p *execProcess) scan(out *OutputBuffer, stream Stream, r io.Reader) {
+	defer p.scans.Done()
+	defer func() {
+		if v := recover(); v != nil {
+			out.AppendStream(stream, fmt.Sprintf("[output capture failed: %v]", v))
+			logPanic("output capture", v)
+		}
+	}()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		out.AppendStream(stream, scanner.Text())
+	}
+}
+
+// Signal signals the whole process group.
+func (p *execProcess) Signal(sig os.Signal) error {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return fmt.Errorf("unsupported signal %v", sig)
+	}
+	return syscall.Kill(-p.cmd.Process.Pid, s)
+}
+
+// Wait drains both pipes, reaps the process, then sweeps the rest of
+// its process group so descendants that outlived the job cannot linger
+// as zombies. It records the final CPU time and wait status under the
+// lock, so the accessors above never touch cmd.ProcessState while
+// cmd.Wait is writing it.
+func (p *execProcess) Wait() int {
+	p.scans.Wait()
+	err := p.cmd.Wait()
+	p.mu.Lock()
+	p.exited = true
+	if st := p.cmd.ProcessState; st != nil {
+		p.finalCPU = st.UserTime() + st.SystemTime()
+		if ws, ok := st.Sys().(syscall.WaitStatus); ok {
+			p.waitStatus, p.haveStatus = ws, true
+		}
+	}
+	p.mu.Unlock()
+	reapGroup(p.cmd.Process.Pid)
+	if err == nil {
+		return 0
+	}
+	return exitCode(err)
+}
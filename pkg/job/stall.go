@@ -0,0 +1,110 @@
+package job
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+)
+
+// StallPolicy says what happens when a job goes silent for longer than its
+// MaxSilence interval.
+type StallPolicy int
+
+const (
+	// StallFlag marks the job stalled and leaves it running; the mark
+	// clears when output resumes.
+	StallFlag StallPolicy = iota
+	// StallKill kills the process so the restart policy decides what
+	// happens next.
+	StallKill
+)
+
+// ParseStallPolicy parses a policy name as supplied over the API. The
+// empty string means flag.
+func ParseStallPolicy(name string) (StallPolicy, error) {
+	switch name {
+	case "", "flag":
+		return StallFlag, nil
+	case "kill":
+		return StallKill, nil
+	default:
+		return 0, fmt.Errorf("unknown stall policy %q; want flag or kill", name)
+	}
+}
+
+// String is the inverse of ParseStallPolicy.
+func (p StallPolicy) String() string {
+	if p == StallKill {
+		return "kill"
+	}
+	return "flag"
+}
+
+// stallPollInterval is how often a job's silence is checked: a quarter of
+// the allowance, bounded so short allowances stay responsive and long ones
+// do not poll needlessly fast.
+func stallPollInterval(maxSilence time.Duration) time.Duration {
+	interval := maxSilence / 4
+	if interval > time.Second {
+		interval = time.Second
+	}
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	return interval
+}
+
+// watchStall flags the job stalled once its output has been silent for
+// longer than MaxSilence, and under StallKill kills the process so the
+// restart policy can relaunch it.
+func (j *Job) watchStall() {
+	ticker := time.NewTicker(stallPollInterval(j.Spec.MaxSilence))
+	defer ticker.Stop()
+	// floor gives a relaunched process a fresh allowance after a stall
+	// kill; the shared output buffer's clock keeps running across it.
+	floor := time.Now()
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-ticker.C:
+		}
+		j.mu.Lock()
+		running := j.status == StatusRunning
+		proc := j.proc
+		j.mu.Unlock()
+		// A paused process is quiet by design, not stalled.
+		if !running {
+			j.setStalled(false)
+			continue
+		}
+		last := j.output.LastActivity()
+		if last.Before(floor) {
+			last = floor
+		}
+		if time.Since(last) <= j.Spec.MaxSilence {
+			j.setStalled(false)
+			continue
+		}
+		j.setStalled(true)
+		if j.Spec.OnStall == StallKill {
+			_ = proc.Signal(syscall.SIGKILL)
+			floor = time.Now()
+		}
+	}
+}
+
+// setStalled records whether the job is currently stalled.
+func (j *Job) setStalled(v bool) {
+	j.mu.Lock()
+	j.stalled = v
+	j.mu.Unlock()
+}
+
+// Stalled reports whether the job has exceeded its MaxSilence interval
+// without output. It clears when output resumes.
+func (j *Job) Stalled() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.stalled
+}
@@ -0,0 +1,77 @@
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStallPolicy(t *testing.T) {
+	for in, want := range map[string]StallPolicy{
+		"":     StallFlag,
+		"flag": StallFlag,
+		"kill": StallKill,
+	} {
+		got, err := ParseStallPolicy(in)
+		if err != nil {
+			t.Errorf("ParseStallPolicy(%q): %v", in, err)
+		}
+		if got != want {
+			t.Errorf("ParseStallPolicy(%q) = %v, want %v", in, got, want)
+		}
+	}
+	if _, err := ParseStallPolicy("panic"); err == nil {
+		t.Error("ParseStallPolicy(panic) succeeded, want error")
+	}
+}
+
+// waitStalled polls the job's stall flag until it matches or times out.
+func waitStalled(t *testing.T, j *Job, want bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for j.Stalled() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("Stalled() never became %v", want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestStallFlagSetsAndClears(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{Command: "quiet", MaxSilence: 30 * time.Millisecond})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	waitStalled(t, j, true)
+
+	// A heartbeat directive counts as activity without adding a log line.
+	runner.Proc(0).Emit(StreamStdout, "::heartbeat")
+	waitStalled(t, j, false)
+	if got := j.Output().Len(); got != 0 {
+		t.Errorf("stored %d lines after heartbeat, want 0", got)
+	}
+	_ = j.Stop()
+}
+
+func TestStallKillLetsRestartRelaunch(t *testing.T) {
+	runner := &FakeRunner{}
+	j := New("job-1", "alice", Spec{
+		Command:    "hung",
+		MaxSilence: 20 * time.Millisecond,
+		OnStall:    StallKill,
+		Restart:    RestartOnFailure,
+	})
+	j.runner = runner
+	if err := j.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	// The silent process is killed and the restart policy relaunches it.
+	waitStarted(t, runner, 2)
+	if got := j.Restarts(); got < 1 {
+		t.Errorf("Restarts() = %d, want at least 1", got)
+	}
+	_ = j.Stop()
+}
@@ -0,0 +1,195 @@
+package joblog
+
+import (
+	"expvar"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Exported metrics; see the gateway's /debug/vars endpoint.
+var (
+	usageVar     = expvar.NewInt("joblog_usage_bytes")
+	evictionsVar = expvar.NewInt("joblog_evicted_jobs")
+)
+
+// protectedMarker, when present in a job's log directory, exempts it from
+// budget eviction.
+const protectedMarker = ".protected"
+
+// SetBudget caps the store's total disk usage in bytes; zero disables the
+// budget. When the cap is exceeded, the logs of the oldest finished jobs
+// are evicted, skipping protected ones.
+func (s *Store) SetBudget(bytes int64) {
+	s.mu.Lock()
+	s.budget = bytes
+	s.mu.Unlock()
+	s.recomputeUsage()
+	s.maybeEvict()
+}
+
+// Usage returns the bytes currently used by persisted logs.
+func (s *Store) Usage() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage
+}
+
+// Budget returns the configured cap; zero means none.
+func (s *Store) Budget() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.budget
+}
+
+// Evictions returns how many job logs have been evicted for the budget.
+func (s *Store) Evictions() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.evictions
+}
+
+// Protect exempts a job's logs from budget eviction; the marker survives
+// restarts.
+func (s *Store) Protect(jobID string) error {
+	return os.WriteFile(filepath.Join(s.root, jobID, protectedMarker), nil, 0o600)
+}
+
+// Unprotect makes a job's logs evictable again.
+func (s *Store) Unprotect(jobID string) error {
+	err := os.Remove(filepath.Join(s.root, jobID, protectedMarker))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// CloseJob closes the job's writer, marking its logs finished and therefore
+// evictable. The shipper calls this once a job's output stream ends.
+func (s *Store) CloseJob(jobID string) error {
+	s.mu.Lock()
+	w, ok := s.writers[jobID]
+	delete(s.writers, jobID)
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	err := w.Close()
+	s.maybeEvict()
+	return err
+}
+
+// addUsage tracks bytes written between recomputations.
+func (s *Store) addUsage(n int64) {
+	s.mu.Lock()
+	s.usage += n
+	usageVar.Set(s.usage)
+	s.mu.Unlock()
+}
+
+// recomputeUsage walks the store and replaces the running usage estimate
+// with the exact total; rotation compresses data, so estimates drift.
+func (s *Store) recomputeUsage() {
+	var total int64
+	_ = filepath.Walk(s.root, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	s.mu.Lock()
+	s.usage = total
+	usageVar.Set(total)
+	s.mu.Unlock()
+}
+
+// evictionCandidate is a finished, unprotected job's log directory.
+type evictionCandidate struct {
+	jobID     string
+	lastWrite time.Time
+}
+
+// maybeEvict removes the oldest evictable job logs until usage is back
+// under the budget. Jobs still being written and protected jobs are never
+// evicted.
+func (s *Store) maybeEvict() {
+	s.mu.Lock()
+	budget := s.budget
+	over := budget > 0 && s.usage > budget
+	s.mu.Unlock()
+	if !over {
+		return
+	}
+
+	for {
+		s.recomputeUsage()
+		s.mu.Lock()
+		done := s.usage <= budget
+		s.mu.Unlock()
+		if done {
+			return
+		}
+		victim, ok := s.oldestEvictable()
+		if !ok {
+			return
+		}
+		if err := os.RemoveAll(filepath.Join(s.root, victim)); err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.evictions++
+		evictionsVar.Set(s.evictions)
+		s.mu.Unlock()
+	}
+}
+
+// oldestEvictable picks the evictable job whose log was written to least
+// recently.
+func (s *Store) oldestEvictable() (string, bool) {
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		return "", false
+	}
+	s.mu.Lock()
+	open := make(map[string]bool, len(s.writers))
+	for id := range s.writers {
+		open[id] = true
+	}
+	s.mu.Unlock()
+
+	var candidates []evictionCandidate
+	for _, e := range entries {
+		if !e.IsDir() || open[e.Name()] {
+			continue
+		}
+		dir := filepath.Join(s.root, e.Name())
+		if _, err := os.Stat(filepath.Join(dir, protectedMarker)); err == nil {
+			continue
+		}
+		candidates = append(candidates, evictionCandidate{
+			jobID:     e.Name(),
+			lastWrite: newestMtime(dir),
+		})
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Slice(candidates, func(i, k int) bool {
+		return candidates[i].lastWrite.Before(candidates[k].lastWrite)
+	})
+	return candidates[0].jobID, true
+}
+
+// newestMtime returns the most recent modification time of any file under
+// dir.
+func newestMtime(dir string) time.Time {
+	var newest time.Time
+	_ = filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.ModTime().After(newest) {
+			newest = info.ModTime()
+		}
+		return nil
+	})
+	return newest
+}
@@ -0,0 +1,74 @@
+package joblog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBudgetEvictsOldestFinishedJob(t *testing.T) {
+	s, err := NewStore(t.TempDir(), 1<<20, 10)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer s.Close()
+
+	appendLines(t, s, "old", 0, 20)
+	if err := s.CloseJob("old"); err != nil {
+		t.Fatalf("CloseJob: %v", err)
+	}
+	// Make "old" visibly older than what follows.
+	past := time.Now().Add(-time.Hour)
+	_ = os.Chtimes(filepath.Join(s.root, "old", currentName), past, past)
+
+	appendLines(t, s, "new", 0, 20)
+	if err := s.CloseJob("new"); err != nil {
+		t.Fatalf("CloseJob: %v", err)
+	}
+
+	// Both jobs together exceed a budget that one alone fits in.
+	s.SetBudget(250)
+
+	if _, err := os.Stat(filepath.Join(s.root, "old")); !os.IsNotExist(err) {
+		t.Error("oldest job's logs were not evicted")
+	}
+	if _, err := os.Stat(filepath.Join(s.root, "new")); err != nil {
+		t.Errorf("newest job's logs were evicted: %v", err)
+	}
+	if s.Evictions() != 1 {
+		t.Errorf("Evictions() = %d, want 1", s.Evictions())
+	}
+	if s.Usage() > 250 {
+		t.Errorf("Usage() = %d, want <= 250", s.Usage())
+	}
+}
+
+func TestBudgetSkipsProtectedAndRunningJobs(t *testing.T) {
+	s, err := NewStore(t.TempDir(), 1<<20, 10)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer s.Close()
+
+	appendLines(t, s, "precious", 0, 20)
+	if err := s.CloseJob("precious"); err != nil {
+		t.Fatalf("CloseJob: %v", err)
+	}
+	if err := s.Protect("precious"); err != nil {
+		t.Fatalf("Protect: %v", err)
+	}
+	// "running" never gets CloseJob: its writer is still open.
+	appendLines(t, s, "running", 0, 20)
+
+	s.SetBudget(10)
+
+	for _, jobID := range []string{"precious", "running"} {
+		if _, err := os.Stat(filepath.Join(s.root, jobID)); err != nil {
+			t.Errorf("job %s was evicted: %v", jobID, err)
+		}
+	}
+	if s.Evictions() != 0 {
+		t.Errorf("Evictions() = %d, want 0", s.Evictions())
+	}
+}
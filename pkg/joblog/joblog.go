@@ -0,0 +1,432 @@
+// Package joblog persists job output to disk, one directory per job, with
+// size-based rotation and gzip compression of rotated segments so noisy
+// long-running jobs cannot fill the disk.
+package joblog
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pmantica4/teleport/pkg/crypt"
+)
+
+// Rotation defaults when the caller does not say otherwise.
+const (
+	DefaultMaxFileSize = 10 << 20 // bytes per segment before rotation
+	DefaultMaxFiles    = 5        // rotated segments kept per job
+)
+
+// Store manages the on-disk logs for all jobs under one root directory.
+// Store implements logship.Sink, so it can be wired up as a file:// sink.
+type Store struct {
+	root        string
+	maxFileSize int64
+	maxFiles    int
+
+	mu      sync.Mutex
+	writers map[string]*LogWriter
+
+	// key, when set, encrypts every file at rest; see SetKey.
+	key []byte
+
+	// budget caps total usage in bytes (zero = unlimited); see budget.go.
+	budget    int64
+	usage     int64
+	evictions int64
+}
+
+// NewStore opens (creating if needed) a log store rooted at dir. Zero
+// values select the defaults.
+func NewStore(dir string, maxFileSize int64, maxFiles int) (*Store, error) {
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultMaxFileSize
+	}
+	if maxFiles <= 0 {
+		maxFiles = DefaultMaxFiles
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating log directory: %w", err)
+	}
+	s := &Store{
+		root:        dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		writers:     make(map[string]*LogWriter),
+	}
+	s.recomputeUsage()
+	return s, nil
+}
+
+// SetKey encrypts all log files at rest with the 32-byte AES key; see
+// pkg/crypt. Readers decrypt transparently. Set it before the first
+// write: logs written under one key (or none) cannot be read under
+// another.
+func (s *Store) SetKey(key []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = key
+}
+
+// Ship appends a batch of lines to the job's log, satisfying logship.Sink.
+func (s *Store) Ship(jobID string, lines []string) error {
+	w, err := s.writer(jobID)
+	if err != nil {
+		return err
+	}
+	var written int64
+	for _, line := range lines {
+		if err := w.Append(line); err != nil {
+			return err
+		}
+		written += int64(len(line)) + 1
+	}
+	s.addUsage(written)
+	s.maybeEvict()
+	return nil
+}
+
+// Close closes every open log writer.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var first error
+	for _, w := range s.writers {
+		if err := w.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	s.writers = make(map[string]*LogWriter)
+	return first
+}
+
+// writer returns the job's log writer, creating it on first use.
+func (s *Store) writer(jobID string) (*LogWriter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w, ok := s.writers[jobID]; ok {
+		return w, nil
+	}
+	w, err := newLogWriter(filepath.Join(s.root, jobID), s.maxFileSize, s.maxFiles, s.key)
+	if err != nil {
+		return nil, err
+	}
+	s.writers[jobID] = w
+	return w, nil
+}
+
+// Reader opens the job's persisted log for replay.
+func (s *Store) Reader(jobID string) (*LogReader, error) {
+	s.mu.Lock()
+	key := s.key
+	s.mu.Unlock()
+	return openLogReader(filepath.Join(s.root, jobID), key)
+}
+
+// currentName is the segment being written; rotated segments are numbered
+// 000001.log.gz and up, oldest first.
+const currentName = "current.log"
+
+// LogWriter appends lines to a job's log directory, rotating and
+// compressing segments as they fill.
+type LogWriter struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	key         []byte
+
+	mu      sync.Mutex
+	file    *os.File
+	out     io.Writer
+	size    int64
+	nextSeg int
+}
+
+// NewLogWriter opens a writer on dir, creating it if needed and picking up
+// after any existing segments.
+func NewLogWriter(dir string, maxFileSize int64, maxFiles int) (*LogWriter, error) {
+	return newLogWriter(dir, maxFileSize, maxFiles, nil)
+}
+
+func newLogWriter(dir string, maxFileSize int64, maxFiles int, key []byte) (*LogWriter, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating job log directory: %w", err)
+	}
+	// Read access is needed to recover the IV when appending to an
+	// existing encrypted segment.
+	file, err := os.OpenFile(filepath.Join(dir, currentName), os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	segs, err := segments(dir)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	next := 1
+	if len(segs) > 0 {
+		fmt.Sscanf(filepath.Base(segs[len(segs)-1]), "%06d", &next)
+		next++
+	}
+	w := &LogWriter{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		key:         key,
+		file:        file,
+		size:        info.Size(),
+		nextSeg:     next,
+	}
+	if err := w.openStream(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// openStream positions w.file at the end and points w.out at it,
+// continuing the cipher stream mid-file when the segment already has
+// encrypted content.
+func (w *LogWriter) openStream() error {
+	if _, err := w.file.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+	switch {
+	case w.key == nil:
+		w.out = w.file
+	case w.size == 0:
+		out, err := crypt.NewWriter(w.file, w.key)
+		if err != nil {
+			return err
+		}
+		w.out = out
+		w.size = crypt.HeaderLen
+	default:
+		if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		iv, err := crypt.ReadHeader(w.file)
+		if err != nil {
+			return err
+		}
+		if _, err := w.file.Seek(0, io.SeekEnd); err != nil {
+			return err
+		}
+		out, err := crypt.ResumeWriter(w.file, w.key, iv, w.size-crypt.HeaderLen)
+		if err != nil {
+			return err
+		}
+		w.out = out
+	}
+	return nil
+}
+
+// Append writes one line, rotating first if the segment is full.
+func (w *LogWriter) Append(line string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.size > 0 && w.size+int64(len(line))+1 > w.maxFileSize {
+		if err := w.rotateLocked(); err != nil {
+			return err
+		}
+	}
+	n, err := w.out.Write([]byte(line + "\n"))
+	w.size += int64(n)
+	return err
+}
+
+// Close closes the current segment.
+func (w *LogWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+// rotateLocked gzips the current segment into the next numbered file,
+// starts a fresh one, and prunes the oldest segments beyond maxFiles.
+func (w *LogWriter) rotateLocked() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	current := filepath.Join(w.dir, currentName)
+	if err := gzipFile(current, filepath.Join(w.dir, fmt.Sprintf("%06d.log.gz", w.nextSeg)), w.key); err != nil {
+		return err
+	}
+	w.nextSeg++
+
+	file, err := os.OpenFile(current, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	w.size = 0
+	if err := w.openStream(); err != nil {
+		return err
+	}
+
+	segs, err := segments(w.dir)
+	if err != nil {
+		return err
+	}
+	for len(segs) > w.maxFiles {
+		if err := os.Remove(segs[0]); err != nil {
+			return err
+		}
+		segs = segs[1:]
+	}
+	return nil
+}
+
+// gzipFile compresses src into dst; the caller truncates src afterwards.
+// With a key the segment is decrypted, compressed, and re-encrypted, so
+// compression still sees plaintext and the result stays sealed.
+func gzipFile(src, dst string, key []byte) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	var from io.Reader = in
+	if key != nil {
+		from, err = crypt.NewReader(in, key)
+		if err != nil {
+			return err
+		}
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	var to io.Writer = out
+	if key != nil {
+		to, err = crypt.NewWriter(out, key)
+		if err != nil {
+			out.Close()
+			return err
+		}
+	}
+	zw := gzip.NewWriter(to)
+	if _, err := io.Copy(zw, from); err != nil {
+		out.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// segments lists a job's rotated segment files, oldest first.
+func segments(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.log.gz"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// LogReader replays a job's persisted log transparently across rotated
+// segments: compressed segments in order, then the current one. It is an
+// io.ReadCloser over the concatenated plain-text lines.
+type LogReader struct {
+	reader  io.Reader
+	closers []io.Closer
+}
+
+// OpenLogReader opens the log under dir for replay.
+func OpenLogReader(dir string) (*LogReader, error) {
+	return openLogReader(dir, nil)
+}
+
+func openLogReader(dir string, key []byte) (*LogReader, error) {
+	segs, err := segments(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &LogReader{}
+	for _, seg := range segs {
+		f, err := os.Open(seg)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		var from io.Reader = f
+		if key != nil {
+			from, err = crypt.NewReader(f, key)
+			if err != nil {
+				f.Close()
+				r.Close()
+				return nil, fmt.Errorf("opening segment %s: %w", filepath.Base(seg), err)
+			}
+		}
+		zr, err := gzip.NewReader(from)
+		if err != nil {
+			f.Close()
+			r.Close()
+			return nil, fmt.Errorf("opening segment %s: %w", filepath.Base(seg), err)
+		}
+		r.readersAppend(zr, zr, f)
+	}
+	current, err := os.Open(filepath.Join(dir, currentName))
+	if err == nil {
+		var from io.Reader = current
+		if key != nil {
+			// A just-created segment may not have its header yet; treat it
+			// as empty rather than failing the replay.
+			if info, serr := current.Stat(); serr == nil && info.Size() >= crypt.HeaderLen {
+				from, err = crypt.NewReader(current, key)
+				if err != nil {
+					current.Close()
+					r.Close()
+					return nil, err
+				}
+			} else {
+				from = io.MultiReader()
+			}
+		}
+		r.readersAppend(from, current)
+	} else if !os.IsNotExist(err) {
+		r.Close()
+		return nil, err
+	}
+	if r.reader == nil {
+		r.reader = io.MultiReader()
+	}
+	return r, nil
+}
+
+func (r *LogReader) readersAppend(reader io.Reader, closers ...io.Closer) {
+	if r.reader == nil {
+		r.reader = reader
+	} else {
+		r.reader = io.MultiReader(r.reader, reader)
+	}
+	r.closers = append(r.closers, closers...)
+}
+
+// Read implements io.Reader.
+func (r *LogReader) Read(p []byte) (int, error) {
+	return r.reader.Read(p)
+}
+
+// Close releases all underlying files.
+func (r *LogReader) Close() error {
+	var first error
+	for _, c := range r.closers {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
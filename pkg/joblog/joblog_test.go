@@ -0,0 +1,174 @@
+package joblog
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pmantica4/teleport/pkg/crypt"
+)
+
+// appendLines writes n numbered lines through the store.
+func appendLines(t *testing.T, s *Store, jobID string, from, n int) {
+	t.Helper()
+	for i := from; i < from+n; i++ {
+		if err := s.Ship(jobID, []string{fmt.Sprintf("line-%04d", i)}); err != nil {
+			t.Fatalf("Ship: %v", err)
+		}
+	}
+}
+
+// readLines replays a job's log into a slice.
+func readLines(t *testing.T, s *Store, jobID string) []string {
+	t.Helper()
+	r, err := s.Reader(jobID)
+	if err != nil {
+		t.Fatalf("Reader: %v", err)
+	}
+	defer r.Close()
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("reading log: %v", err)
+	}
+	return lines
+}
+
+func TestRotationAndReplay(t *testing.T) {
+	// Each line is 10 bytes; a 100-byte segment holds at most 10 lines.
+	s, err := NewStore(t.TempDir(), 100, 100)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer s.Close()
+
+	appendLines(t, s, "job1", 0, 35)
+
+	segs, err := segments(filepath.Join(s.root, "job1"))
+	if err != nil {
+		t.Fatalf("segments: %v", err)
+	}
+	if len(segs) == 0 {
+		t.Fatal("no rotated segments created")
+	}
+	for _, seg := range segs {
+		if !strings.HasSuffix(seg, ".log.gz") {
+			t.Errorf("segment %s is not gzipped", seg)
+		}
+	}
+
+	lines := readLines(t, s, "job1")
+	if len(lines) != 35 {
+		t.Fatalf("replay returned %d lines, want 35", len(lines))
+	}
+	for i, line := range lines {
+		if want := fmt.Sprintf("line-%04d", i); line != want {
+			t.Fatalf("line %d = %q, want %q", i, line, want)
+		}
+	}
+}
+
+func TestMaxFilesPrunesOldest(t *testing.T) {
+	s, err := NewStore(t.TempDir(), 100, 2)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer s.Close()
+
+	appendLines(t, s, "job1", 0, 60)
+
+	segs, err := segments(filepath.Join(s.root, "job1"))
+	if err != nil {
+		t.Fatalf("segments: %v", err)
+	}
+	if len(segs) > 2 {
+		t.Errorf("kept %d segments, want at most 2", len(segs))
+	}
+
+	// The oldest lines are gone, but replay still works and ends with the
+	// newest line.
+	lines := readLines(t, s, "job1")
+	if len(lines) == 0 {
+		t.Fatal("replay after pruning returned no lines")
+	}
+	if last := lines[len(lines)-1]; last != "line-0059" {
+		t.Errorf("replay after pruning ends with %q, want line-0059", last)
+	}
+}
+
+func TestWriterResumesAfterReopen(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir, 100, 10)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	appendLines(t, s, "job1", 0, 15)
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	s, err = NewStore(dir, 100, 10)
+	if err != nil {
+		t.Fatalf("reopening store: %v", err)
+	}
+	defer s.Close()
+	appendLines(t, s, "job1", 15, 15)
+
+	lines := readLines(t, s, "job1")
+	if len(lines) != 30 {
+		t.Fatalf("replay returned %d lines, want 30", len(lines))
+	}
+	if lines[29] != "line-0029" {
+		t.Errorf("last line = %q, want line-0029", lines[29])
+	}
+}
+
+func TestEncryptedStoreRoundTrip(t *testing.T) {
+	key, err := crypt.ParseKey(strings.Repeat("cd", 32))
+	if err != nil {
+		t.Fatalf("ParseKey: %v", err)
+	}
+	dir := t.TempDir()
+	s, err := NewStore(dir, 100, 10)
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	s.SetKey(key)
+	appendLines(t, s, "job1", 0, 15)
+
+	// Nothing readable on disk: neither the current segment nor rotated
+	// ones contain the plaintext.
+	current, err := os.ReadFile(filepath.Join(dir, "job1", currentName))
+	if err != nil {
+		t.Fatalf("reading current segment: %v", err)
+	}
+	if strings.Contains(string(current), "line-") {
+		t.Error("current segment contains plaintext")
+	}
+
+	// Replay decrypts transparently, across rotation and a reopen.
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	s, err = NewStore(dir, 100, 10)
+	if err != nil {
+		t.Fatalf("reopening store: %v", err)
+	}
+	defer s.Close()
+	s.SetKey(key)
+	appendLines(t, s, "job1", 15, 5)
+
+	lines := readLines(t, s, "job1")
+	if len(lines) != 20 {
+		t.Fatalf("replay returned %d lines, want 20", len(lines))
+	}
+	if lines[19] != "line-0019" {
+		t.Errorf("last line = %q, want line-0019", lines[19])
+	}
+}
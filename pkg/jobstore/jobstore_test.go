@@ -0,0 +1,77 @@
+package jobstore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseURL(t *testing.T) {
+	for _, tc := range []struct {
+		url     string
+		driver  string
+		dsn     string
+		dialect dialect
+	}{
+		{"sqlite:/tmp/jobs.db", "sqlite", "/tmp/jobs.db", dialectSQLite},
+		{"postgres://u:p@db/jobs", "postgres", "postgres://u:p@db/jobs", dialectPostgres},
+	} {
+		driver, dsn, d, err := parseURL(tc.url)
+		if err != nil {
+			t.Errorf("parseURL(%q): %v", tc.url, err)
+			continue
+		}
+		if driver != tc.driver || dsn != tc.dsn || d != tc.dialect {
+			t.Errorf("parseURL(%q) = (%q, %q, %d), want (%q, %q, %d)",
+				tc.url, driver, dsn, d, tc.driver, tc.dsn, tc.dialect)
+		}
+	}
+	if _, _, _, err := parseURL("mysql://db/jobs"); err == nil {
+		t.Error("parseURL accepted an unsupported scheme")
+	}
+}
+
+func TestListQueryPushdown(t *testing.T) {
+	since := time.Unix(100, 0)
+	until := time.Unix(200, 0)
+	query, args := listQuery(dialectPostgres, Filter{
+		Owner:  "alice",
+		Status: "COMPLETED",
+		Labels: map[string]string{"env": "prod", "app": "web"},
+		Since:  since,
+		Until:  until,
+		Limit:  10,
+	}, "")
+	want := `SELECT id, owner, name, command, args, labels, status, exit_code, restarts, started_at_unix_nanos, ended_at_unix_nanos FROM jobs` +
+		` WHERE owner = $1 AND status = $2 AND labels LIKE $3 ESCAPE '\' AND labels LIKE $4 ESCAPE '\'` +
+		` AND started_at_unix_nanos >= $5 AND started_at_unix_nanos < $6` +
+		` ORDER BY started_at_unix_nanos DESC LIMIT $7`
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	wantArgs := []any{"alice", "COMPLETED", `%"app":"web"%`, `%"env":"prod"%`,
+		since.UnixNano(), until.UnixNano(), 10}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %v, want %v", args, wantArgs)
+	}
+}
+
+func TestLabelPatternEscapesLIKEMetacharacters(t *testing.T) {
+	got := labelPattern("tier", "100%_done")
+	want := `%"tier":"100\%\_done"%`
+	if got != want {
+		t.Errorf("labelPattern = %q, want %q", got, want)
+	}
+}
+
+func TestListQueryNoFilter(t *testing.T) {
+	query, args := listQuery(dialectSQLite, Filter{}, "")
+	if len(args) != 0 {
+		t.Errorf("args = %v, want none", args)
+	}
+	want := `SELECT id, owner, name, command, args, labels, status, exit_code, restarts, started_at_unix_nanos, ended_at_unix_nanos FROM jobs` +
+		` ORDER BY started_at_unix_nanos DESC`
+	if query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+}
@@ -0,0 +1,86 @@
+package logship
+
+import (
+	"log"
+	"time"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// flush tuning: a batch is shipped when it reaches flushLines or when
+// flushInterval elapses with pending lines, whichever comes first.
+const (
+	flushLines    = 256
+	flushInterval = 2 * time.Second
+)
+
+// Shipper follows every job's output and forwards it to a sink in ordered
+// batches.
+type Shipper struct {
+	sink Sink
+}
+
+// NewShipper returns a shipper forwarding to sink.
+func NewShipper(sink Sink) *Shipper {
+	return &Shipper{sink: sink}
+}
+
+// Listener returns the job-manager listener that starts following each job
+// as it starts. Register it before any jobs run.
+func (s *Shipper) Listener() job.Listener {
+	return func(ev job.Event) {
+		if ev.Type == job.EventStarted {
+			go s.follow(ev)
+		}
+	}
+}
+
+// follow drains a job's output buffer into the sink until the buffer
+// closes. Ship errors are logged and the batch dropped; shipping is best
+// effort and must never block job management.
+func (s *Shipper) follow(ev job.Event) {
+	buf := ev.Job.Output()
+	offset := 0
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var pending []string
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		if err := s.sink.Ship(ev.Job.ID, pending); err != nil {
+			log.Printf("logship: job %s: %v", ev.Job.ID, err)
+		}
+		pending = nil
+	}
+
+	for {
+		wait := buf.Wait()
+		lines, next, closed := buf.LinesSince(offset)
+		offset = next
+		pending = append(pending, lines...)
+		if len(pending) >= flushLines {
+			flush()
+		}
+		if closed {
+			flush()
+			// Sinks with per-job state (like the on-disk store) learn
+			// here that no more output is coming for this job.
+			if jc, ok := s.sink.(interface{ CloseJob(string) error }); ok {
+				if err := jc.CloseJob(ev.Job.ID); err != nil {
+					log.Printf("logship: closing job %s: %v", ev.Job.ID, err)
+				}
+			}
+			return
+		}
+		if len(lines) > 0 {
+			continue
+		}
+		select {
+		case <-wait:
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
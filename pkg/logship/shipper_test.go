@@ -0,0 +1,58 @@
+package logship
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// recordSink captures shipped batches in memory.
+type recordSink struct {
+	mu    sync.Mutex
+	lines map[string][]string
+}
+
+func (s *recordSink) Ship(jobID string, lines []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lines[jobID] = append(s.lines[jobID], lines...)
+	return nil
+}
+
+func (s *recordSink) Close() error { return nil }
+
+func (s *recordSink) get(jobID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.lines[jobID]...)
+}
+
+func TestShipperForwardsJobOutput(t *testing.T) {
+	sink := &recordSink{lines: make(map[string][]string)}
+	m := job.NewManager()
+	m.AddListener(NewShipper(sink).Listener())
+
+	j, err := m.StartJob("alice", job.Spec{Command: "sh", Args: []string{"-c", "echo a; echo b"}})
+	if err != nil {
+		t.Fatalf("StartJob: %v", err)
+	}
+	<-j.Done()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		got := sink.get(j.ID)
+		if len(got) == 2 && got[0] == "a" && got[1] == "b" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("sink never received output, got %q", sink.get(j.ID))
+}
+
+func TestNewRejectsUnknownScheme(t *testing.T) {
+	if _, err := New("ftp://example.com"); err == nil {
+		t.Fatal("New accepted an unsupported scheme")
+	}
+}
@@ -0,0 +1,106 @@
+// Package logship forwards captured job output to external log sinks:
+// syslog, Loki, or any HTTP endpoint (including S3-compatible stores via
+// pre-signed or gateway URLs).
+package logship
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pmantica4/teleport/pkg/joblog"
+)
+
+// Sink receives batches of output lines for a job. Implementations must be
+// safe for concurrent use; batches for one job arrive in order.
+type Sink interface {
+	// Ship forwards a batch of lines attributed to jobID.
+	Ship(jobID string, lines []string) error
+	// Close flushes and releases the sink.
+	Close() error
+}
+
+// New builds a sink from a URL. Supported schemes:
+//
+//	syslog://[tag]                      local syslog with the given tag
+//	loki://host[:port][/path]           Loki push API over http
+//	lokis://host[:port][/path]          Loki push API over https
+//	http:// or https://                 POST plain-text batches to the URL
+//	file:///dir[?max-size=b&max-files=n&budget=b]
+//	                                    rotated, gzip-compressed logs on
+//	                                    disk, one directory per job; budget
+//	                                    caps total usage with eviction
+func New(rawURL string) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing sink url: %w", err)
+	}
+	switch u.Scheme {
+	case "syslog":
+		return newSyslogSink(u.Host)
+	case "file":
+		return newFileSink(u)
+	case "loki", "lokis":
+		scheme := "http"
+		if u.Scheme == "lokis" {
+			scheme = "https"
+		}
+		path := u.Path
+		if path == "" || path == "/" {
+			path = "/loki/api/v1/push"
+		}
+		return newLokiSink(scheme + "://" + u.Host + path), nil
+	case "http", "https":
+		return newHTTPSink(rawURL), nil
+	default:
+		return nil, fmt.Errorf("unsupported sink scheme %q", u.Scheme)
+	}
+}
+
+// newFileSink builds an on-disk log store from a file:// URL; rotation is
+// tuned with the max-size (bytes) and max-files query parameters.
+func newFileSink(u *url.URL) (Sink, error) {
+	if u.Path == "" {
+		return nil, fmt.Errorf("file sink needs a directory path")
+	}
+	q := u.Query()
+	var maxSize int64
+	var maxFiles int
+	if v := q.Get("max-size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("file sink max-size: %w", err)
+		}
+		maxSize = n
+	}
+	if v := q.Get("max-files"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("file sink max-files: %w", err)
+		}
+		maxFiles = n
+	}
+	store, err := joblog.NewStore(u.Path, maxSize, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	if v := q.Get("budget"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("file sink budget: %w", err)
+		}
+		store.SetBudget(n)
+	}
+	return store, nil
+}
+
+// joinLines renders a batch as newline-terminated text.
+func joinLines(lines []string) string {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package logship
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// syslogSink writes each line to the local syslog daemon.
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func newSyslogSink(tag string) (Sink, error) {
+	if tag == "" {
+		tag = "jobworker"
+	}
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to syslog: %w", err)
+	}
+	return &syslogSink{w: w}, nil
+}
+
+func (s *syslogSink) Ship(jobID string, lines []string) error {
+	for _, line := range lines {
+		if err := s.w.Info(jobID + ": " + line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	return s.w.Close()
+}
+
+// httpSink POSTs each batch as plain text with the job ID in a header.
+// Usable with S3-compatible gateways and pre-signed URLs.
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPSink(url string) Sink {
+	return &httpSink{url: url, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (s *httpSink) Ship(jobID string, lines []string) error {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewBufferString(joinLines(lines)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Job-ID", jobID)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sink returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error { return nil }
+
+// lokiSink pushes batches to Loki's push API, labelling streams with the
+// job ID.
+type lokiSink struct {
+	url    string
+	client *http.Client
+}
+
+func newLokiSink(url string) Sink {
+	return &lokiSink{url: url, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (s *lokiSink) Ship(jobID string, lines []string) error {
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	values := make([][2]string, 0, len(lines))
+	for _, line := range lines {
+		values = append(values, [2]string{now, line})
+	}
+	body, err := json.Marshal(map[string]any{
+		"streams": []map[string]any{{
+			"stream": map[string]string{"app": "jobworker", "job_id": jobID},
+			"values": values,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("loki returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *lokiSink) Close() error { return nil }
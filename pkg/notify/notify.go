@@ -0,0 +1,148 @@
+// Package notify sends human-readable job notifications to first-class
+// integrations — email over SMTP and Slack incoming webhooks — as opposed
+// to the machine-oriented JSON of package webhook. Targets are compact
+// URLs, messages are rendered from a template.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Event is the notification-worthy outcome of one job, already flattened
+// for templating.
+type Event struct {
+	JobID    string
+	Name     string
+	Owner    string
+	Command  string
+	Status   string
+	ExitCode int
+	Duration time.Duration
+	// LogTail is the last few captured output lines, oldest first.
+	LogTail []string
+}
+
+// DefaultTemplate renders the message body sent when no custom template is
+// configured. The dot is an Event.
+const DefaultTemplate = `Job {{if .Name}}{{.Name}} ({{.JobID}}){{else}}{{.JobID}}{{end}} {{.Status}} after {{.Duration}} (exit code {{.ExitCode}}).
+Command: {{.Command}}{{if .LogTail}}
+Last output:
+{{range .LogTail}}  {{.}}
+{{end}}{{end}}`
+
+// Target is one notification destination.
+type Target interface {
+	// Send delivers the rendered message.
+	Send(subject, body string) error
+	// String returns the target's URL form, for logs.
+	String() string
+}
+
+// ParseTarget parses a target URL:
+//
+//	smtp://relay:25/to@example.com?from=worker@example.com
+//	slack://hooks.slack.com/services/T000/B000/XXXX
+//
+// The SMTP path is the recipient; from defaults to job-worker@ the relay
+// host. Slack URLs are the https webhook URL with the scheme swapped.
+func ParseTarget(s string) (Target, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notify target %q: %v", s, err)
+	}
+	switch u.Scheme {
+	case "smtp":
+		to := strings.TrimPrefix(u.Path, "/")
+		if u.Host == "" || to == "" {
+			return nil, fmt.Errorf("invalid notify target %q, want smtp://host:port/recipient", s)
+		}
+		from := u.Query().Get("from")
+		if from == "" {
+			from = "job-worker@" + u.Hostname()
+		}
+		return &smtpTarget{addr: u.Host, from: from, to: to}, nil
+	case "slack":
+		if u.Host == "" || u.Path == "" {
+			return nil, fmt.Errorf("invalid notify target %q, want slack://host/webhook-path", s)
+		}
+		return &slackTarget{url: "https://" + u.Host + u.Path}, nil
+	default:
+		return nil, fmt.Errorf("unknown notify scheme %q, want smtp or slack", u.Scheme)
+	}
+}
+
+// Render fills the message template with the event. An empty tmpl uses
+// DefaultTemplate.
+func Render(tmpl string, ev Event) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultTemplate
+	}
+	t, err := template.New("notify").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid notify template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, ev); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Subject is the one-line summary used as the email subject.
+func Subject(ev Event) string {
+	name := ev.Name
+	if name == "" {
+		name = ev.JobID
+	}
+	return fmt.Sprintf("job %s %s", name, ev.Status)
+}
+
+type smtpTarget struct {
+	addr string
+	from string
+	to   string
+}
+
+func (t *smtpTarget) Send(subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		t.from, t.to, subject, strings.ReplaceAll(body, "\n", "\r\n"))
+	return smtp.SendMail(t.addr, nil, t.from, []string{t.to}, []byte(msg))
+}
+
+func (t *smtpTarget) String() string { return "smtp://" + t.addr + "/" + t.to }
+
+type slackTarget struct {
+	url string
+	// client overrides the default HTTP client in tests.
+	client *http.Client
+}
+
+func (t *slackTarget) Send(_, body string) error {
+	payload, err := json.Marshal(map[string]string{"text": body})
+	if err != nil {
+		return err
+	}
+	client := t.client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Post(t.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (t *slackTarget) String() string { return strings.Replace(t.url, "https://", "slack://", 1) }
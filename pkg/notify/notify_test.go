@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTarget(t *testing.T) {
+	for _, in := range []string{
+		"smtp://relay:25/oncall@example.com",
+		"smtp://relay:25/oncall@example.com?from=worker@example.com",
+		"slack://hooks.slack.com/services/T0/B0/XX",
+	} {
+		if _, err := ParseTarget(in); err != nil {
+			t.Errorf("ParseTarget(%q) error: %v", in, err)
+		}
+	}
+	for _, in := range []string{
+		"smtp://relay:25",
+		"smtp:///oncall@example.com",
+		"slack://",
+		"http://example.com/hook",
+		"bogus",
+	} {
+		if _, err := ParseTarget(in); err == nil {
+			t.Errorf("ParseTarget(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestRenderDefaultTemplate(t *testing.T) {
+	got, err := Render("", Event{
+		JobID:    "job-1",
+		Name:     "deploy",
+		Status:   "FAILED",
+		Command:  "make deploy",
+		ExitCode: 2,
+		Duration: 3 * time.Second,
+		LogTail:  []string{"pushing", "error: denied"},
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for _, want := range []string{"deploy (job-1)", "FAILED", "3s", "exit code 2", "error: denied"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("message %q missing %q", got, want)
+		}
+	}
+}
+
+func TestSlackTargetPostsText(t *testing.T) {
+	var body map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+	}))
+	defer srv.Close()
+
+	target, err := ParseTarget("slack://hooks.slack.com/services/T0/B0/XX")
+	if err != nil {
+		t.Fatalf("ParseTarget: %v", err)
+	}
+	// Point the target at the plaintext test server instead of https.
+	target.(*slackTarget).url = srv.URL
+	if err := target.Send("subject", "job deploy FAILED"); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if body["text"] != "job deploy FAILED" {
+		t.Errorf("posted text = %q, want job deploy FAILED", body["text"])
+	}
+}
@@ -0,0 +1,343 @@
+// Package operator reconciles JobRun custom resources in a Kubernetes
+// cluster against jobs on a worker or coordinator. It speaks to the
+// Kubernetes API directly over HTTP — list, watch, and status patches on
+// the jobruns resource — so no Kubernetes client machinery is required.
+//
+// A JobRun looks like:
+//
+//	apiVersion: jobworker.io/v1
+//	kind: JobRun
+//	metadata:
+//	  name: nightly-report
+//	spec:
+//	  command: make
+//	  args: ["report"]
+//
+// The operator starts a job for every JobRun without one, mirrors the
+// job's state, exit code, and log reference into the JobRun's status, and
+// stops the job when its JobRun is deleted.
+package operator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// resyncInterval is how often every JobRun is re-reconciled even without
+// watch events, keeping statuses fresh while jobs run.
+const resyncInterval = 10 * time.Second
+
+// jobRunsPath is the API path of the custom resource, relative to the
+// namespace.
+const jobRunsPath = "/apis/jobworker.io/v1/namespaces/%s/jobruns"
+
+// JobService is the slice of the worker client the operator drives.
+// *client.Client satisfies it.
+type JobService interface {
+	Start(ctx context.Context, spec client.StartSpec) (string, error)
+	GetJobStatus(ctx context.Context, jobID string) (*jobworkerv1.Job, error)
+	StopJobReason(ctx context.Context, jobID, reason string) error
+}
+
+var _ JobService = (*client.Client)(nil)
+
+// Config holds everything needed to build an Operator.
+type Config struct {
+	// APIServer is the Kubernetes API base URL. Empty uses the in-cluster
+	// environment (KUBERNETES_SERVICE_HOST and the service-account files).
+	APIServer string
+	// TokenFile and CAFile authenticate to the API server; empty uses the
+	// in-cluster service-account defaults.
+	TokenFile string
+	CAFile    string
+	// Namespace is where JobRuns are watched; empty uses the pod's own.
+	Namespace string
+
+	// Jobs is the worker or coordinator connection jobs are started on.
+	Jobs JobService
+}
+
+// serviceAccountDir is where Kubernetes mounts pod credentials.
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// CheckAndSetDefaults validates the config and fills in the in-cluster
+// defaults.
+func (c *Config) CheckAndSetDefaults() error {
+	if c.Jobs == nil {
+		return fmt.Errorf("a job service connection is required")
+	}
+	if c.APIServer == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" {
+			return fmt.Errorf("no API server configured and not running in a cluster")
+		}
+		c.APIServer = "https://" + host + ":" + port
+	}
+	if c.TokenFile == "" {
+		c.TokenFile = serviceAccountDir + "/token"
+	}
+	if c.Namespace == "" {
+		data, err := os.ReadFile(serviceAccountDir + "/namespace")
+		if err != nil {
+			return fmt.Errorf("no namespace configured and none mounted: %w", err)
+		}
+		c.Namespace = strings.TrimSpace(string(data))
+	}
+	return nil
+}
+
+// JobRun is the custom resource the operator reconciles.
+type JobRun struct {
+	Metadata ObjectMeta   `json:"metadata"`
+	Spec     JobRunSpec   `json:"spec"`
+	Status   JobRunStatus `json:"status"`
+}
+
+// ObjectMeta is the subset of Kubernetes object metadata the operator
+// uses.
+type ObjectMeta struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// JobRunSpec mirrors the start options a JobRun may set.
+type JobRunSpec struct {
+	Command       string            `json:"command"`
+	Args          []string          `json:"args"`
+	NodeSelector  map[string]string `json:"nodeSelector,omitempty"`
+	RestartPolicy string            `json:"restartPolicy,omitempty"`
+	Gpus          int               `json:"gpus,omitempty"`
+	Priority      int               `json:"priority,omitempty"`
+}
+
+// JobRunStatus is what the operator writes back to the cluster.
+type JobRunStatus struct {
+	// JobID is the job backing this JobRun; set once it has started.
+	JobID string `json:"jobId,omitempty"`
+	// State is the job's status string, e.g. "RUNNING".
+	State string `json:"state,omitempty"`
+	// ExitCode is meaningful once State is terminal.
+	ExitCode int32 `json:"exitCode,omitempty"`
+	// Logs is the job's output path on the worker's HTTP gateway.
+	Logs string `json:"logs,omitempty"`
+	// Message carries the last reconcile error, if any.
+	Message string `json:"message,omitempty"`
+}
+
+// watchEvent is one entry of a Kubernetes watch stream.
+type watchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// Operator drives JobRuns to their desired state.
+type Operator struct {
+	cfg  Config
+	http *http.Client
+	base string // API server URL + namespaced jobruns path
+}
+
+// New builds an Operator; call Run to start reconciling.
+func New(cfg Config) (*Operator, error) {
+	if err := cfg.CheckAndSetDefaults(); err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{}
+	caFile := cfg.CAFile
+	if caFile == "" {
+		if _, err := os.Stat(serviceAccountDir + "/ca.crt"); err == nil {
+			caFile = serviceAccountDir + "/ca.crt"
+		}
+	}
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading API server CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates parsed from %s", caFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &Operator{
+		cfg:  cfg,
+		http: &http.Client{Transport: transport},
+		base: cfg.APIServer + fmt.Sprintf(jobRunsPath, cfg.Namespace),
+	}, nil
+}
+
+// Run reconciles until ctx is cancelled: a full list on every resync
+// tick, with a watch in between so deletes and edits act promptly.
+func (o *Operator) Run(ctx context.Context) error {
+	for {
+		version, err := o.reconcileAll(ctx)
+		if err != nil {
+			log.Printf("operator: list jobruns: %v", err)
+		} else if err := o.watch(ctx, version); err != nil {
+			log.Printf("operator: watch jobruns: %v", err)
+		}
+		select {
+		case <-time.After(resyncInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// reconcileAll lists every JobRun and reconciles each, returning the
+// list's resource version to watch from.
+func (o *Operator) reconcileAll(ctx context.Context) (string, error) {
+	resp, err := o.do(ctx, http.MethodGet, o.base, "", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var list struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+		Items []JobRun `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return "", err
+	}
+	for i := range list.Items {
+		o.reconcile(ctx, &list.Items[i])
+	}
+	return list.Metadata.ResourceVersion, nil
+}
+
+// watch follows JobRun changes until the stream breaks or the resync
+// interval elapses, reconciling on adds and edits and stopping jobs on
+// deletes.
+func (o *Operator) watch(ctx context.Context, version string) error {
+	watchCtx, cancel := context.WithTimeout(ctx, resyncInterval)
+	defer cancel()
+	u := o.base + "?watch=1&resourceVersion=" + url.QueryEscape(version)
+	resp, err := o.do(watchCtx, http.MethodGet, u, "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	dec := json.NewDecoder(bufio.NewReader(resp.Body))
+	for {
+		var ev watchEvent
+		if err := dec.Decode(&ev); err != nil {
+			if watchCtx.Err() != nil || err == io.EOF {
+				return nil // resync or server-side timeout, not a failure
+			}
+			return err
+		}
+		var jr JobRun
+		if err := json.Unmarshal(ev.Object, &jr); err != nil {
+			continue
+		}
+		switch ev.Type {
+		case "ADDED", "MODIFIED":
+			o.reconcile(ctx, &jr)
+		case "DELETED":
+			if jr.Status.JobID != "" {
+				if err := o.cfg.Jobs.StopJobReason(ctx, jr.Status.JobID, "jobrun "+jr.Metadata.Name+" deleted"); err != nil {
+					log.Printf("operator: stopping job for deleted jobrun %s: %v", jr.Metadata.Name, err)
+				}
+			}
+		}
+	}
+}
+
+// reconcile drives one JobRun: starts its job if it has none and syncs
+// the job's state into the status. Errors land in status.message rather
+// than failing the loop.
+func (o *Operator) reconcile(ctx context.Context, jr *JobRun) {
+	status := jr.Status
+	status.Message = ""
+	if status.JobID == "" {
+		id, err := o.cfg.Jobs.Start(ctx, client.StartSpec{
+			Command:       jr.Spec.Command,
+			Args:          jr.Spec.Args,
+			Name:          "jobrun-" + jr.Metadata.Name,
+			NodeSelector:  jr.Spec.NodeSelector,
+			RestartPolicy: jr.Spec.RestartPolicy,
+			GPUs:          jr.Spec.Gpus,
+			Priority:      jr.Spec.Priority,
+		})
+		if err != nil {
+			status.Message = fmt.Sprintf("starting job: %v", err)
+			o.patchStatus(ctx, jr, status)
+			return
+		}
+		log.Printf("operator: jobrun %s started job %s", jr.Metadata.Name, id)
+		status.JobID = id
+		status.Logs = "/v1/jobs/" + id + "/output"
+	}
+	j, err := o.cfg.Jobs.GetJobStatus(ctx, status.JobID)
+	if err != nil {
+		status.Message = fmt.Sprintf("fetching job status: %v", err)
+	} else {
+		status.State = strings.TrimPrefix(j.Status.String(), "JOB_STATUS_")
+		status.ExitCode = j.ExitCode
+	}
+	o.patchStatus(ctx, jr, status)
+}
+
+// patchStatus merge-patches the JobRun's status subresource when it has
+// changed.
+func (o *Operator) patchStatus(ctx context.Context, jr *JobRun, status JobRunStatus) {
+	if status == jr.Status {
+		return
+	}
+	body, err := json.Marshal(map[string]any{"status": status})
+	if err != nil {
+		return
+	}
+	u := o.base + "/" + jr.Metadata.Name + "/status"
+	resp, err := o.do(ctx, http.MethodPatch, u, "application/merge-patch+json", bytes.NewReader(body))
+	if err != nil {
+		log.Printf("operator: patching jobrun %s status: %v", jr.Metadata.Name, err)
+		return
+	}
+	resp.Body.Close()
+	jr.Status = status
+}
+
+// do issues one authenticated API request; non-2xx responses become
+// errors.
+func (o *Operator) do(ctx context.Context, method, u, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token, err := os.ReadFile(o.cfg.TokenFile); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	resp, err := o.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s %s: %s: %s", method, u, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,148 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/client"
+)
+
+// fakeJobs records the calls the operator makes against the job service.
+type fakeJobs struct {
+	mu      sync.Mutex
+	started []client.StartSpec
+	stopped []string
+	status  jobworkerv1.JobStatus
+}
+
+func (f *fakeJobs) Start(ctx context.Context, spec client.StartSpec) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.started = append(f.started, spec)
+	return "job-1", nil
+}
+
+func (f *fakeJobs) GetJobStatus(ctx context.Context, jobID string) (*jobworkerv1.Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &jobworkerv1.Job{Id: jobID, Status: f.status}, nil
+}
+
+func (f *fakeJobs) StopJobReason(ctx context.Context, jobID, reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped = append(f.stopped, jobID)
+	return nil
+}
+
+// newTestOperator wires an Operator against a fake API server and job
+// service.
+func newTestOperator(t *testing.T, jobs JobService, handler http.Handler) *Operator {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	op, err := New(Config{
+		APIServer: ts.URL,
+		TokenFile: tokenFile,
+		Namespace: "default",
+		Jobs:      jobs,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return op
+}
+
+func TestReconcileStartsJobAndSyncsStatus(t *testing.T) {
+	jobs := &fakeJobs{status: jobworkerv1.JobStatus_JOB_STATUS_RUNNING}
+	var patches []JobRunStatus
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apis/jobworker.io/v1/namespaces/default/jobruns", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"metadata": map[string]any{"resourceVersion": "7"},
+			"items": []JobRun{{
+				Metadata: ObjectMeta{Name: "report", Namespace: "default"},
+				Spec:     JobRunSpec{Command: "make", Args: []string{"report"}},
+			}},
+		})
+	})
+	mux.HandleFunc("/apis/jobworker.io/v1/namespaces/default/jobruns/report/status", func(w http.ResponseWriter, r *http.Request) {
+		var patch struct {
+			Status JobRunStatus `json:"status"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		patches = append(patches, patch.Status)
+	})
+	op := newTestOperator(t, jobs, mux)
+
+	version, err := op.reconcileAll(context.Background())
+	if err != nil {
+		t.Fatalf("reconcileAll: %v", err)
+	}
+	if version != "7" {
+		t.Errorf("resource version = %q, want 7", version)
+	}
+	if len(jobs.started) != 1 || jobs.started[0].Command != "make" || jobs.started[0].Name != "jobrun-report" {
+		t.Fatalf("started = %+v, want one make job named jobrun-report", jobs.started)
+	}
+	if len(patches) != 1 {
+		t.Fatalf("got %d status patches, want 1", len(patches))
+	}
+	st := patches[0]
+	if st.JobID != "job-1" || st.State != "RUNNING" || st.Logs != "/v1/jobs/job-1/output" {
+		t.Errorf("patched status = %+v", st)
+	}
+}
+
+func TestWatchDeleteStopsJob(t *testing.T) {
+	jobs := &fakeJobs{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apis/jobworker.io/v1/namespaces/default/jobruns", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("watch") != "1" {
+			http.Error(w, "expected a watch", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(watchEvent{
+			Type: "DELETED",
+			Object: mustJSON(t, JobRun{
+				Metadata: ObjectMeta{Name: "report"},
+				Status:   JobRunStatus{JobID: "job-9"},
+			}),
+		})
+	})
+	op := newTestOperator(t, jobs, mux)
+
+	if err := op.watch(context.Background(), "7"); err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	if len(jobs.stopped) != 1 || jobs.stopped[0] != "job-9" {
+		t.Errorf("stopped = %v, want [job-9]", jobs.stopped)
+	}
+}
+
+func mustJSON(t *testing.T, v any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,136 @@
+// Package secrets resolves references like file:db/password,
+// env:SECRET_DB_PASS, or vault:kv/db#password into values a job's
+// environment is populated with at exec time. Values pass straight from
+// the provider into the child process; they are never written to job
+// metadata, the job store, or logs — only the variable names are.
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Resolver resolves secret references against the configured providers.
+type Resolver struct {
+	// dir is the root for file: references; empty disables them.
+	dir string
+	// client performs Vault HTTP requests.
+	client *http.Client
+}
+
+// NewResolver builds a resolver. dir roots file: references; the env:
+// and vault: providers need no configuration here — env: reads this
+// process's SECRET_-prefixed variables, vault: uses VAULT_ADDR and
+// VAULT_TOKEN.
+func NewResolver(dir string) *Resolver {
+	return &Resolver{dir: dir, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Resolve returns the value a reference points at. Errors name the
+// reference, never the value.
+func (r *Resolver) Resolve(ref string) (string, error) {
+	scheme, rest, ok := strings.Cut(ref, ":")
+	if !ok {
+		return "", fmt.Errorf("secret reference %q: want provider:name", ref)
+	}
+	switch scheme {
+	case "file":
+		return r.resolveFile(rest)
+	case "env":
+		return r.resolveEnv(rest)
+	case "vault":
+		return r.resolveVault(rest)
+	default:
+		return "", fmt.Errorf("unknown secret provider %q; want file, env, or vault", scheme)
+	}
+}
+
+// resolveFile reads a secret file under the configured root, with a
+// trailing newline stripped. References cannot escape the root.
+func (r *Resolver) resolveFile(name string) (string, error) {
+	if r.dir == "" {
+		return "", fmt.Errorf("file secrets are not configured; set -secrets-dir")
+	}
+	path := filepath.Join(r.dir, filepath.Clean("/"+name))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading secret file %s: %w", name, err)
+	}
+	return strings.TrimRight(string(data), "\n"), nil
+}
+
+// resolveEnv reads a variable from this process's environment. Only
+// SECRET_-prefixed names resolve, so a reference cannot exfiltrate the
+// server's own credentials.
+func (r *Resolver) resolveEnv(name string) (string, error) {
+	if !strings.HasPrefix(name, "SECRET_") {
+		return "", fmt.Errorf("env secret %q: only SECRET_-prefixed variables resolve", name)
+	}
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("env secret %s is not set", name)
+	}
+	return v, nil
+}
+
+// resolveVault fetches mount/path#key from the Vault KV store at
+// VAULT_ADDR, authenticated by VAULT_TOKEN. Both KV v2 (data nested
+// under data.data) and v1 layouts are understood.
+func (r *Resolver) resolveVault(ref string) (string, error) {
+	addr := os.Getenv("VAULT_ADDR")
+	token := os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		return "", fmt.Errorf("vault secrets need VAULT_ADDR and VAULT_TOKEN in the server environment")
+	}
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok {
+		return "", fmt.Errorf("vault secret %q: want mount/path#key", ref)
+	}
+	mount, rest, ok := strings.Cut(path, "/")
+	if !ok {
+		return "", fmt.Errorf("vault secret %q: want mount/path#key", ref)
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+mount+"/data/"+rest, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching vault secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching vault secret %s: status %s", path, resp.Status)
+	}
+	var body struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	// Decode twice: KV v2 nests the pairs one level deeper than v1.
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(raw, &body); err == nil {
+		if v, ok := body.Data.Data[key]; ok {
+			return v, nil
+		}
+	}
+	var v1 struct {
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &v1); err == nil {
+		if v, ok := v1.Data[key]; ok {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("vault secret %s has no key %q", path, key)
+}
@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "db"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "db", "password"), []byte("hunter2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	r := NewResolver(dir)
+
+	got, err := r.Resolve("file:db/password")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if got != "hunter2" {
+		t.Errorf("value = %q, want hunter2 with the newline stripped", got)
+	}
+
+	// References cannot climb out of the root.
+	if _, err := r.Resolve("file:../../etc/passwd"); err == nil {
+		t.Error("escaping reference resolved; want an error")
+	}
+
+	// No root configured: the provider is off.
+	if _, err := NewResolver("").Resolve("file:db/password"); err == nil {
+		t.Error("file secret resolved without a secrets dir; want an error")
+	}
+}
+
+func TestResolveEnv(t *testing.T) {
+	t.Setenv("SECRET_TOKEN", "tok")
+	t.Setenv("VAULT_TOKEN", "dont-leak-me")
+	r := NewResolver("")
+
+	if got, err := r.Resolve("env:SECRET_TOKEN"); err != nil || got != "tok" {
+		t.Errorf("Resolve(env:SECRET_TOKEN) = %q, %v; want tok", got, err)
+	}
+	// Unprefixed variables are off-limits, or a job could read the
+	// server's own credentials.
+	if _, err := r.Resolve("env:VAULT_TOKEN"); err == nil {
+		t.Error("unprefixed env secret resolved; want an error")
+	}
+}
+
+func TestResolveVault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if req.URL.Path != "/v1/kv/data/db" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write([]byte(`{"data": {"data": {"password": "hunter2"}}}`))
+	}))
+	defer srv.Close()
+	t.Setenv("VAULT_ADDR", srv.URL)
+	t.Setenv("VAULT_TOKEN", "root")
+	r := NewResolver("")
+
+	got, err := r.Resolve("vault:kv/db#password")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if got != "hunter2" {
+		t.Errorf("value = %q, want hunter2", got)
+	}
+	if _, err := r.Resolve("vault:kv/db#missing"); err == nil {
+		t.Error("missing key resolved; want an error")
+	}
+}
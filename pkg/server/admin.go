@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"runtime"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/audit"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/template"
+)
+
+// requireAdmin rejects callers whose certificate does not carry the admin
+// role, returning the identity of those it admits.
+func requireAdmin(ctx context.Context) (string, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, role := range auth.RolesFromContext(ctx) {
+		if role == adminRole {
+			return identity, nil
+		}
+	}
+	return "", status.Error(codes.PermissionDenied, "admin role required")
+}
+
+// admissionGate is the switch the admin API flips to stop new jobs from
+// being admitted. Running jobs are never affected.
+type admissionGate struct {
+	mu       sync.Mutex
+	paused   bool
+	draining bool
+	reason   string
+}
+
+// check fails when admission is paused or the node is draining; start
+// paths call it before doing any work.
+func (g *admissionGate) check() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case g.draining:
+		return status.Error(codes.Unavailable, "node is draining; not accepting jobs")
+	case g.paused:
+		msg := "job admission is paused"
+		if g.reason != "" {
+			msg += ": " + g.reason
+		}
+		return status.Error(codes.Unavailable, msg)
+	}
+	return nil
+}
+
+// set pauses or resumes admission; resuming also clears drain mode.
+func (g *admissionGate) set(paused bool, reason string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.paused = paused
+	g.reason = reason
+	if !paused {
+		g.draining = false
+		g.reason = ""
+	}
+}
+
+// drain stops admission until a resume, independent of the pause flag.
+func (g *admissionGate) drain() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.draining = true
+}
+
+// certReloader serves the server certificate through tls.Config's
+// GetCertificate hook so ReloadConfig can swap in a rotated key pair
+// without dropping connections.
+type certReloader struct {
+	certFile, keyFile string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertReloader(certFile, keyFile string) *certReloader {
+	return &certReloader{certFile: certFile, keyFile: keyFile}
+}
+
+// reload re-reads the key pair from disk; the old certificate stays in
+// service when reading fails.
+func (r *certReloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// adminServer is the AdminService implementation: node-level operations
+// restricted to the admin role.
+type adminServer struct {
+	jobworkerv1.UnimplementedAdminServiceServer
+
+	svc   *jobServiceServer
+	cfg   Config
+	certs *certReloader
+	audit *audit.Log
+}
+
+func (a *adminServer) SetAdmission(ctx context.Context, req *jobworkerv1.SetAdmissionRequest) (*jobworkerv1.SetAdmissionResponse, error) {
+	identity, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.svc.admission.set(req.Paused, req.Reason)
+	if req.Paused {
+		log.Printf("admin: job admission paused by %s: %s", identity, req.Reason)
+	} else {
+		log.Printf("admin: job admission resumed by %s", identity)
+	}
+	return &jobworkerv1.SetAdmissionResponse{}, nil
+}
+
+func (a *adminServer) Drain(ctx context.Context, _ *jobworkerv1.DrainRequest) (*jobworkerv1.DrainResponse, error) {
+	identity, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.svc.admission.drain()
+	running := a.svc.manager.RunningCount()
+	log.Printf("admin: drain requested by %s; %d jobs still running", identity, running)
+	return &jobworkerv1.DrainResponse{RunningJobs: int64(running)}, nil
+}
+
+func (a *adminServer) ReloadConfig(ctx context.Context, _ *jobworkerv1.ReloadConfigRequest) (*jobworkerv1.ReloadConfigResponse, error) {
+	identity, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var reloaded []string
+	if err := a.certs.reload(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reloading certificate: %v", err)
+	}
+	reloaded = append(reloaded, "certificate")
+	if a.cfg.TemplatesFile != "" {
+		templates, err := template.LoadFile(a.cfg.TemplatesFile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reloading templates: %v", err)
+		}
+		a.svc.reloadMu.Lock()
+		a.svc.templates = templates
+		a.svc.reloadMu.Unlock()
+		reloaded = append(reloaded, "templates")
+	}
+	if a.cfg.LimitsFile != "" {
+		limits, err := LoadLimitPolicy(a.cfg.LimitsFile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reloading limits: %v", err)
+		}
+		a.svc.reloadMu.Lock()
+		a.svc.limits = limits
+		a.svc.reloadMu.Unlock()
+		reloaded = append(reloaded, "limits")
+	}
+	if a.cfg.QuotasFile != "" {
+		quotas, err := LoadQuotaPolicy(a.cfg.QuotasFile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reloading quotas: %v", err)
+		}
+		a.svc.quota.setPolicy(quotas)
+		reloaded = append(reloaded, "quotas")
+	}
+	if a.cfg.RedactFile != "" {
+		redact, err := LoadRedactRules(a.cfg.RedactFile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reloading redaction rules: %v", err)
+		}
+		a.svc.reloadMu.Lock()
+		a.svc.redact = redact
+		a.svc.reloadMu.Unlock()
+		reloaded = append(reloaded, "redact")
+	}
+	if a.cfg.MaintenanceFile != "" {
+		maintenance, err := LoadMaintenancePolicy(a.cfg.MaintenanceFile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reloading maintenance windows: %v", err)
+		}
+		a.svc.maintenance.setPolicy(maintenance)
+		reloaded = append(reloaded, "maintenance")
+	}
+	log.Printf("admin: config reloaded by %s: %v", identity, reloaded)
+	return &jobworkerv1.ReloadConfigResponse{Reloaded: reloaded}, nil
+}
+
+func (a *adminServer) VerifyAudit(ctx context.Context, _ *jobworkerv1.VerifyAuditRequest) (*jobworkerv1.VerifyAuditResponse, error) {
+	identity, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.audit == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no audit trail configured; start the server with -audit-file")
+	}
+	entries, err := a.audit.Verify()
+	if err != nil {
+		log.Printf("admin: audit verification by %s FAILED after %d entries: %v", identity, entries, err)
+		return nil, status.Errorf(codes.FailedPrecondition, "audit trail verification failed: %v", err)
+	}
+	log.Printf("admin: audit trail verified by %s: %d entries intact", identity, entries)
+	return &jobworkerv1.VerifyAuditResponse{Entries: entries}, nil
+}
+
+func (a *adminServer) DumpDiagnostics(ctx context.Context, _ *jobworkerv1.DumpDiagnosticsRequest) (*jobworkerv1.DumpDiagnosticsResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	// Grow the buffer until the full dump fits, as runtime.Stack truncates
+	// silently.
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return &jobworkerv1.DumpDiagnosticsResponse{
+		GoroutineDump: buf,
+		Goroutines:    int64(runtime.NumGoroutine()),
+		Jobs:          int64(len(a.svc.manager.ListJobs())),
+		RunningJobs:   int64(a.svc.manager.RunningCount()),
+		HeapBytes:     int64(mem.HeapAlloc),
+	}, nil
+}
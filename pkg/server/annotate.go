@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// AnnotateJob appends an owner-written marker to the job's output timeline,
+// so external events can be correlated with the surrounding output.
+func (s *jobServiceServer) AnnotateJob(ctx context.Context, req *jobworkerv1.AnnotateJobRequest) (*jobworkerv1.AnnotateJobResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Text == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+	if err := j.Annotate(req.Text); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &jobworkerv1.AnnotateJobResponse{}, nil
+}
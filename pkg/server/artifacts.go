@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/artifact"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// artifactChunkSize is how much of an artifact each streamed message carries.
+const artifactChunkSize = 64 * 1024
+
+// corePattern matches the core dumps a job with a core limit may leave in
+// its workspace; StartJob adds it to such jobs' artifact patterns.
+const corePattern = "core*"
+
+// collectArtifacts is the manager listener that gathers a finished job's
+// declared artifacts from its workspace into the store. Collection failures
+// are logged rather than surfaced: the job itself already finished.
+func collectArtifacts(store artifact.Store) job.Listener {
+	return func(ev job.Event) {
+		j := ev.Job
+		if ev.Type != job.EventFinished || len(j.Spec.Artifacts) == 0 || j.Spec.Dir == "" {
+			return
+		}
+		if _, err := artifact.Collect(store, j.ID, j.Spec.Dir, j.Spec.Artifacts); err != nil {
+			log.Printf("collecting artifacts for job %s: %v", j.ID, err)
+		}
+	}
+}
+
+func (s *jobServiceServer) ListArtifacts(ctx context.Context, req *jobworkerv1.ListArtifactsRequest) (*jobworkerv1.ListArtifactsResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if s.artifacts == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no artifact store configured; set -artifact-store")
+	}
+	entries, err := s.artifacts.Index(j.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &jobworkerv1.ListArtifactsResponse{}
+	for _, e := range entries {
+		resp.Artifacts = append(resp.Artifacts, &jobworkerv1.Artifact{
+			Path:      e.Path,
+			SizeBytes: e.Size,
+		})
+	}
+	return resp, nil
+}
+
+func (s *jobServiceServer) DownloadArtifact(req *jobworkerv1.DownloadArtifactRequest, stream jobworkerv1.JobService_DownloadArtifactServer) error {
+	j, err := s.authorizedJob(stream.Context(), req.JobId)
+	if err != nil {
+		return err
+	}
+	if s.artifacts == nil {
+		return status.Error(codes.FailedPrecondition, "no artifact store configured; set -artifact-store")
+	}
+	r, err := s.artifacts.Open(j.ID, req.Path)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	defer r.Close()
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&jobworkerv1.ArtifactChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+}
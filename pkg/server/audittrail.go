@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/pmantica4/teleport/pkg/audit"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// recordAudit returns the listener that appends job lifecycle events to
+// the tamper-evident audit trail. Appends are best effort and logged;
+// the trail must never block job management.
+func recordAudit(trail *audit.Log) job.Listener {
+	return func(ev job.Event) {
+		j := ev.Job
+		var action, detail string
+		switch ev.Type {
+		case job.EventStarted:
+			action = "job.start"
+			detail = fmt.Sprintf("job %s: %s", j.ID, j.Spec.Command)
+		case job.EventFinished:
+			st, code := j.Status()
+			action = "job.finish"
+			detail = fmt.Sprintf("job %s: %s, exit %d", j.ID, st, code)
+		default:
+			return
+		}
+		if err := trail.Append(j.Owner, action, detail); err != nil {
+			log.Printf("audit: job %s: %v", j.ID, err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// StartBatch launches the requested jobs in order. Best-effort mode records
+// a per-job error and keeps going; atomic mode stops everything already
+// started and fails the call on the first error.
+func (s *jobServiceServer) StartBatch(ctx context.Context, req *jobworkerv1.StartBatchRequest) (*jobworkerv1.StartBatchResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Jobs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch has no jobs")
+	}
+
+	var started []*job.Job
+	resp := &jobworkerv1.StartBatchResponse{
+		Results: make([]*jobworkerv1.StartBatchResult, len(req.Jobs)),
+	}
+	for i, jr := range req.Jobs {
+		j, err := s.startBatchJob(ctx, identity, jr, req.NodeSelector)
+		if err != nil {
+			if req.Atomic {
+				for _, prev := range started {
+					_ = prev.Stop()
+				}
+				return nil, status.Errorf(status.Code(err), "job %d: %v", i, err)
+			}
+			resp.Results[i] = &jobworkerv1.StartBatchResult{Error: err.Error()}
+			continue
+		}
+		started = append(started, j)
+		resp.Results[i] = &jobworkerv1.StartBatchResult{JobId: j.ID}
+	}
+	return resp, nil
+}
+
+// startBatchJob starts one job of a batch, applying the batch-wide selector
+// when the job has none of its own.
+func (s *jobServiceServer) startBatchJob(ctx context.Context, identity string, req *jobworkerv1.StartJobRequest, batchSelector map[string]string) (*job.Job, error) {
+	if req.Command == "" {
+		return nil, status.Error(codes.InvalidArgument, "command is required")
+	}
+	selector := req.NodeSelector
+	if len(selector) == 0 {
+		selector = batchSelector
+	}
+	if !s.node.matchesSelector(selector) {
+		return nil, status.Error(codes.FailedPrecondition, "this worker does not match the node selector")
+	}
+	if err := s.admission.check(); err != nil {
+		return nil, err
+	}
+	if err := s.maintenance.wait(ctx, identity, auth.RolesFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := s.quota.checkStart(identity); err != nil {
+		return nil, err
+	}
+	if err := s.shares.wait(ctx, identity); err != nil {
+		return nil, err
+	}
+	slotUsed := false
+	defer func() {
+		if !slotUsed {
+			s.shares.release(identity)
+		}
+	}()
+	if err := s.locks.acquire(ctx, identity, req.LockKey, req.LockWait); err != nil {
+		return nil, err
+	}
+	lockHeld := req.LockKey != ""
+	defer func() {
+		if lockHeld && !slotUsed {
+			s.locks.release(identity, req.LockKey)
+		}
+	}()
+	if err := s.groups.wait(ctx, req.ConcurrencyGroup); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !slotUsed {
+			s.groups.release(req.ConcurrencyGroup)
+		}
+	}()
+	spec, err := specFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyLimits(ctx, &spec); err != nil {
+		return nil, err
+	}
+	secretEnv, secretValues, err := s.resolveSecretEnv(req.SecretEnv)
+	if err != nil {
+		return nil, err
+	}
+	spec.Env = append(spec.Env, secretEnv...)
+	spec.Redact = s.redactNow().WithValues(secretValues...)
+	var inputDir string
+	if req.UploadId != "" {
+		if s.uploads == nil {
+			return nil, status.Error(codes.FailedPrecondition, "no workspace root configured; set -workspace-root")
+		}
+		inputDir, err = s.uploads.take(identity, req.UploadId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := allocatePortsFor(req.Ports, &spec); err != nil {
+		return nil, err
+	}
+	if err := s.acquireGPUsFor(ctx, req.Gpus, &spec); err != nil {
+		return nil, err
+	}
+	j, err := s.manager.Start(job.StartRequest{
+		Owner:          identity,
+		Name:           req.Name,
+		IdempotencyKey: req.IdempotencyKey,
+		InputDir:       inputDir,
+		Spec:           spec,
+	})
+	if err != nil {
+		s.releaseGPUsFor(&spec)
+		return nil, err
+	}
+	slotUsed = true
+	s.locks.bind(identity, req.LockKey, j.ID)
+	s.quota.recordStart(identity)
+	return j, nil
+}
@@ -0,0 +1,279 @@
+// Package server wires the job manager into an mTLS-authenticated gRPC
+// server implementing the JobService API.
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// Config holds everything needed to build a Server.
+type Config struct {
+	// Addr is the TCP address to listen on, e.g. ":8443".
+	Addr string
+
+	// HTTPAddr optionally enables the HTTP/JSON gateway on a second
+	// address. The gateway uses the same mutual-TLS requirements and
+	// authorization as the gRPC API. Empty disables it.
+	HTTPAddr string
+
+	// CACertFile, CertFile, and KeyFile configure mutual TLS. All three are
+	// required: clients must present a certificate signed by the CA.
+	CACertFile string
+	CertFile   string
+	KeyFile    string
+
+	// CoordinatorAddr, when set, makes this worker register with a
+	// coordinator and heartbeat; the worker dials out using its own key
+	// pair as the client certificate.
+	CoordinatorAddr string
+	// AdvertiseAddr is the address the coordinator should dial to reach
+	// this worker's JobService; required when CoordinatorAddr is set.
+	AdvertiseAddr string
+	// TunnelAddr, when set, connects to the coordinator's reverse-tunnel
+	// listener instead of requiring the coordinator to dial in; used for
+	// workers behind NAT. The worker's AdvertiseAddr is then implied.
+	TunnelAddr string
+	// WorkerName identifies this worker to the coordinator; defaults to
+	// the hostname.
+	WorkerName string
+	// Labels describe this worker for scheduling, e.g. {"gpu": "true"}.
+	Labels map[string]string
+	// CoordinatorCN, when non-empty, lets connections whose client
+	// certificate carries this Common Name forward an end-user identity in
+	// request metadata. Set it to the coordinator's certificate CN.
+	CoordinatorCN string
+
+	// LogSinkURL optionally forwards all job output to an external sink;
+	// see pkg/logship for supported schemes. Empty disables shipping.
+	LogSinkURL string
+
+	// EventBusURL optionally publishes job events and output batches to a
+	// message bus (nats:// or kafka://); see pkg/eventbus. Empty disables
+	// publication.
+	EventBusURL string
+
+	// JobStoreURL optionally mirrors job metadata into a SQL database
+	// (sqlite: or postgres://); see pkg/jobstore. Empty disables the
+	// store.
+	JobStoreURL string
+
+	// QuotasFile is a JSON file of per-owner consumption quotas (jobs,
+	// CPU seconds, log bytes per accounting window). Empty tracks usage
+	// without enforcing anything.
+	QuotasFile string
+
+	// MaintenanceFile is a JSON file of recurring maintenance windows
+	// during which non-exempt job starts are held. Empty disables
+	// maintenance scheduling.
+	MaintenanceFile string
+
+	// RedactFile is a JSON file of regex redaction rules blanked from
+	// captured output; see LoadRedactRules. Empty redacts only resolved
+	// secret values.
+	RedactFile string
+
+	// SecretsDir roots file: secret references; see pkg/secrets. Empty
+	// disables the file provider (env: and vault: still work).
+	SecretsDir string
+
+	// ExposeIdentity tells each job who launched it: JOB_ID, JOB_OWNER,
+	// and JOB_LABELS environment variables, plus a .job.json metadata
+	// file in per-job workspaces.
+	ExposeIdentity bool
+
+	// DataKey sources the 32-byte AES key (64 hex characters) that
+	// encrypts persisted job logs and artifacts at rest: a file path, or
+	// an env:/vault:/file: secret reference resolved like secret env
+	// values. Empty stores data in the clear.
+	DataKey string
+
+	// AuditFile persists a hash-chained, tamper-evident audit trail of
+	// job lifecycle events to this path; see pkg/audit. Empty disables
+	// the trail.
+	AuditFile string
+
+	// LogRPCs turns on one audit log line per completed RPC, with the
+	// caller, status code, and duration.
+	LogRPCs bool
+
+	// RPCRateLimit caps each caller identity to this many RPCs per
+	// second; zero means unlimited.
+	RPCRateLimit float64
+
+	// MaxRecvMsgSize and MaxSendMsgSize bound gRPC message sizes in bytes.
+	// Zero means the gRPC defaults.
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+
+	// MaxConcurrentStreams caps streams per HTTP/2 connection. Zero means
+	// the gRPC default.
+	MaxConcurrentStreams uint32
+
+	// Keepalive tuning. Long-lived output streams traverse NATs and proxies
+	// that silently drop idle connections, so the server pings clients and
+	// tolerates client pings in return.
+	KeepaliveTime    time.Duration // server ping interval on idle connections
+	KeepaliveTimeout time.Duration // wait for ping ack before closing
+	// KeepaliveMinTime is the minimum interval at which clients may send
+	// keepalive pings; more frequent pings are answered with GOAWAY.
+	KeepaliveMinTime time.Duration
+	// KeepalivePermitWithoutStream allows client pings with no active RPCs.
+	KeepalivePermitWithoutStream bool
+	// MaxConnectionIdle closes connections with no active RPCs after this
+	// duration. Zero disables idle-based closing.
+	MaxConnectionIdle time.Duration
+
+	// TemplatesFile optionally loads named job templates from a JSON file;
+	// see pkg/template. Empty registers no templates.
+	TemplatesFile string
+
+	// OutputSubscriberQueue is the per-subscriber queue depth, in lines,
+	// for output streams. Zero means DefaultOutputSubscriberQueue.
+	OutputSubscriberQueue int
+	// OutputBackpressurePolicy says what to do with a subscriber whose
+	// queue is full: "throttle" (default), "drop", or "disconnect".
+	OutputBackpressurePolicy string
+
+	// IDScheme selects the job ID format: "uuidv7" (default, time-ordered
+	// UUIDs) or "ulid".
+	IDScheme string
+
+	// WorkspaceRoot, when set, runs every job in its own fresh directory
+	// under it. Required for artifact collection.
+	WorkspaceRoot string
+	// ArtifactStoreURL, when set, collects files matching a job's artifact
+	// patterns into this store when the job finishes; see pkg/artifact for
+	// supported schemes. Empty disables artifacts.
+	ArtifactStoreURL string
+
+	// MaxUploadBytes caps the total size of one input upload. Zero means
+	// DefaultMaxUploadBytes; negative disables the cap.
+	MaxUploadBytes int64
+
+	// LimitsFile is a JSON file with default resource limits and per-role
+	// ceilings for start requests; see LimitPolicy. Empty applies no
+	// policy.
+	LimitsFile string
+
+	// GPUs declares the GPU device IDs this worker can allocate to jobs,
+	// e.g. ["0", "1"]. Empty means no GPUs.
+	GPUs []string
+
+	// SharesFile is a JSON file with the fair-share policy: a cap on
+	// concurrently running jobs and per-owner share weights dividing the
+	// slots; see SharePolicy. Empty admits every start immediately.
+	SharesFile string
+
+	// Preemption is the policy for making room when a start queues for
+	// GPUs: "never" (default) queues everything in arrival order;
+	// "lower-priority" lets a higher-priority start gracefully stop and
+	// requeue strictly lower-priority running jobs.
+	Preemption string
+
+	// NotifyFile is a JSON file routing finished-job notifications to
+	// email or Slack per owner; empty sends only per-job targets.
+	NotifyFile string
+
+	// GroupsFile is a JSON file with per-group max parallelism for
+	// concurrency groups; see GroupPolicy. Empty leaves every group at
+	// the default limit of one.
+	GroupsFile string
+
+	// NameCollision says what a named start does when the caller already
+	// has an active job under the name: "reject" (default) fails the
+	// start, "replace" stops the old job first, "suffix" runs the new job
+	// as name-2, name-3, and so on, and "allow" lets duplicates coexist.
+	NameCollision string
+}
+
+// Default keepalive values, chosen to keep long follows alive through
+// typical NAT idle timeouts (commonly 60s-300s).
+const (
+	DefaultKeepaliveTime    = 30 * time.Second
+	DefaultKeepaliveTimeout = 10 * time.Second
+	DefaultKeepaliveMinTime = 10 * time.Second
+)
+
+// DefaultOutputSubscriberQueue is the per-subscriber output queue depth when
+// the config does not say otherwise.
+const DefaultOutputSubscriberQueue = 256
+
+// DefaultMaxUploadBytes caps one input upload when the config does not say
+// otherwise.
+const DefaultMaxUploadBytes = 1 << 30
+
+// CheckAndSetDefaults validates the config and fills in defaults.
+func (c *Config) CheckAndSetDefaults() error {
+	if c.Addr == "" {
+		return fmt.Errorf("listen address is required")
+	}
+	if c.CACertFile == "" || c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("ca-cert, cert, and key are all required for mutual TLS")
+	}
+	if c.CoordinatorAddr != "" && c.AdvertiseAddr == "" && c.TunnelAddr == "" {
+		return fmt.Errorf("advertise-addr is required when joining a coordinator without a tunnel")
+	}
+	if c.KeepaliveTime == 0 {
+		c.KeepaliveTime = DefaultKeepaliveTime
+	}
+	if c.KeepaliveTimeout == 0 {
+		c.KeepaliveTimeout = DefaultKeepaliveTimeout
+	}
+	if c.KeepaliveMinTime == 0 {
+		c.KeepaliveMinTime = DefaultKeepaliveMinTime
+	}
+	if c.OutputSubscriberQueue == 0 {
+		c.OutputSubscriberQueue = DefaultOutputSubscriberQueue
+	}
+	if c.OutputBackpressurePolicy == "" {
+		c.OutputBackpressurePolicy = "throttle"
+	}
+	if _, err := job.ParseSubscriberPolicy(c.OutputBackpressurePolicy); err != nil {
+		return fmt.Errorf("output-backpressure: %w", err)
+	}
+	if c.RPCRateLimit < 0 {
+		return fmt.Errorf("rpc-rate-limit must not be negative")
+	}
+	if c.IDScheme == "" {
+		c.IDScheme = "uuidv7"
+	}
+	if _, err := job.ParseIDGenerator(c.IDScheme); err != nil {
+		return fmt.Errorf("id-scheme: %w", err)
+	}
+	if c.ArtifactStoreURL != "" && c.WorkspaceRoot == "" {
+		return fmt.Errorf("artifact-store requires workspace-root: artifacts are collected from job workspaces")
+	}
+	if c.MaxUploadBytes == 0 {
+		c.MaxUploadBytes = DefaultMaxUploadBytes
+	}
+	return nil
+}
+
+// TLSConfig builds the server's mutual-TLS configuration. TLS 1.3 is the
+// floor: this service runs privileged commands and has no legacy clients.
+func (c *Config) TLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(c.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates parsed from %s", c.CACertFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
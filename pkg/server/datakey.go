@@ -0,0 +1,32 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmantica4/teleport/pkg/crypt"
+	"github.com/pmantica4/teleport/pkg/secrets"
+)
+
+// loadDataKey sources the at-rest encryption key. ref is either a path
+// to a file of 64 hex characters, or an env:/vault:/file: secret
+// reference resolved like secret env values — vault serving as the KMS.
+func loadDataKey(ref, secretsDir string) ([]byte, error) {
+	var material string
+	switch scheme, _, _ := strings.Cut(ref, ":"); scheme {
+	case "env", "vault", "file":
+		v, err := secrets.NewResolver(secretsDir).Resolve(ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolving data key: %w", err)
+		}
+		material = v
+	default:
+		data, err := os.ReadFile(ref)
+		if err != nil {
+			return nil, fmt.Errorf("reading data key: %w", err)
+		}
+		material = string(data)
+	}
+	return crypt.ParseKey(strings.TrimSpace(material))
+}
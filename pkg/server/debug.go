@@ -0,0 +1,116 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/artifact"
+)
+
+const (
+	// debugDefaultDuration is how long a capture runs when the request
+	// does not say; debugMaxDuration caps what it may ask for, since the
+	// attached tool slows the traced process down.
+	debugDefaultDuration = 10 * time.Second
+	debugMaxDuration     = 60 * time.Second
+)
+
+// DebugJob attaches strace or perf to a running job's process for a
+// bounded time and stores the capture as a job artifact.
+func (a *adminServer) DebugJob(ctx context.Context, req *jobworkerv1.DebugJobRequest) (*jobworkerv1.DebugJobResponse, error) {
+	identity, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.svc.artifacts == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no artifact store configured; set -artifact-store")
+	}
+	j, err := a.svc.manager.GetJob(req.JobId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	pid := j.Pid()
+	if pid == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "job is not running")
+	}
+	duration := debugDefaultDuration
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration must not be negative")
+	}
+	if req.DurationSeconds > 0 {
+		duration = time.Duration(req.DurationSeconds) * time.Second
+		if duration > debugMaxDuration {
+			duration = debugMaxDuration
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "jobworker-debug-")
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	stamp := time.Now().Unix()
+	var name string
+	var cmd *exec.Cmd
+	switch req.Tool {
+	case "strace":
+		name = fmt.Sprintf("debug/strace-%d.txt", stamp)
+		cmd = exec.Command("strace", "-f", "-tt", "-p", fmt.Sprint(pid), "-o", filepath.Join(dir, "capture"))
+	case "perf":
+		name = fmt.Sprintf("debug/perf-%d.data", stamp)
+		cmd = exec.Command("perf", "record", "-p", fmt.Sprint(pid), "-o", filepath.Join(dir, "capture"))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tool %q; want strace or perf", req.Tool)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is not installed on the worker", req.Tool)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	log.Printf("admin: %s attached %s to job %s (pid %d) for %s", identity, req.Tool, j.ID, pid, duration)
+	// Both tools detach and finalize their output on SIGINT; the traced
+	// process exiting early ends the capture the same way.
+	timer := time.AfterFunc(duration, func() { _ = cmd.Process.Signal(os.Interrupt) })
+	waitErr := cmd.Wait()
+	timer.Stop()
+
+	// An interrupted tool exits non-zero; judge the capture by whether it
+	// produced anything.
+	f, err := os.Open(filepath.Join(dir, "capture"))
+	if err != nil {
+		if waitErr != nil {
+			return nil, status.Errorf(codes.Internal, "%s failed: %v: %s", req.Tool, waitErr, bytes.TrimSpace(stderr.Bytes()))
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer f.Close()
+
+	size, err := a.svc.artifacts.Put(j.ID, name, f)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	entries, err := a.svc.artifacts.Index(j.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	entries = append(entries, artifact.Entry{Path: name, Size: size})
+	if err := a.svc.artifacts.PutIndex(j.ID, entries); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &jobworkerv1.DebugJobResponse{Artifact: name, SizeBytes: size}, nil
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// SharePolicy is the admin-configured fair-share policy: the worker's
+// running-job slots divide across owners in proportion to their shares,
+// so one owner submitting a flood of jobs cannot starve everyone else.
+type SharePolicy struct {
+	// MaxRunningJobs caps how many jobs run at once on this worker;
+	// starts beyond the cap queue until a slot frees.
+	MaxRunningJobs int `json:"max_running_jobs"`
+	// DefaultShare is the weight for owners without their own entry;
+	// zero means 1.
+	DefaultShare int `json:"default_share"`
+	// Shares maps an owner identity to its weight. An owner with share 2
+	// is entitled to twice the running jobs of an owner with share 1
+	// when both are contending.
+	Shares map[string]int `json:"shares"`
+}
+
+// LoadSharePolicy reads a fair-share policy from a JSON file.
+func LoadSharePolicy(path string) (*SharePolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading share policy: %w", err)
+	}
+	var p SharePolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if p.MaxRunningJobs <= 0 {
+		return nil, fmt.Errorf("share policy needs a positive max_running_jobs")
+	}
+	if p.DefaultShare < 0 {
+		return nil, fmt.Errorf("negative default share")
+	}
+	for owner, share := range p.Shares {
+		if share <= 0 {
+			return nil, fmt.Errorf("share for owner %q must be positive", owner)
+		}
+	}
+	return &p, nil
+}
+
+// shareFor resolves an owner's weight, falling back to the default.
+func (p *SharePolicy) shareFor(owner string) int {
+	if share, ok := p.Shares[owner]; ok {
+		return share
+	}
+	if p.DefaultShare > 0 {
+		return p.DefaultShare
+	}
+	return 1
+}
+
+// fairShare gates job starts by the share policy. Below the running-job
+// cap everything is admitted immediately; at the cap, waiters are
+// admitted by weighted usage — the contending owner with the fewest
+// running jobs per share goes first — rather than arrival order.
+type fairShare struct {
+	policy *SharePolicy
+
+	mu      sync.Mutex
+	running map[string]int
+	waiting map[string]int
+	total   int
+	// wake is closed and replaced whenever a slot frees or a waiter
+	// leaves, so every waiter re-evaluates its turn.
+	wake chan struct{}
+}
+
+// newFairShare returns nil for a nil policy; all methods are safe on a
+// nil receiver, which is how a worker without a share policy runs.
+func newFairShare(policy *SharePolicy) *fairShare {
+	if policy == nil {
+		return nil
+	}
+	return &fairShare{
+		policy:  policy,
+		running: make(map[string]int),
+		waiting: make(map[string]int),
+		wake:    make(chan struct{}),
+	}
+}
+
+// wait blocks until the owner is entitled to a running-job slot or ctx is
+// done. Every admission must be paired with a release, either explicit or
+// through the finish listener.
+func (f *fairShare) wait(ctx context.Context, owner string) error {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	f.waiting[owner]++
+	for {
+		if f.turnLocked(owner) {
+			f.leaveLocked(owner)
+			f.running[owner]++
+			f.total++
+			// Other waiters may still fit under the cap.
+			f.wakeLocked()
+			f.mu.Unlock()
+			return nil
+		}
+		wake := f.wake
+		f.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			f.mu.Lock()
+			f.leaveLocked(owner)
+			f.wakeLocked()
+			f.mu.Unlock()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		f.mu.Lock()
+	}
+}
+
+// turnLocked reports whether the owner may take a slot now: one is free
+// and no other waiting owner has lower weighted usage.
+func (f *fairShare) turnLocked(owner string) bool {
+	if f.total >= f.policy.MaxRunningJobs {
+		return false
+	}
+	mine := float64(f.running[owner]) / float64(f.policy.shareFor(owner))
+	for other := range f.waiting {
+		if other == owner {
+			continue
+		}
+		if float64(f.running[other])/float64(f.policy.shareFor(other)) < mine {
+			return false
+		}
+	}
+	return true
+}
+
+// leaveLocked removes one waiter for the owner.
+func (f *fairShare) leaveLocked(owner string) {
+	if f.waiting[owner]--; f.waiting[owner] <= 0 {
+		delete(f.waiting, owner)
+	}
+}
+
+// wakeLocked broadcasts to every waiter.
+func (f *fairShare) wakeLocked() {
+	close(f.wake)
+	f.wake = make(chan struct{})
+}
+
+// release gives an owner's slot back and wakes the queue.
+func (f *fairShare) release(owner string) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	if f.running[owner]--; f.running[owner] <= 0 {
+		delete(f.running, owner)
+	}
+	f.total--
+	f.wakeLocked()
+	f.mu.Unlock()
+}
+
+// listener returns a job.Listener that releases a job's slot when it
+// finishes.
+func (f *fairShare) listener() job.Listener {
+	return func(e job.Event) {
+		if e.Type == job.EventFinished {
+			f.release(e.Job.Owner)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+)
+
+// gateway is a hand-written HTTP/JSON front end over the same service
+// implementation the gRPC server uses, so authn/authz behave identically.
+// Routes:
+//
+//	POST /v1/jobs                     start a job
+//	GET  /v1/jobs                     list jobs
+//	GET  /v1/jobs/{id}                job status
+//	POST /v1/jobs/{id}/stop           stop a job
+//	GET  /v1/jobs/{id}/output         captured output
+//	GET  /v1/jobs/{id}/output?follow=1  server-sent events stream
+type gateway struct {
+	svc *jobServiceServer
+}
+
+// httpIdentity authenticates the request from its verified client
+// certificate and returns a context carrying the identity, mirroring the
+// gRPC auth interceptors.
+func httpIdentity(r *http.Request) (context.Context, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+	if cn == "" {
+		return nil, status.Error(codes.Unauthenticated, "client certificate has no common name")
+	}
+	return auth.ContextWithIdentity(r.Context(), cn), nil
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, err := httpIdentity(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	rest, ok := strings.CutPrefix(r.URL.Path, "/v1/jobs")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	rest = strings.Trim(rest, "/")
+
+	switch {
+	case rest == "" && r.Method == http.MethodPost:
+		g.startJob(ctx, w, r)
+	case rest == "" && r.Method == http.MethodGet:
+		g.listJobs(ctx, w)
+	default:
+		parts := strings.Split(rest, "/")
+		id := parts[0]
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			g.jobStatus(ctx, w, id)
+		case len(parts) == 2 && parts[1] == "stop" && r.Method == http.MethodPost:
+			g.stopJob(ctx, w, id)
+		case len(parts) == 2 && parts[1] == "output" && r.Method == http.MethodGet:
+			g.output(ctx, w, r, id)
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+type startJobRequest struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+func (g *gateway) startJob(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req startJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	resp, err := g.svc.StartJob(ctx, &jobworkerv1.StartJobRequest{Command: req.Command, Args: req.Args})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, map[string]string{"job_id": resp.JobId})
+}
+
+func (g *gateway) stopJob(ctx context.Context, w http.ResponseWriter, id string) {
+	if _, err := g.svc.StopJob(ctx, &jobworkerv1.StopJobRequest{JobId: id}); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, map[string]string{})
+}
+
+func (g *gateway) jobStatus(ctx context.Context, w http.ResponseWriter, id string) {
+	resp, err := g.svc.GetJobStatus(ctx, &jobworkerv1.GetJobStatusRequest{JobId: id})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, jobJSON(resp.Job))
+}
+
+func (g *gateway) listJobs(ctx context.Context, w http.ResponseWriter) {
+	resp, err := g.svc.ListJobs(ctx, &jobworkerv1.ListJobsRequest{})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	jobs := make([]any, 0, len(resp.Jobs))
+	for _, j := range resp.Jobs {
+		jobs = append(jobs, jobJSON(j))
+	}
+	writeJSON(w, map[string]any{"jobs": jobs})
+}
+
+func (g *gateway) output(ctx context.Context, w http.ResponseWriter, r *http.Request, id string) {
+	if r.URL.Query().Get("follow") == "" {
+		tail, _ := strconv.ParseInt(r.URL.Query().Get("tail"), 10, 64)
+		resp, err := g.svc.GetOutput(ctx, &jobworkerv1.GetOutputRequest{JobId: id, TailLines: tail})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, map[string]any{"lines": resp.Lines})
+		return
+	}
+	g.followOutput(ctx, w, id)
+}
+
+// followOutput streams output lines as server-sent events, one event per
+// line, until the job finishes or the client disconnects.
+func (g *gateway) followOutput(ctx context.Context, w http.ResponseWriter, id string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	j, err := g.svc.authorizedJob(ctx, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	buf := j.Output()
+	offset := 0
+	for {
+		wait := buf.Wait()
+		lines, next, closed := buf.LinesSince(offset)
+		offset = next
+		for _, line := range lines {
+			fmt.Fprintf(w, "data: %s\n\n", line)
+		}
+		if len(lines) > 0 {
+			flusher.Flush()
+			continue
+		}
+		if closed {
+			return
+		}
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func jobJSON(j *jobworkerv1.Job) map[string]any {
+	out := map[string]any{
+		"id":         j.Id,
+		"owner":      j.Owner,
+		"command":    j.Command,
+		"args":       j.Args,
+		"status":     strings.TrimPrefix(j.Status.String(), "JOB_STATUS_"),
+		"started_at": j.StartedAtUnix,
+	}
+	if j.EndedAtUnix != 0 {
+		out["ended_at"] = j.EndedAtUnix
+		out["exit_code"] = j.ExitCode
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError maps a gRPC status error onto the closest HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	var code int
+	switch st.Code() {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.FailedPrecondition:
+		code = http.StatusConflict
+	default:
+		code = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": st.Message()})
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// gpuAllocator hands the worker's GPU devices out to jobs. Devices are
+// admin-declared IDs (typically "0", "1", ...); a job gets exclusive use of
+// its devices via CUDA_VISIBLE_DEVICES until it finishes. Requests that
+// cannot be satisfied yet queue until devices free up.
+type gpuAllocator struct {
+	total int
+
+	mu   sync.Mutex
+	free []string
+	// wait is closed and replaced whenever devices are released, waking
+	// every queued acquire.
+	wait chan struct{}
+}
+
+func newGPUAllocator(devices []string) *gpuAllocator {
+	return &gpuAllocator{
+		total: len(devices),
+		free:  append([]string(nil), devices...),
+		wait:  make(chan struct{}),
+	}
+}
+
+// acquire takes n devices, blocking until enough are free or ctx is done.
+func (a *gpuAllocator) acquire(ctx context.Context, n int) ([]string, error) {
+	if n > a.total {
+		return nil, status.Errorf(codes.InvalidArgument, "requested %d GPUs; this worker has %d", n, a.total)
+	}
+	for {
+		a.mu.Lock()
+		if len(a.free) >= n {
+			devices := append([]string(nil), a.free[:n]...)
+			a.free = a.free[n:]
+			a.mu.Unlock()
+			return devices, nil
+		}
+		wait := a.wait
+		a.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// release returns devices to the pool and wakes queued acquires.
+func (a *gpuAllocator) release(devices []string) {
+	if len(devices) == 0 {
+		return
+	}
+	a.mu.Lock()
+	a.free = append(a.free, devices...)
+	close(a.wait)
+	a.wait = make(chan struct{})
+	a.mu.Unlock()
+}
+
+// counts reports total and currently free devices.
+func (a *gpuAllocator) counts() (total, free int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.total, len(a.free)
+}
+
+// acquireGPUsFor reserves n devices for a start request, queueing until
+// they are free, and applies them to spec: the allocation is recorded so
+// it can be released, and the process sees it as CUDA_VISIBLE_DEVICES.
+func (s *jobServiceServer) acquireGPUsFor(ctx context.Context, n int32, spec *job.Spec) error {
+	if n == 0 {
+		return nil
+	}
+	if n < 0 {
+		return status.Error(codes.InvalidArgument, "gpus must be non-negative")
+	}
+	if s.gpus == nil {
+		return status.Error(codes.FailedPrecondition, "no GPUs configured on this worker; set -gpus")
+	}
+	devices, err := s.gpus.acquire(ctx, int(n))
+	if err != nil {
+		return err
+	}
+	spec.GPUs = devices
+	spec.Env = append(spec.Env, "CUDA_VISIBLE_DEVICES="+strings.Join(devices, ","))
+	return nil
+}
+
+// releaseGPUsFor gives spec's devices back after a start that never made it
+// to a running job.
+func (s *jobServiceServer) releaseGPUsFor(spec *job.Spec) {
+	if s.gpus != nil {
+		s.gpus.release(spec.GPUs)
+	}
+}
+
+// releaseGPUs returns a listener that gives a job's devices back to the
+// pool when it finishes.
+func releaseGPUs(alloc *gpuAllocator) job.Listener {
+	return func(e job.Event) {
+		if e.Type == job.EventFinished {
+			alloc.release(e.Job.Spec.GPUs)
+		}
+	}
+}
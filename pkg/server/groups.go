@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// Per-group gauges at /debug/vars, so queue depth is visible without
+// reading logs: how many jobs of each group are running and how many
+// starts are waiting for a slot.
+var (
+	groupRunning = expvar.NewMap("concurrency_group_running")
+	groupWaiting = expvar.NewMap("concurrency_group_waiting")
+)
+
+// GroupPolicy is the admin-configured max parallelism per concurrency
+// group. A group not listed runs DefaultLimit jobs at once (one when
+// unset), so an unconfigured group behaves like a lock key.
+type GroupPolicy struct {
+	DefaultLimit int            `json:"default_limit"`
+	Groups       map[string]int `json:"groups"`
+}
+
+// LoadGroupPolicy reads a concurrency-group policy from a JSON file.
+func LoadGroupPolicy(path string) (*GroupPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading group policy: %w", err)
+	}
+	var p GroupPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if p.DefaultLimit < 0 {
+		return nil, fmt.Errorf("negative default limit")
+	}
+	for group, limit := range p.Groups {
+		if limit <= 0 {
+			return nil, fmt.Errorf("limit for group %q must be positive", group)
+		}
+	}
+	return &p, nil
+}
+
+// limitFor resolves a group's max parallelism.
+func (p *GroupPolicy) limitFor(group string) int {
+	if p != nil {
+		if limit, ok := p.Groups[group]; ok {
+			return limit
+		}
+		if p.DefaultLimit > 0 {
+			return p.DefaultLimit
+		}
+	}
+	return 1
+}
+
+// concurrency gates starts by group: jobs tagged with the same
+// concurrency group run at most the group's limit at once, worker-wide.
+// It generalizes lock keys — a group with limit one is a shared
+// singleton — except that group starts always queue rather than fail.
+type concurrency struct {
+	policy *GroupPolicy
+
+	mu      sync.Mutex
+	running map[string]int
+	// wake is closed and replaced whenever a slot frees, so every queued
+	// start re-checks its group.
+	wake chan struct{}
+}
+
+func newConcurrency(policy *GroupPolicy) *concurrency {
+	return &concurrency{
+		policy:  policy,
+		running: make(map[string]int),
+		wake:    make(chan struct{}),
+	}
+}
+
+// wait blocks until the group has a free slot or ctx is done. Every
+// admission must be paired with a release, either explicit or through
+// the finish listener.
+func (c *concurrency) wait(ctx context.Context, group string) error {
+	if group == "" {
+		return nil
+	}
+	c.mu.Lock()
+	waiting := false
+	for {
+		if c.running[group] < c.policy.limitFor(group) {
+			c.running[group]++
+			c.mu.Unlock()
+			if waiting {
+				groupWaiting.Add(group, -1)
+			}
+			groupRunning.Add(group, 1)
+			return nil
+		}
+		wake := c.wake
+		c.mu.Unlock()
+		if !waiting {
+			waiting = true
+			groupWaiting.Add(group, 1)
+		}
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			groupWaiting.Add(group, -1)
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		c.mu.Lock()
+	}
+}
+
+// release frees a group slot and wakes queued starts.
+func (c *concurrency) release(group string) {
+	if group == "" {
+		return
+	}
+	c.mu.Lock()
+	if c.running[group] > 0 {
+		c.running[group]--
+	}
+	close(c.wake)
+	c.wake = make(chan struct{})
+	c.mu.Unlock()
+	groupRunning.Add(group, -1)
+}
+
+// listener frees a job's group slot when it finishes.
+func (c *concurrency) listener() job.Listener {
+	return func(e job.Event) {
+		if e.Type == job.EventFinished && e.Job.Spec.ConcurrencyGroup != "" {
+			c.release(e.Job.Spec.ConcurrencyGroup)
+		}
+	}
+}
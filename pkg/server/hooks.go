@@ -0,0 +1,47 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// runPostJobHooks is the manager listener launching a job's on-success or
+// on-failure command once it finishes. The hook runs as a tracked child job
+// of the same owner, linked back through Spec.ParentID, with the parent's
+// identity and outcome in its environment. Hook jobs carry no hooks of
+// their own, so a failing hook cannot cascade.
+func runPostJobHooks(m *job.Manager) job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventFinished {
+			return
+		}
+		parent := ev.Job
+		st, exitCode := parent.Status()
+		var hook string
+		switch st {
+		case job.StatusCompleted:
+			hook = parent.Spec.OnSuccess
+		case job.StatusFailed, job.StatusOutputLimit, job.StatusCPUBudget, job.StatusOOM:
+			hook = parent.Spec.OnFailure
+		}
+		if hook == "" {
+			return
+		}
+		spec := job.Spec{
+			Command: "sh",
+			Args:    []string{"-c", hook},
+			Env: []string{
+				"PARENT_JOB_ID=" + parent.ID,
+				"PARENT_JOB_STATUS=" + st.String(),
+				fmt.Sprintf("PARENT_JOB_EXIT_CODE=%d", exitCode),
+			},
+			Redact:   parent.Spec.Redact,
+			ParentID: parent.ID,
+		}
+		if _, err := m.StartJob(parent.Owner, spec); err != nil {
+			log.Printf("post-job hook for %s failed to start: %v", parent.ID, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/jobstore"
+)
+
+// recordJobs returns the listener that mirrors job metadata into the SQL
+// job store: a row at start, updated again when the job finishes. Writes
+// are best effort and logged; the store must never block job management.
+func recordJobs(store *jobstore.Store) job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventStarted && ev.Type != job.EventFinished {
+			return
+		}
+		j := ev.Job
+		st, code := j.Status()
+		rec := jobstore.Record{
+			ID:        j.ID,
+			Owner:     j.Owner,
+			Name:      j.Name,
+			Command:   j.Spec.Command,
+			Args:      j.Spec.Args,
+			Labels:    j.Spec.Labels,
+			Status:    st.String(),
+			ExitCode:  code,
+			Restarts:  j.Restarts(),
+			StartedAt: j.StartedAt(),
+			EndedAt:   j.EndedAt(),
+		}
+		if err := store.Put(context.Background(), rec); err != nil {
+			log.Printf("jobstore: job %s: %v", j.ID, err)
+		}
+	}
+}
+
+// ListJobHistory serves the caller's jobs out of the SQL job store, so
+// runs mirrored there stay reachable after the in-memory table is gone —
+// in particular across worker restarts. Filtering is pushed down to the
+// database.
+func (s *jobServiceServer) ListJobHistory(ctx context.Context, req *jobworkerv1.ListJobHistoryRequest) (*jobworkerv1.ListJobHistoryResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.jobStore == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no job store configured; start the worker with -job-store")
+	}
+	f := jobstore.Filter{
+		Owner:  identity,
+		Status: req.Status,
+		Labels: req.Labels,
+		Limit:  int(req.Limit),
+	}
+	if req.SinceUnix != 0 {
+		f.Since = time.Unix(req.SinceUnix, 0)
+	}
+	if req.UntilUnix != 0 {
+		f.Until = time.Unix(req.UntilUnix, 0)
+	}
+	recs, err := s.jobStore.List(ctx, f)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "querying job store: %v", err)
+	}
+	resp := &jobworkerv1.ListJobHistoryResponse{}
+	for _, r := range recs {
+		resp.Jobs = append(resp.Jobs, recordToProto(r))
+	}
+	return resp, nil
+}
+
+// recordToProto converts a stored record to its wire form.
+func recordToProto(r jobstore.Record) *jobworkerv1.JobHistoryEntry {
+	e := &jobworkerv1.JobHistoryEntry{
+		Id:            r.ID,
+		Owner:         r.Owner,
+		Name:          r.Name,
+		Command:       r.Command,
+		Args:          r.Args,
+		Status:        r.Status,
+		ExitCode:      int32(r.ExitCode),
+		Restarts:      int32(r.Restarts),
+		StartedAtUnix: r.StartedAt.Unix(),
+		Labels:        r.Labels,
+	}
+	if !r.EndedAt.IsZero() {
+		e.EndedAtUnix = r.EndedAt.Unix()
+	}
+	return e
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// Limits are the resource-limit values the limit policy governs. Zero
+// means unlimited as a default and "no ceiling" as a ceiling.
+type Limits struct {
+	CPUBudgetSeconds int64 `json:"cpu_budget_seconds"`
+	MaxOutputBytes   int64 `json:"max_output_bytes"`
+}
+
+// LimitPolicy is the admin-configured resource limit policy: defaults for
+// start requests that leave a limit unset, and per-role ceilings on what a
+// request may ask for. Roles come from the client certificate's
+// organizational units.
+type LimitPolicy struct {
+	// Defaults fill in limits a start request leaves at zero.
+	Defaults Limits `json:"defaults"`
+	// Ceilings cap what each role may request. The "*" entry applies to
+	// callers with none of the listed roles. A caller with several listed
+	// roles gets the most permissive ceiling per field.
+	Ceilings map[string]Limits `json:"ceilings"`
+	// Clamp reduces over-ceiling requests to the ceiling instead of
+	// rejecting them.
+	Clamp bool `json:"clamp"`
+}
+
+// LoadLimitPolicy reads a limit policy from a JSON file.
+func LoadLimitPolicy(path string) (*LimitPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading limit policy: %w", err)
+	}
+	var p LimitPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for role, l := range p.Ceilings {
+		if l.CPUBudgetSeconds < 0 || l.MaxOutputBytes < 0 {
+			return nil, fmt.Errorf("negative ceiling for role %q", role)
+		}
+	}
+	if p.Defaults.CPUBudgetSeconds < 0 || p.Defaults.MaxOutputBytes < 0 {
+		return nil, fmt.Errorf("negative default limit")
+	}
+	return &p, nil
+}
+
+// apply fills in defaults and enforces the caller's ceiling on spec,
+// leaving the effective values in place. A nil policy allows everything.
+func (p *LimitPolicy) apply(spec *job.Spec, roles []string) error {
+	if p == nil {
+		return nil
+	}
+	if spec.CPUBudget == 0 && p.Defaults.CPUBudgetSeconds > 0 {
+		spec.CPUBudget = time.Duration(p.Defaults.CPUBudgetSeconds) * time.Second
+	}
+	if spec.MaxOutputBytes == 0 && p.Defaults.MaxOutputBytes > 0 {
+		spec.MaxOutputBytes = p.Defaults.MaxOutputBytes
+	}
+	ceiling, ok := p.ceilingFor(roles)
+	if !ok {
+		return nil
+	}
+	if ceiling.CPUBudgetSeconds > 0 {
+		max := time.Duration(ceiling.CPUBudgetSeconds) * time.Second
+		if spec.CPUBudget == 0 || spec.CPUBudget > max {
+			if !p.Clamp {
+				return status.Errorf(codes.InvalidArgument, "cpu budget %s exceeds the %s ceiling for your role", spec.CPUBudget, max)
+			}
+			spec.CPUBudget = max
+		}
+	}
+	if ceiling.MaxOutputBytes > 0 {
+		if spec.MaxOutputBytes == 0 || spec.MaxOutputBytes > ceiling.MaxOutputBytes {
+			if !p.Clamp {
+				return status.Errorf(codes.InvalidArgument, "output cap %d exceeds the %d byte ceiling for your role", spec.MaxOutputBytes, ceiling.MaxOutputBytes)
+			}
+			spec.MaxOutputBytes = ceiling.MaxOutputBytes
+		}
+	}
+	return nil
+}
+
+// ceilingFor resolves the ceiling for a caller's roles: per field, the most
+// permissive value across matched roles, where zero means no ceiling at
+// all. Callers matching no listed role fall back to the "*" entry.
+func (p *LimitPolicy) ceilingFor(roles []string) (Limits, bool) {
+	var matched []Limits
+	for _, role := range roles {
+		if l, ok := p.Ceilings[role]; ok {
+			matched = append(matched, l)
+		}
+	}
+	if len(matched) == 0 {
+		l, ok := p.Ceilings["*"]
+		return l, ok
+	}
+	out := matched[0]
+	for _, l := range matched[1:] {
+		out.CPUBudgetSeconds = loosest(out.CPUBudgetSeconds, l.CPUBudgetSeconds)
+		out.MaxOutputBytes = loosest(out.MaxOutputBytes, l.MaxOutputBytes)
+	}
+	return out, true
+}
+
+// loosest picks the more permissive of two ceiling values, where zero is
+// "no ceiling".
+func loosest(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// applyLimits runs the server's limit policy against spec for the caller
+// in ctx.
+func (s *jobServiceServer) applyLimits(ctx context.Context, spec *job.Spec) error {
+	return s.limitsNow().apply(spec, auth.RolesFromContext(ctx))
+}
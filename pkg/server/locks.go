@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// lockTable enforces per-request mutual exclusion: a start may declare a
+// lock key, and at most one running job of an owner's holds a given key
+// at a time. Keys are scoped to the owner, like names and quotas, so
+// callers cannot contend with — or probe — each other's locks.
+type lockTable struct {
+	mu sync.Mutex
+	// holders maps owner and key to the job holding the lock; the entry
+	// is empty between acquisition and the job ID being bound.
+	holders map[string]string
+	// wake is closed and replaced whenever a key frees, so every queued
+	// start re-checks its key.
+	wake chan struct{}
+}
+
+func newLockTable() *lockTable {
+	return &lockTable{
+		holders: make(map[string]string),
+		wake:    make(chan struct{}),
+	}
+}
+
+// lockName scopes a key to its owner.
+func lockName(owner, key string) string {
+	return owner + "\x00" + key
+}
+
+// acquire claims the key for a start. A held key fails the start
+// immediately unless wait is set, in which case the caller queues until
+// the key frees or ctx is done. Every acquisition must be paired with a
+// release, either explicit or through the finish listener once bound to
+// a job.
+func (t *lockTable) acquire(ctx context.Context, owner, key string, wait bool) error {
+	if key == "" {
+		return nil
+	}
+	t.mu.Lock()
+	for {
+		holder, held := t.holders[lockName(owner, key)]
+		if !held {
+			t.holders[lockName(owner, key)] = ""
+			t.mu.Unlock()
+			return nil
+		}
+		if !wait {
+			t.mu.Unlock()
+			if holder == "" {
+				return status.Errorf(codes.FailedPrecondition, "lock key %q is held by a starting job; pass lock_wait to queue", key)
+			}
+			return status.Errorf(codes.FailedPrecondition, "lock key %q is held by job %s; pass lock_wait to queue", key, holder)
+		}
+		wake := t.wake
+		t.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		t.mu.Lock()
+	}
+}
+
+// bind records which job holds the key, so status messages can name it
+// and the finish listener can release it.
+func (t *lockTable) bind(owner, key, jobID string) {
+	if key == "" {
+		return
+	}
+	t.mu.Lock()
+	t.holders[lockName(owner, key)] = jobID
+	t.mu.Unlock()
+}
+
+// release frees the key and wakes queued starts.
+func (t *lockTable) release(owner, key string) {
+	if key == "" {
+		return
+	}
+	t.mu.Lock()
+	delete(t.holders, lockName(owner, key))
+	close(t.wake)
+	t.wake = make(chan struct{})
+	t.mu.Unlock()
+}
+
+// listener releases a job's lock key when it finishes.
+func (t *lockTable) listener() job.Listener {
+	return func(e job.Event) {
+		if e.Type == job.EventFinished && e.Job.Spec.LockKey != "" {
+			t.release(e.Job.Owner, e.Job.Spec.LockKey)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+)
+
+// weekdays maps the day abbreviations the maintenance policy file uses.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday,
+	"wed": time.Wednesday, "thu": time.Thursday, "fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// MaintenanceWindow is one recurring window during which job starts are
+// held. Times are "HH:MM" in UTC; a window may wrap past midnight.
+type MaintenanceWindow struct {
+	// Days the window recurs on ("mon".."sun"); empty means every day.
+	Days   []string `json:"days"`
+	Start  string   `json:"start"`
+	End    string   `json:"end"`
+	Reason string   `json:"reason"`
+}
+
+// MaintenancePolicy is the admin-configured maintenance schedule. During
+// a window, non-exempt starts are held until the window closes rather
+// than rejected; running jobs are never touched.
+type MaintenancePolicy struct {
+	Windows []MaintenanceWindow `json:"windows"`
+	// Exempt lists roles whose jobs run even during a window, for
+	// emergency fixes.
+	Exempt []string `json:"exempt"`
+}
+
+// LoadMaintenancePolicy reads a maintenance policy from a JSON file.
+func LoadMaintenancePolicy(path string) (*MaintenancePolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading maintenance policy: %w", err)
+	}
+	var p MaintenancePolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i, w := range p.Windows {
+		if _, err := parseClock(w.Start); err != nil {
+			return nil, fmt.Errorf("window %d: %w", i, err)
+		}
+		if _, err := parseClock(w.End); err != nil {
+			return nil, fmt.Errorf("window %d: %w", i, err)
+		}
+		for _, d := range w.Days {
+			if _, ok := weekdays[strings.ToLower(d)]; !ok {
+				return nil, fmt.Errorf("window %d: unknown day %q; want mon..sun", i, d)
+			}
+		}
+	}
+	return &p, nil
+}
+
+// parseClock converts "HH:MM" to minutes since midnight.
+func parseClock(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("bad clock time %q; want HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+// onDay reports whether the window recurs on the given weekday.
+func (w MaintenanceWindow) onDay(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if weekdays[strings.ToLower(d)] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// activeAt reports whether now (UTC) falls inside the window and, if so,
+// when the window closes.
+func (w MaintenanceWindow) activeAt(now time.Time) (time.Time, bool) {
+	now = now.UTC()
+	start, _ := parseClock(w.Start)
+	end, _ := parseClock(w.End)
+	minutes := now.Hour()*60 + now.Minute()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if start <= end {
+		if w.onDay(now.Weekday()) && minutes >= start && minutes < end {
+			return midnight.Add(time.Duration(end) * time.Minute), true
+		}
+		return time.Time{}, false
+	}
+	// The window wraps past midnight: either today's portion after start,
+	// or the tail of yesterday's window before end.
+	if w.onDay(now.Weekday()) && minutes >= start {
+		return midnight.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute), true
+	}
+	if w.onDay(now.AddDate(0, 0, -1).Weekday()) && minutes < end {
+		return midnight.Add(time.Duration(end) * time.Minute), true
+	}
+	return time.Time{}, false
+}
+
+// maintenanceState holds the current policy; ReloadConfig swaps it.
+type maintenanceState struct {
+	mu     sync.RWMutex
+	policy *MaintenancePolicy
+}
+
+func (m *maintenanceState) setPolicy(p *MaintenancePolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.policy = p
+}
+
+// active returns the currently open window, if any, and when it closes.
+func (m *maintenanceState) active(now time.Time) (MaintenanceWindow, time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.policy == nil {
+		return MaintenanceWindow{}, time.Time{}, false
+	}
+	for _, w := range m.policy.Windows {
+		if until, ok := w.activeAt(now); ok {
+			return w, until, true
+		}
+	}
+	return MaintenanceWindow{}, time.Time{}, false
+}
+
+// exempt reports whether any of the caller's roles is maintenance-exempt.
+func (m *maintenanceState) exempt(roles []string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.policy == nil {
+		return false
+	}
+	for _, role := range roles {
+		for _, e := range m.policy.Exempt {
+			if role == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wait holds a non-exempt start until any open maintenance window closes.
+// It mirrors how GPU starts queue: the RPC blocks, and cancelling the
+// call abandons the start. A caller whose deadline cannot outlast the
+// window fails immediately with the hold reason, rather than timing out
+// without ever learning why.
+func (m *maintenanceState) wait(ctx context.Context, identity string, roles []string) error {
+	logged := false
+	for {
+		w, until, ok := m.active(time.Now())
+		if !ok || m.exempt(roles) {
+			return nil
+		}
+		msg := "start held for maintenance until " + until.Format(time.RFC3339)
+		if w.Reason != "" {
+			msg += ": " + w.Reason
+		}
+		if dl, ok := ctx.Deadline(); ok && dl.Before(until) {
+			return status.Error(codes.Unavailable, msg)
+		}
+		if !logged {
+			log.Printf("start by %s held for maintenance until %s: %s",
+				identity, until.Format(time.RFC3339), w.Reason)
+			logged = true
+		}
+		select {
+		case <-time.After(time.Until(until)):
+			// Re-check: another window may have opened, or the policy
+			// changed while we slept.
+		case <-ctx.Done():
+			return status.Error(codes.Unavailable, msg)
+		}
+	}
+}
+
+// GetMaintenance reports whether a maintenance window is currently open;
+// starts are held while one is.
+func (s *jobServiceServer) GetMaintenance(ctx context.Context, _ *jobworkerv1.GetMaintenanceRequest) (*jobworkerv1.GetMaintenanceResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	w, until, ok := s.maintenance.active(time.Now())
+	if !ok {
+		return &jobworkerv1.GetMaintenanceResponse{}, nil
+	}
+	return &jobworkerv1.GetMaintenanceResponse{
+		Active:    true,
+		Reason:    w.Reason,
+		UntilUnix: until.Unix(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+// GetJobManifest returns the job's reproducibility record, with the
+// worker's build identity filled in server-side.
+func (s *jobServiceServer) GetJobManifest(ctx context.Context, req *jobworkerv1.GetJobManifestRequest) (*jobworkerv1.GetJobManifestResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	m := j.Manifest()
+	pb := &jobworkerv1.JobManifest{
+		JobId:            j.ID,
+		Command:          m.Command,
+		Args:             m.Args,
+		Dir:              m.Dir,
+		Env:              m.Env,
+		Hostname:         m.Hostname,
+		Kernel:           m.Kernel,
+		ServerVersion:    version.Version,
+		GoVersion:        runtime.Version(),
+		Nice:             int32(m.Nice),
+		IoClass:          m.IOClass,
+		CoreLimitBytes:   m.CoreLimit,
+		CpuBudgetSeconds: int64(m.CPUBudget / time.Second),
+		StartedAtUnix:    m.StartedAt.Unix(),
+	}
+	for _, c := range m.CPUAffinity {
+		pb.CpuAffinity = append(pb.CpuAffinity, int32(c))
+	}
+	return &jobworkerv1.GetJobManifestResponse{Manifest: pb}, nil
+}
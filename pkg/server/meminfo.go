@@ -0,0 +1,37 @@
+package server
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// memInfo reads total and available memory from /proc/meminfo, in bytes.
+// Both are zero when the file cannot be read; capacity reporting is best
+// effort.
+func memInfo() (total, available int64) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, 0
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		name, rest, ok := strings.Cut(sc.Text(), ":")
+		if !ok {
+			continue
+		}
+		kb, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(rest), " kB"), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch name {
+		case "MemTotal":
+			total = kb << 10
+		case "MemAvailable":
+			available = kb << 10
+		}
+	}
+	return total, available
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/notify"
+)
+
+// notifyLogTail is how many trailing output lines a notification carries.
+const notifyLogTail = 5
+
+// NotifyPolicy is the admin-configured standing notification routing: for
+// each owner, the targets told whenever one of their jobs finishes. Jobs
+// can add their own targets per start on top.
+type NotifyPolicy struct {
+	// Template overrides the default message body; the dot is a
+	// notify.Event.
+	Template string `json:"template"`
+	// Owners maps an owner to target URLs, e.g.
+	// "slack://hooks.slack.com/services/T0/B0/XX" or
+	// "smtp://relay:25/oncall@example.com".
+	Owners map[string][]string `json:"owners"`
+}
+
+// LoadNotifyPolicy reads a notification policy from a JSON file and
+// validates its targets and template.
+func LoadNotifyPolicy(path string) (*NotifyPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading notify policy: %w", err)
+	}
+	var p NotifyPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if _, err := notify.Render(p.Template, notify.Event{}); err != nil {
+		return nil, err
+	}
+	for owner, targets := range p.Owners {
+		for _, t := range targets {
+			if _, err := notify.ParseTarget(t); err != nil {
+				return nil, fmt.Errorf("owner %q: %w", owner, err)
+			}
+		}
+	}
+	return &p, nil
+}
+
+// sendNotifications is the manager listener behind the first-class
+// notifiers: when a job finishes it renders one message and delivers it to
+// the owner's standing targets plus any the start requested. policy may be
+// nil, leaving only per-job targets.
+func sendNotifications(policy *NotifyPolicy) job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventFinished {
+			return
+		}
+		j := ev.Job
+		targets := j.Spec.Notify
+		var tmpl string
+		if policy != nil {
+			targets = append(append([]string(nil), targets...), policy.Owners[j.Owner]...)
+			tmpl = policy.Template
+		}
+		if len(targets) == 0 {
+			return
+		}
+		body, err := notify.Render(tmpl, notifyEvent(j))
+		if err != nil {
+			log.Printf("notify: rendering message for job %s: %v", j.ID, err)
+			return
+		}
+		subject := notify.Subject(notifyEvent(j))
+		for _, t := range targets {
+			target, err := notify.ParseTarget(t)
+			if err != nil {
+				// Targets were validated at load or start time; a bad one
+				// here means the policy file changed underneath us.
+				log.Printf("notify: %v", err)
+				continue
+			}
+			go func() {
+				if err := target.Send(subject, body); err != nil {
+					log.Printf("notify: sending to %s for job %s: %v", target, j.ID, err)
+				}
+			}()
+		}
+	}
+}
+
+// notifyEvent flattens a finished job into the template's view of it.
+func notifyEvent(j *job.Job) notify.Event {
+	st, exitCode := j.Status()
+	status := st.String()
+	if label := j.StatusLabel(); label != "" {
+		status += ":" + label
+	}
+	var tail []string
+	lines := j.Output().ReadAll()
+	if n := len(lines); n > notifyLogTail {
+		lines = lines[n-notifyLogTail:]
+	}
+	for _, l := range lines {
+		tail = append(tail, l.Text)
+	}
+	return notify.Event{
+		JobID:    j.ID,
+		Name:     j.Name,
+		Owner:    j.Owner,
+		Command:  j.Spec.Command,
+		Status:   status,
+		ExitCode: exitCode,
+		Duration: j.EndedAt().Sub(j.StartedAt()).Round(time.Millisecond),
+		LogTail:  tail,
+	}
+}
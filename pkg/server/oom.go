@@ -0,0 +1,26 @@
+package server
+
+import (
+	"expvar"
+	"log"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// oomKillsVar counts jobs finished with the OOM_KILLED status, exposed on
+// /debug/vars so memory pressure shows up apart from ordinary failures.
+var oomKillsVar = expvar.NewInt("jobs_oom_killed")
+
+// countOOMKills returns the manager listener maintaining oomKillsVar.
+func countOOMKills() job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventFinished {
+			return
+		}
+		if st, _ := ev.Job.Status(); st != job.StatusOOM {
+			return
+		}
+		oomKillsVar.Add(1)
+		log.Printf("job %s killed by the kernel OOM killer (peak rss %d bytes)", ev.Job.ID, ev.Job.PeakRSSBytes())
+	}
+}
@@ -0,0 +1,400 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// stageState tracks one pipeline stage through its life. Pending stages
+// wait on their needs; skipped stages never ran because a need failed or
+// the pipeline was stopped.
+type stageState int
+
+const (
+	stagePending stageState = iota
+	stageRunning
+	stageCompleted
+	stageFailed
+	stageSkipped
+)
+
+func (s stageState) String() string {
+	switch s {
+	case stagePending:
+		return "PENDING"
+	case stageRunning:
+		return "RUNNING"
+	case stageCompleted:
+		return "COMPLETED"
+	case stageFailed:
+		return "FAILED"
+	case stageSkipped:
+		return "SKIPPED"
+	}
+	return "UNKNOWN"
+}
+
+// pipelineStage is one node of the DAG. needs holds indexes into the
+// pipeline's stage slice, resolved from names at submission.
+type pipelineStage struct {
+	name  string
+	needs []int
+	spec  job.Spec
+	state stageState
+	job   *job.Job
+}
+
+// pipeline is a submitted DAG of stages sharing one ID and owner.
+type pipeline struct {
+	id       string
+	name     string
+	owner    string
+	failFast bool
+	stages   []*pipelineStage
+}
+
+// pipelineSet is the registry of live pipelines. Stage progression is
+// driven by the manager's EventFinished notifications; byJob maps a
+// running stage's job ID back to its pipeline.
+type pipelineSet struct {
+	svc   *jobServiceServer
+	mu    sync.Mutex
+	byID  map[string]*pipeline
+	byJob map[string]*pipeline
+}
+
+func newPipelineSet(svc *jobServiceServer) *pipelineSet {
+	return &pipelineSet{
+		svc:   svc,
+		byID:  make(map[string]*pipeline),
+		byJob: make(map[string]*pipeline),
+	}
+}
+
+// listener advances a pipeline whenever one of its stage jobs finishes.
+func (ps *pipelineSet) listener() job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventFinished {
+			return
+		}
+		ps.mu.Lock()
+		defer ps.mu.Unlock()
+		p, ok := ps.byJob[ev.Job.ID]
+		if !ok {
+			return
+		}
+		delete(ps.byJob, ev.Job.ID)
+		for _, st := range p.stages {
+			if st.job != nil && st.job.ID == ev.Job.ID {
+				if s, _ := ev.Job.Status(); s == job.StatusCompleted {
+					st.state = stageCompleted
+				} else {
+					st.state = stageFailed
+				}
+			}
+		}
+		ps.advanceLocked(p)
+	}
+}
+
+// advanceLocked starts every pending stage whose needs all completed and
+// skips the ones that can no longer run. Skips cascade, so the loop runs
+// to a fixpoint.
+func (ps *pipelineSet) advanceLocked(p *pipeline) {
+	failed := false
+	for _, st := range p.stages {
+		if st.state == stageFailed {
+			failed = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, st := range p.stages {
+			if st.state != stagePending {
+				continue
+			}
+			if failed && p.failFast {
+				st.state = stageSkipped
+				changed = true
+				continue
+			}
+			ready := true
+			for _, n := range st.needs {
+				switch p.stages[n].state {
+				case stageCompleted:
+				case stageFailed, stageSkipped:
+					st.state = stageSkipped
+					ready = false
+				default:
+					ready = false
+				}
+			}
+			if st.state == stageSkipped {
+				changed = true
+				continue
+			}
+			if !ready {
+				continue
+			}
+			if err := ps.startStageLocked(p, st); err != nil {
+				log.Printf("pipeline %s stage %s failed to start: %v", p.id, st.name, err)
+				st.state = stageFailed
+				failed = true
+			}
+			changed = true
+		}
+	}
+}
+
+// startStageLocked launches one stage as a tracked job of the pipeline's
+// owner. Quota is enforced per stage, like any other start.
+func (ps *pipelineSet) startStageLocked(p *pipeline, st *pipelineStage) error {
+	if err := ps.svc.quota.checkStart(p.owner); err != nil {
+		return err
+	}
+	j, err := ps.svc.manager.StartJob(p.owner, st.spec)
+	if err != nil {
+		return err
+	}
+	ps.svc.quota.recordStart(p.owner)
+	st.job = j
+	st.state = stageRunning
+	ps.byJob[j.ID] = p
+	return nil
+}
+
+// StartPipeline validates the DAG, builds every stage's spec up front so
+// a bad stage fails the whole submission, and starts the root stages.
+func (s *jobServiceServer) StartPipeline(ctx context.Context, req *jobworkerv1.StartPipelineRequest) (*jobworkerv1.StartPipelineResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Stages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pipeline has no stages")
+	}
+	var failFast bool
+	switch req.FailurePolicy {
+	case "", "fail-fast":
+		failFast = true
+	case "continue":
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown failure policy %q (want fail-fast or continue)", req.FailurePolicy)
+	}
+	if err := s.admission.check(); err != nil {
+		return nil, err
+	}
+	if err := s.maintenance.wait(ctx, identity, auth.RolesFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	p := &pipeline{
+		id:       uuid.NewString(),
+		name:     req.Name,
+		owner:    identity,
+		failFast: failFast,
+	}
+	index := make(map[string]int, len(req.Stages))
+	for i, sr := range req.Stages {
+		if sr.Name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "stage %d has no name", i)
+		}
+		if _, ok := index[sr.Name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate stage name %q", sr.Name)
+		}
+		index[sr.Name] = i
+	}
+	for _, sr := range req.Stages {
+		spec, err := s.stageSpec(ctx, sr)
+		if err != nil {
+			return nil, err
+		}
+		st := &pipelineStage{name: sr.Name, spec: spec}
+		for _, need := range sr.Needs {
+			n, ok := index[need]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "stage %q needs unknown stage %q", sr.Name, need)
+			}
+			st.needs = append(st.needs, n)
+		}
+		p.stages = append(p.stages, st)
+	}
+	if err := checkPipelineAcyclic(p); err != nil {
+		return nil, err
+	}
+
+	s.pipelines.mu.Lock()
+	defer s.pipelines.mu.Unlock()
+	s.pipelines.byID[p.id] = p
+	s.pipelines.advanceLocked(p)
+	return &jobworkerv1.StartPipelineResponse{PipelineId: p.id}, nil
+}
+
+// stageSpec builds one stage's job spec, with limits, secrets, and
+// redaction applied the same way a direct start would. Features needing
+// per-start server context — staged uploads, locks, GPUs, ports,
+// idempotency — are not available to stages, because later stages launch
+// from a listener with no caller attached.
+func (s *jobServiceServer) stageSpec(ctx context.Context, sr *jobworkerv1.PipelineStage) (job.Spec, error) {
+	req := sr.Job
+	if req == nil || req.Command == "" {
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q has no command", sr.Name)
+	}
+	switch {
+	case req.UploadId != "":
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q: uploads are not supported in pipeline stages", sr.Name)
+	case req.IdempotencyKey != "":
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q: idempotency keys are not supported in pipeline stages", sr.Name)
+	case req.LockKey != "":
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q: locks are not supported in pipeline stages", sr.Name)
+	case req.Gpus > 0:
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q: GPUs are not supported in pipeline stages", sr.Name)
+	case req.Ports > 0:
+		return job.Spec{}, status.Errorf(codes.InvalidArgument, "stage %q: port allocation is not supported in pipeline stages", sr.Name)
+	}
+	if !s.node.matchesSelector(req.NodeSelector) {
+		return job.Spec{}, status.Errorf(codes.FailedPrecondition, "stage %q: this worker does not match the node selector", sr.Name)
+	}
+	spec, err := specFromRequest(req)
+	if err != nil {
+		return job.Spec{}, err
+	}
+	if err := s.applyLimits(ctx, &spec); err != nil {
+		return job.Spec{}, err
+	}
+	secretEnv, secretValues, err := s.resolveSecretEnv(req.SecretEnv)
+	if err != nil {
+		return job.Spec{}, err
+	}
+	spec.Env = append(spec.Env, secretEnv...)
+	spec.Redact = s.redactNow().WithValues(secretValues...)
+	return spec, nil
+}
+
+// checkPipelineAcyclic rejects DAGs with cycles, which would deadlock
+// waiting on themselves.
+func checkPipelineAcyclic(p *pipeline) error {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	marks := make([]int, len(p.stages))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch marks[i] {
+		case done:
+			return nil
+		case visiting:
+			return status.Errorf(codes.InvalidArgument, "stage %q is part of a dependency cycle", p.stages[i].name)
+		}
+		marks[i] = visiting
+		for _, n := range p.stages[i].needs {
+			if err := visit(n); err != nil {
+				return err
+			}
+		}
+		marks[i] = done
+		return nil
+	}
+	for i := range p.stages {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPipeline reports a pipeline's aggregate status and per-stage states.
+// Like jobs, another owner's pipeline reads as not found.
+func (s *jobServiceServer) GetPipeline(ctx context.Context, req *jobworkerv1.GetPipelineRequest) (*jobworkerv1.GetPipelineResponse, error) {
+	p, err := s.authorizedPipeline(ctx, req.PipelineId)
+	if err != nil {
+		return nil, err
+	}
+	s.pipelines.mu.Lock()
+	defer s.pipelines.mu.Unlock()
+	return &jobworkerv1.GetPipelineResponse{Pipeline: pipelineToProto(p)}, nil
+}
+
+// StopPipeline stops the running stages and skips the pending ones.
+func (s *jobServiceServer) StopPipeline(ctx context.Context, req *jobworkerv1.StopPipelineRequest) (*jobworkerv1.StopPipelineResponse, error) {
+	p, err := s.authorizedPipeline(ctx, req.PipelineId)
+	if err != nil {
+		return nil, err
+	}
+	s.pipelines.mu.Lock()
+	defer s.pipelines.mu.Unlock()
+	var stopped int32
+	for _, st := range p.stages {
+		switch st.state {
+		case stagePending:
+			st.state = stageSkipped
+		case stageRunning:
+			if err := st.job.StopBy("server", fmt.Sprintf("pipeline %s stopped", p.id)); err == nil {
+				stopped++
+			}
+		}
+	}
+	return &jobworkerv1.StopPipelineResponse{Stopped: stopped}, nil
+}
+
+func (s *jobServiceServer) authorizedPipeline(ctx context.Context, id string) (*pipeline, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.pipelines.mu.Lock()
+	defer s.pipelines.mu.Unlock()
+	p, ok := s.pipelines.byID[id]
+	if !ok || p.owner != identity {
+		return nil, status.Errorf(codes.NotFound, "pipeline not found: %s", id)
+	}
+	return p, nil
+}
+
+// pipelineToProto renders the pipeline under the set's lock.
+func pipelineToProto(p *pipeline) *jobworkerv1.Pipeline {
+	pb := &jobworkerv1.Pipeline{
+		Id:     p.id,
+		Name:   p.name,
+		Status: "COMPLETED",
+	}
+	if p.failFast {
+		pb.FailurePolicy = "fail-fast"
+	} else {
+		pb.FailurePolicy = "continue"
+	}
+	settled := true
+	failed := false
+	for _, st := range p.stages {
+		ss := &jobworkerv1.PipelineStageStatus{Name: st.name, State: st.state.String()}
+		if st.job != nil {
+			ss.JobId = st.job.ID
+		}
+		pb.Stages = append(pb.Stages, ss)
+		switch st.state {
+		case stagePending, stageRunning:
+			settled = false
+		case stageFailed, stageSkipped:
+			failed = true
+		}
+	}
+	switch {
+	case !settled:
+		pb.Status = "RUNNING"
+	case failed:
+		pb.Status = "FAILED"
+	}
+	return pb
+}
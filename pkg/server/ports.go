@@ -0,0 +1,60 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// maxPortsPerJob bounds how many listening ports one job may request.
+const maxPortsPerJob = 16
+
+// allocatePorts reserves n free TCP ports by binding ephemeral listeners
+// and releasing them just before the job starts. The small window between
+// release and the job binding is the standard trade-off for dynamic port
+// assignment without kernel-level reservation.
+func allocatePorts(n int) ([]int, error) {
+	listeners := make([]net.Listener, 0, n)
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+	ports := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, fmt.Errorf("reserving port: %w", err)
+		}
+		listeners = append(listeners, l)
+		ports = append(ports, l.Addr().(*net.TCPAddr).Port)
+	}
+	return ports, nil
+}
+
+// allocatePortsFor reserves the requested ports for a start request and
+// applies them to spec: recorded in metadata, and injected as PORT0..PORTn-1
+// (plus PORT as an alias for the first) so the command can bind them.
+func allocatePortsFor(n int32, spec *job.Spec) error {
+	if n == 0 {
+		return nil
+	}
+	if n < 0 || n > maxPortsPerJob {
+		return status.Errorf(codes.InvalidArgument, "ports must be between 0 and %d", maxPortsPerJob)
+	}
+	ports, err := allocatePorts(int(n))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	spec.Ports = ports
+	spec.Env = append(spec.Env, "PORT="+strconv.Itoa(ports[0]))
+	for i, p := range ports {
+		spec.Env = append(spec.Env, fmt.Sprintf("PORT%d=%d", i, p))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// requeueDelay is how long a preempted job waits before re-entering the
+// GPU queue, giving the start that triggered the preemption first claim
+// on the freed devices.
+const requeueDelay = time.Second
+
+// preemptor makes room for high-priority GPU starts. When the worker is
+// out of devices and a start arrives with a higher priority, running jobs
+// with strictly lower priorities are gracefully stopped — SIGTERM, grace
+// period, SIGKILL, exactly like StopJob — and then requeued: their
+// original start request is replayed under the owner's identity, so each
+// re-runs once devices free up, linked to the stopped attempt through
+// RescheduledFrom. All methods are safe on a nil receiver, which is how
+// a worker with preemption disabled runs.
+type preemptor struct {
+	svc *jobServiceServer
+
+	mu sync.Mutex
+	// starts remembers the original request for every live GPU job, keyed
+	// by job ID; it is what a preempted job is requeued from.
+	starts map[string]*trackedStart
+}
+
+type trackedStart struct {
+	req      *jobworkerv1.StartJobRequest
+	identity string
+	roles    []string
+}
+
+func newPreemptor(svc *jobServiceServer) *preemptor {
+	return &preemptor{svc: svc, starts: make(map[string]*trackedStart)}
+}
+
+// track remembers a started job's request so it can be requeued if
+// preempted. Jobs without GPUs never contend for devices and are skipped.
+func (p *preemptor) track(jobID string, req *jobworkerv1.StartJobRequest, identity string, roles []string) {
+	if p == nil || req.Gpus == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.starts[jobID] = &trackedStart{req: req, identity: identity, roles: roles}
+}
+
+// listener returns a job.Listener that drops finished jobs from tracking.
+func (p *preemptor) listener() job.Listener {
+	return func(e job.Event) {
+		if e.Type == job.EventFinished {
+			p.mu.Lock()
+			delete(p.starts, e.Job.ID)
+			p.mu.Unlock()
+		}
+	}
+}
+
+// makeRoom preempts enough lower-priority jobs to free n devices for a
+// start with the given priority. It returns once the victims are chosen
+// and being stopped; the caller's acquire then queues for the devices.
+// If the preemptible jobs cannot cover the shortfall, nothing is stopped
+// — wasting their work would not unblock the start — and it simply
+// queues.
+func (p *preemptor) makeRoom(n, priority int) {
+	if p == nil || n == 0 || priority == 0 {
+		return
+	}
+	_, free := p.svc.gpus.counts()
+	needed := n - free
+	if needed <= 0 {
+		return
+	}
+	victims := p.pickVictims(priority, needed)
+	for _, id := range victims {
+		go p.preemptOne(id)
+	}
+}
+
+// pickVictims chooses running jobs with priorities strictly below the
+// given one, lowest priority first and youngest first within a priority,
+// until their devices cover the shortfall. It returns nil if they cannot.
+func (p *preemptor) pickVictims(priority, needed int) []string {
+	p.mu.Lock()
+	ids := make([]string, 0, len(p.starts))
+	for id := range p.starts {
+		ids = append(ids, id)
+	}
+	p.mu.Unlock()
+
+	var candidates []*job.Job
+	for _, id := range ids {
+		j, err := p.svc.manager.GetJob(id)
+		if err != nil {
+			continue
+		}
+		if st, _ := j.Status(); st != job.StatusRunning && st != job.StatusPaused {
+			continue
+		}
+		if j.Spec.Priority < priority && len(j.Spec.GPUs) > 0 {
+			candidates = append(candidates, j)
+		}
+	}
+	sort.Slice(candidates, func(i, k int) bool {
+		if candidates[i].Spec.Priority != candidates[k].Spec.Priority {
+			return candidates[i].Spec.Priority < candidates[k].Spec.Priority
+		}
+		return candidates[i].StartedAt().After(candidates[k].StartedAt())
+	})
+	var victims []string
+	for _, j := range candidates {
+		victims = append(victims, j.ID)
+		needed -= len(j.Spec.GPUs)
+		if needed <= 0 {
+			return victims
+		}
+	}
+	return nil
+}
+
+// preemptOne gracefully stops one victim and requeues it. The stop is
+// recorded in the job's history and StopInfo, so the preemption shows up
+// in GetHistory and GetJobStatus.
+func (p *preemptor) preemptOne(jobID string) {
+	j, err := p.svc.manager.GetJob(jobID)
+	if err != nil {
+		return
+	}
+	// Take the record before stopping: the finish listener drops it as
+	// soon as the process dies.
+	p.mu.Lock()
+	rec, ok := p.starts[jobID]
+	delete(p.starts, jobID)
+	p.mu.Unlock()
+	if !ok {
+		return
+	}
+	log.Printf("preempting job %s (priority %d) for a higher-priority start", jobID, j.Spec.Priority)
+	if err := j.StopBy("scheduler", "preempted by a higher-priority start"); err != nil {
+		return
+	}
+	time.Sleep(requeueDelay)
+	p.requeue(jobID, rec)
+}
+
+// requeue replays a preempted job's original start request under its
+// owner's identity. The replayed start queues for devices like any other,
+// so the job re-runs once capacity returns.
+func (p *preemptor) requeue(jobID string, rec *trackedStart) {
+	req := proto.Clone(rec.req).(*jobworkerv1.StartJobRequest)
+	req.IdempotencyKey = ""
+	req.RescheduledFrom = jobID
+	ctx := auth.ContextWithIdentity(context.Background(), rec.identity)
+	ctx = auth.ContextWithRoles(ctx, rec.roles)
+	resp, err := p.svc.StartJob(ctx, req)
+	if err != nil {
+		log.Printf("requeueing preempted job %s: %v", jobID, err)
+		return
+	}
+	log.Printf("preempted job %s requeued as %s", jobID, resp.JobId)
+}
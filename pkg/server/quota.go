@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// adminRole is the client-certificate role (organizational unit) allowed
+// to see every owner's quota usage, not just their own.
+const adminRole = "admin"
+
+// Per-owner consumption counters, published for scraping alongside the
+// joblog_* variables. Keys are owner identities.
+var (
+	quotaJobsVar     = expvar.NewMap("quota_jobs")
+	quotaCPUVar      = expvar.NewMap("quota_cpu_seconds")
+	quotaLogBytesVar = expvar.NewMap("quota_log_bytes")
+)
+
+// Quota is the per-window consumption an owner is allowed. Zero fields are
+// unlimited.
+type Quota struct {
+	Jobs       int64 `json:"jobs"`
+	CPUSeconds int64 `json:"cpu_seconds"`
+	LogBytes   int64 `json:"log_bytes"`
+}
+
+// QuotaPolicy is the admin-configured consumption quota: cumulative
+// per-owner ceilings on jobs started, CPU seconds burned, and log bytes
+// captured, reset each accounting window. Starts are rejected once a
+// ceiling is exhausted.
+type QuotaPolicy struct {
+	// Period is the accounting window: "daily" or "monthly" (the
+	// default). Windows are aligned to UTC.
+	Period string `json:"period"`
+	// Owners maps an identity to its quota. The "*" entry applies to
+	// owners without their own.
+	Owners map[string]Quota `json:"owners"`
+}
+
+// LoadQuotaPolicy reads a quota policy from a JSON file.
+func LoadQuotaPolicy(path string) (*QuotaPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading quota policy: %w", err)
+	}
+	var p QuotaPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	switch p.Period {
+	case "", "daily", "monthly":
+	default:
+		return nil, fmt.Errorf("unknown quota period %q; want daily or monthly", p.Period)
+	}
+	for owner, q := range p.Owners {
+		if q.Jobs < 0 || q.CPUSeconds < 0 || q.LogBytes < 0 {
+			return nil, fmt.Errorf("negative quota for owner %q", owner)
+		}
+	}
+	return &p, nil
+}
+
+// quotaFor resolves an owner's quota; owners without one fall back to the
+// "*" entry. A nil policy, or no match, means no enforcement.
+func (p *QuotaPolicy) quotaFor(owner string) (Quota, bool) {
+	if p == nil {
+		return Quota{}, false
+	}
+	if q, ok := p.Owners[owner]; ok {
+		return q, true
+	}
+	q, ok := p.Owners["*"]
+	return q, ok
+}
+
+// quotaUsage is one owner's consumption within the current window.
+type quotaUsage struct {
+	windowStart time.Time
+	jobs        int64
+	cpu         time.Duration
+	logBytes    int64
+}
+
+// quotaAccountant tracks cumulative per-owner resource consumption and,
+// when a policy is configured, enforces it at start time. Accounting runs
+// even without a policy so usage stays observable.
+type quotaAccountant struct {
+	mu     sync.Mutex
+	policy *QuotaPolicy
+	usage  map[string]*quotaUsage
+}
+
+func newQuotaAccountant(policy *QuotaPolicy) *quotaAccountant {
+	return &quotaAccountant{policy: policy, usage: make(map[string]*quotaUsage)}
+}
+
+// setPolicy swaps the enforced policy, for runtime config reload. Usage
+// counters carry over; only the ceilings change.
+func (a *quotaAccountant) setPolicy(policy *QuotaPolicy) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.policy = policy
+}
+
+// quotaFor resolves an owner's quota under the current policy.
+func (a *quotaAccountant) quotaFor(owner string) (Quota, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.policy.quotaFor(owner)
+}
+
+// currentWindowStart reports when the current accounting window began.
+func (a *quotaAccountant) currentWindowStart() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.windowStartLocked(time.Now())
+}
+
+// windowStartLocked aligns now to the start of the current accounting
+// window.
+func (a *quotaAccountant) windowStartLocked(now time.Time) time.Time {
+	now = now.UTC()
+	if a.policy != nil && a.policy.Period == "daily" {
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// usageLocked returns the owner's counters for the current window, rolling
+// them over when the window has advanced.
+func (a *quotaAccountant) usageLocked(owner string) *quotaUsage {
+	start := a.windowStartLocked(time.Now())
+	u, ok := a.usage[owner]
+	if !ok || u.windowStart.Before(start) {
+		u = &quotaUsage{windowStart: start}
+		a.usage[owner] = u
+	}
+	return u
+}
+
+// checkStart rejects the start when any of the owner's quota dimensions is
+// already exhausted for the current window.
+func (a *quotaAccountant) checkStart(owner string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	q, ok := a.policy.quotaFor(owner)
+	if !ok {
+		return nil
+	}
+	u := a.usageLocked(owner)
+	switch {
+	case q.Jobs > 0 && u.jobs >= q.Jobs:
+		return status.Errorf(codes.ResourceExhausted,
+			"job quota exhausted: %d of %d jobs this %s", u.jobs, q.Jobs, a.periodNameLocked())
+	case q.CPUSeconds > 0 && int64(u.cpu/time.Second) >= q.CPUSeconds:
+		return status.Errorf(codes.ResourceExhausted,
+			"cpu quota exhausted: %ds of %ds this %s", int64(u.cpu/time.Second), q.CPUSeconds, a.periodNameLocked())
+	case q.LogBytes > 0 && u.logBytes >= q.LogBytes:
+		return status.Errorf(codes.ResourceExhausted,
+			"log quota exhausted: %d of %d bytes this %s", u.logBytes, q.LogBytes, a.periodNameLocked())
+	}
+	return nil
+}
+
+func (a *quotaAccountant) periodNameLocked() string {
+	if a.policy != nil && a.policy.Period == "daily" {
+		return "day"
+	}
+	return "month"
+}
+
+// recordStart counts one started job against the owner.
+func (a *quotaAccountant) recordStart(owner string) {
+	a.mu.Lock()
+	a.usageLocked(owner).jobs++
+	a.mu.Unlock()
+	quotaJobsVar.Add(owner, 1)
+}
+
+// listener returns the job-manager listener charging each finished job's
+// CPU time and output volume to its owner.
+func (a *quotaAccountant) listener() job.Listener {
+	return func(ev job.Event) {
+		if ev.Type != job.EventFinished {
+			return
+		}
+		cpu := ev.Job.CPUTime()
+		bytes := ev.Job.Output().Bytes()
+		a.mu.Lock()
+		u := a.usageLocked(ev.Job.Owner)
+		u.cpu += cpu
+		u.logBytes += bytes
+		a.mu.Unlock()
+		quotaCPUVar.Add(ev.Job.Owner, int64(cpu/time.Second))
+		quotaLogBytesVar.Add(ev.Job.Owner, bytes)
+	}
+}
+
+// GetQuotaUsage reports per-owner consumption in the current accounting
+// window. Callers see their own row; the admin role sees every owner's.
+func (s *jobServiceServer) GetQuotaUsage(ctx context.Context, _ *jobworkerv1.GetQuotaUsageRequest) (*jobworkerv1.GetQuotaUsageResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin := false
+	for _, role := range auth.RolesFromContext(ctx) {
+		if role == adminRole {
+			isAdmin = true
+		}
+	}
+
+	usage := s.quota.snapshot()
+	if _, ok := usage[identity]; !ok {
+		// Owners with no activity yet still get a row, so they can see
+		// their limits.
+		usage[identity] = quotaUsage{windowStart: s.quota.currentWindowStart()}
+	}
+	resp := &jobworkerv1.GetQuotaUsageResponse{}
+	for owner, u := range usage {
+		if !isAdmin && owner != identity {
+			continue
+		}
+		row := &jobworkerv1.QuotaUsage{
+			Owner:           owner,
+			Jobs:            u.jobs,
+			CpuSeconds:      int64(u.cpu / time.Second),
+			LogBytes:        u.logBytes,
+			WindowStartUnix: u.windowStart.Unix(),
+		}
+		if q, ok := s.quota.quotaFor(owner); ok {
+			row.JobsLimit = q.Jobs
+			row.CpuSecondsLimit = q.CPUSeconds
+			row.LogBytesLimit = q.LogBytes
+		}
+		resp.Owners = append(resp.Owners, row)
+	}
+	sort.Slice(resp.Owners, func(i, k int) bool { return resp.Owners[i].Owner < resp.Owners[k].Owner })
+	return resp, nil
+}
+
+// snapshot returns every owner's usage in the current window, for the
+// GetQuotaUsage RPC.
+func (a *quotaAccountant) snapshot() map[string]quotaUsage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	start := a.windowStartLocked(time.Now())
+	out := make(map[string]quotaUsage, len(a.usage))
+	for owner, u := range a.usage {
+		if u.windowStart.Before(start) {
+			continue
+		}
+		out[owner] = *u
+	}
+	return out
+}
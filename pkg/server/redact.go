@@ -0,0 +1,34 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// RedactRules is the JSON shape of the redaction rules file: regex
+// patterns whose matches are blanked from captured output before it is
+// stored, streamed, or shipped. Values resolved from the secrets
+// provider are blanked per job on top of these.
+type RedactRules struct {
+	Patterns []string `json:"patterns"`
+}
+
+// LoadRedactRules reads and compiles a redaction rules file.
+func LoadRedactRules(path string) (*job.Redactor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading redaction rules: %w", err)
+	}
+	var rules RedactRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	r, err := job.NewRedactor(rules.Patterns)
+	if err != nil {
+		return nil, fmt.Errorf("compiling %s: %w", path, err)
+	}
+	return r, nil
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/coordinator"
+	"github.com/pmantica4/teleport/pkg/version"
+)
+
+// registerLoopRetryDelay is how long the worker waits before retrying a
+// failed registration or heartbeat.
+const registerLoopRetryDelay = 5 * time.Second
+
+// registerWithCoordinator dials the coordinator, registers this worker, and
+// heartbeats until ctx is cancelled. Registration is retried forever: a
+// worker must survive coordinator restarts.
+func (s *Server) registerWithCoordinator(ctx context.Context) {
+	name := s.cfg.WorkerName
+	if name == "" {
+		name, _ = os.Hostname()
+	}
+	addr := s.cfg.AdvertiseAddr
+	if s.cfg.TunnelAddr != "" {
+		addr = coordinator.TunnelAddrPrefix + name
+	}
+
+	conn, err := s.dialCoordinator()
+	if err != nil {
+		log.Printf("worker registration: %v", err)
+		return
+	}
+	defer conn.Close()
+	client := jobworkerv1.NewWorkerServiceClient(conn)
+
+	interval := registerLoopRetryDelay
+	registered := false
+	for {
+		if !registered {
+			memTotal, _ := memInfo()
+			resp, err := client.RegisterWorker(ctx, &jobworkerv1.RegisterWorkerRequest{
+				Name:          name,
+				Addr:          addr,
+				Labels:        s.cfg.Labels,
+				Version:       version.Version,
+				Cpus:          int64(runtime.NumCPU()),
+				MemTotalBytes: memTotal,
+				GpusTotal:     int32(len(s.cfg.GPUs)),
+			})
+			if err != nil {
+				log.Printf("registering with coordinator: %v", err)
+			} else {
+				registered = true
+				if resp.HeartbeatIntervalSeconds > 0 {
+					interval = time.Duration(resp.HeartbeatIntervalSeconds) * time.Second
+				}
+				log.Printf("registered with coordinator %s as %q", s.cfg.CoordinatorAddr, name)
+			}
+		} else {
+			_, memFree := memInfo()
+			var gpusFree int32
+			if s.gpus != nil {
+				_, free := s.gpus.counts()
+				gpusFree = int32(free)
+			}
+			resp, err := client.Heartbeat(ctx, &jobworkerv1.HeartbeatRequest{
+				Name:         name,
+				RunningJobs:  int64(s.manager.RunningCount()),
+				MemFreeBytes: memFree,
+				GpusFree:     gpusFree,
+			})
+			if err != nil {
+				log.Printf("heartbeat to coordinator: %v", err)
+			} else if !resp.Registered {
+				// Coordinator restarted and lost us; re-register next tick.
+				registered = false
+				continue
+			}
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// clientTLSConfig builds the TLS config the worker uses when dialing out
+// (to the coordinator, or for the reverse tunnel): its own key pair as the
+// client certificate and the shared CA to verify the peer.
+func (s *Server) clientTLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertFile, s.cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading worker key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(s.cfg.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates parsed from %s", s.cfg.CACertFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// dialCoordinator connects to the coordinator's gRPC endpoint.
+func (s *Server) dialCoordinator() (*grpc.ClientConn, error) {
+	tlsConfig, err := s.clientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	unaryVersion, streamVersion := version.ClientInterceptors(nil)
+	return grpc.Dial(s.cfg.CoordinatorAddr,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithChainUnaryInterceptor(unaryVersion),
+		grpc.WithChainStreamInterceptor(streamVersion),
+	)
+}
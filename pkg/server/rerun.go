@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// startRecorder remembers the original StartJobRequest of every job
+// started through StartJob, so RerunJob can resubmit it verbatim — the
+// same trick the coordinator uses when it migrates a lost job.
+type startRecorder struct {
+	mu   sync.Mutex
+	reqs map[string]*jobworkerv1.StartJobRequest
+}
+
+func newStartRecorder() *startRecorder {
+	return &startRecorder{reqs: make(map[string]*jobworkerv1.StartJobRequest)}
+}
+
+// record stores a copy of the request under the job's ID.
+func (r *startRecorder) record(jobID string, req *jobworkerv1.StartJobRequest) {
+	clone := proto.Clone(req).(*jobworkerv1.StartJobRequest)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reqs[jobID] = clone
+}
+
+// get returns a copy of the job's recorded request, or nil when the job
+// was not started through StartJob.
+func (r *startRecorder) get(jobID string) *jobworkerv1.StartJobRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.reqs[jobID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(req).(*jobworkerv1.StartJobRequest)
+}
+
+// RerunJob resubmits the recorded start request of an existing job
+// through the normal StartJob path, so limits, quotas, and secret
+// resolution apply afresh. A named job still running blocks its rerun the
+// same way any duplicate name would.
+func (s *jobServiceServer) RerunJob(ctx context.Context, req *jobworkerv1.RerunJobRequest) (*jobworkerv1.StartJobResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	orig := s.starts.get(j.ID)
+	if orig == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no recorded start request; only jobs started through StartJob can be rerun")
+	}
+	// The rerun is a fresh execution: never a cache hit or an idempotent
+	// replay, and staged uploads were consumed by the original.
+	orig.IdempotencyKey = ""
+	orig.UploadId = ""
+	if orig.Cacheable {
+		orig.NoCache = true
+	}
+	orig.Args = append(orig.Args, req.AppendArgs...)
+	for k, v := range req.Set {
+		if err := applyOverride(orig, k, v); err != nil {
+			return nil, err
+		}
+	}
+	return s.StartJob(ctx, orig)
+}
+
+// applyOverride sets one start parameter on the cloned request. Keys
+// match the CLI start flags; values only need to parse here, the full
+// validation runs again when the request re-enters StartJob.
+func applyOverride(req *jobworkerv1.StartJobRequest, key, value string) error {
+	bad := func(err error) error {
+		return status.Errorf(codes.InvalidArgument, "override %s=%s: %v", key, value, err)
+	}
+	switch key {
+	case "name":
+		req.Name = value
+	case "nice":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return bad(err)
+		}
+		req.Nice = int32(n)
+	case "priority":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return bad(err)
+		}
+		req.Priority = int32(n)
+	case "cpu-budget":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return bad(err)
+		}
+		req.CpuBudgetSeconds = int64(d / time.Second)
+	case "cpu-budget-policy":
+		req.CpuBudgetPolicy = value
+	case "max-output-bytes":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return bad(err)
+		}
+		req.MaxOutputBytes = n
+	case "output-limit-policy":
+		req.OutputLimitPolicy = value
+	case "max-silence":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return bad(err)
+		}
+		req.MaxSilenceSeconds = int64(d / time.Second)
+	case "stall-policy":
+		req.StallPolicy = value
+	case "max-core-bytes":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return bad(err)
+		}
+		req.MaxCoreBytes = n
+	case "restart-policy":
+		req.RestartPolicy = value
+	case "max-restarts":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return bad(err)
+		}
+		req.MaxRestarts = int32(n)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown override %q", key)
+	}
+	return nil
+}
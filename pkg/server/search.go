@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+)
+
+// defaultMaxMatches bounds SearchOutput responses when the request does not
+// say otherwise.
+const defaultMaxMatches = 1000
+
+// maxContextLines caps the per-match context a caller may request.
+const maxContextLines = 10
+
+// SearchOutput greps the job's captured output server-side so clients do not
+// have to download the full log to find a few lines.
+func (s *jobServiceServer) SearchOutput(ctx context.Context, req *jobworkerv1.SearchOutputRequest) (*jobworkerv1.SearchOutputResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	match, err := compileMatcher(req.Pattern, req.Literal, req.CaseInsensitive)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pattern: %v", err)
+	}
+	maxMatches := int(req.MaxMatches)
+	if maxMatches <= 0 {
+		maxMatches = defaultMaxMatches
+	}
+	contextLines := int(req.ContextLines)
+	if contextLines < 0 {
+		return nil, status.Error(codes.InvalidArgument, "context_lines must not be negative")
+	}
+	if contextLines > maxContextLines {
+		contextLines = maxContextLines
+	}
+
+	entries, _ := j.Output().Entries(0)
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.Text
+	}
+	var resp jobworkerv1.SearchOutputResponse
+	for i, line := range lines {
+		if !match(line) {
+			continue
+		}
+		if req.Level != "" && lineLevel(entries[i]) != req.Level {
+			continue
+		}
+		if len(resp.Matches) == maxMatches {
+			resp.Truncated = true
+			break
+		}
+		m := &jobworkerv1.SearchMatch{
+			LineNumber: int64(i + 1),
+			Line:       line,
+		}
+		for b := i - contextLines; b < i; b++ {
+			if b >= 0 {
+				m.Before = append(m.Before, lines[b])
+			}
+		}
+		for a := i + 1; a <= i+contextLines && a < len(lines); a++ {
+			m.After = append(m.After, lines[a])
+		}
+		resp.Matches = append(resp.Matches, m)
+	}
+	return &resp, nil
+}
+
+// compileMatcher turns the request's pattern options into a per-line
+// predicate.
+func compileMatcher(pattern string, literal, caseInsensitive bool) (func(string) bool, error) {
+	if pattern == "" {
+		return nil, status.Error(codes.InvalidArgument, "pattern is required")
+	}
+	if literal {
+		if caseInsensitive {
+			needle := strings.ToLower(pattern)
+			return func(line string) bool {
+				return strings.Contains(strings.ToLower(line), needle)
+			}, nil
+		}
+		return func(line string) bool {
+			return strings.Contains(line, pattern)
+		}, nil
+	}
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
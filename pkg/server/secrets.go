@@ -0,0 +1,34 @@
+package server
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveSecretEnv expands NAME=provider:reference entries into
+// NAME=value environment entries via the secrets resolver, also
+// returning the bare values so the job's output redactor can blank
+// them. Resolution errors carry the variable name and the reference,
+// never a value.
+func (s *jobServiceServer) resolveSecretEnv(refs []string) (env, values []string, err error) {
+	if len(refs) == 0 {
+		return nil, nil, nil
+	}
+	env = make([]string, 0, len(refs))
+	values = make([]string, 0, len(refs))
+	for _, e := range refs {
+		name, ref, ok := strings.Cut(e, "=")
+		if !ok || !envNameRE.MatchString(name) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "secret env %q: want NAME=provider:reference", e)
+		}
+		v, err := s.secrets.Resolve(ref)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "secret env %s: %v", name, err)
+		}
+		env = append(env, name+"="+v)
+		values = append(values, v)
+	}
+	return env, values, nil
+}
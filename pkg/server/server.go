@@ -0,0 +1,394 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/artifact"
+	"github.com/pmantica4/teleport/pkg/audit"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/eventbus"
+	"github.com/pmantica4/teleport/pkg/interceptor"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/joblog"
+	"github.com/pmantica4/teleport/pkg/jobstore"
+	"github.com/pmantica4/teleport/pkg/logship"
+	"github.com/pmantica4/teleport/pkg/secrets"
+	"github.com/pmantica4/teleport/pkg/template"
+	"github.com/pmantica4/teleport/pkg/version"
+	"github.com/pmantica4/teleport/pkg/webhook"
+)
+
+// Server is the worker's gRPC front end, plus the optional HTTP/JSON
+// gateway.
+type Server struct {
+	cfg      Config
+	grpc     *grpc.Server
+	http     *http.Server
+	manager  *job.Manager
+	logSink  logship.Sink
+	busPub   eventbus.Publisher
+	jobStore *jobstore.Store
+	auditLog *audit.Log
+	gpus     *gpuAllocator
+
+	registerCancel context.CancelFunc
+}
+
+// New builds a Server from the config; call Serve to start accepting
+// connections.
+func New(cfg Config) (*Server, error) {
+	if err := cfg.CheckAndSetDefaults(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	// Serve the certificate through a reloader so the admin API can swap
+	// in a rotated key pair without a restart.
+	certs := newCertReloader(cfg.CertFile, cfg.KeyFile)
+	if err := certs.reload(); err != nil {
+		return nil, err
+	}
+	tlsConfig.Certificates = nil
+	tlsConfig.GetCertificate = certs.getCertificate
+
+	// Middleware order: recovery outermost so nothing below can kill a
+	// stream, then authn, version exchange, and the optional extras.
+	unaryRecovery, streamRecovery := interceptor.Recovery()
+	unaryAuth, streamAuth := auth.Interceptors(cfg.CoordinatorCN)
+	unaryVersion, streamVersion := version.ServerInterceptors()
+	unaryMetrics, streamMetrics := interceptor.Metrics()
+	unaryChain := []grpc.UnaryServerInterceptor{unaryRecovery, unaryAuth, unaryVersion, unaryMetrics}
+	streamChain := []grpc.StreamServerInterceptor{streamRecovery, streamAuth, streamVersion, streamMetrics}
+	if cfg.LogRPCs {
+		u, st := interceptor.Audit()
+		unaryChain = append(unaryChain, u)
+		streamChain = append(streamChain, st)
+	}
+	if cfg.RPCRateLimit > 0 {
+		u, st := interceptor.RateLimit(cfg.RPCRateLimit)
+		unaryChain = append(unaryChain, u)
+		streamChain = append(streamChain, st)
+	}
+	opts := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(unaryChain...),
+		grpc.ChainStreamInterceptor(streamChain...),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:              cfg.KeepaliveTime,
+			Timeout:           cfg.KeepaliveTimeout,
+			MaxConnectionIdle: cfg.MaxConnectionIdle,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.KeepaliveMinTime,
+			PermitWithoutStream: cfg.KeepalivePermitWithoutStream,
+		}),
+	}
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+	if cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+
+	idGen, err := job.ParseIDGenerator(cfg.IDScheme)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		cfg:     cfg,
+		grpc:    grpc.NewServer(opts...),
+		manager: job.NewManagerWithIDs(idGen),
+	}
+	switch cfg.NameCollision {
+	case "", "reject":
+		s.manager.SetNameCollisionPolicy(job.NameCollisionReject)
+	case "replace":
+		s.manager.SetNameCollisionPolicy(job.NameCollisionReplace)
+	case "suffix":
+		s.manager.SetNameCollisionPolicy(job.NameCollisionSuffix)
+	case "allow":
+		s.manager.SetNameCollisionPolicy(job.NameCollisionAllow)
+	default:
+		return nil, fmt.Errorf("unknown name collision policy %q", cfg.NameCollision)
+	}
+	webhooks := webhook.NewRegistry()
+	s.manager.AddListener(notifyWebhooks(webhooks))
+	s.manager.AddListener(runPostJobHooks(s.manager))
+	s.manager.AddListener(countOOMKills())
+	if cfg.ExposeIdentity {
+		s.manager.SetIdentityEnv(cfg.Labels)
+	}
+	var uploads *uploadRegistry
+	if cfg.WorkspaceRoot != "" {
+		s.manager.SetWorkspaceRoot(cfg.WorkspaceRoot)
+		uploads = newUploadRegistry(cfg.WorkspaceRoot, cfg.MaxUploadBytes)
+	}
+	var dataKey []byte
+	if cfg.DataKey != "" {
+		dataKey, err = loadDataKey(cfg.DataKey, cfg.SecretsDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var artifacts artifact.Store
+	if cfg.ArtifactStoreURL != "" {
+		artifacts, err = artifact.New(cfg.ArtifactStoreURL)
+		if err != nil {
+			return nil, err
+		}
+		if dataKey != nil {
+			artifacts = artifact.Encrypted(artifacts, dataKey)
+		}
+		s.manager.AddListener(collectArtifacts(artifacts))
+	}
+	if cfg.LogSinkURL != "" {
+		sink, err := logship.New(cfg.LogSinkURL)
+		if err != nil {
+			return nil, err
+		}
+		s.logSink = sink
+		s.manager.AddListener(logship.NewShipper(sink).Listener())
+	}
+	if cfg.JobStoreURL != "" {
+		store, err := jobstore.Open(cfg.JobStoreURL)
+		if err != nil {
+			return nil, err
+		}
+		s.jobStore = store
+		s.manager.AddListener(recordJobs(store))
+	}
+	if cfg.AuditFile != "" {
+		trail, err := audit.Open(cfg.AuditFile)
+		if err != nil {
+			return nil, err
+		}
+		s.auditLog = trail
+		s.manager.AddListener(recordAudit(trail))
+	}
+	if cfg.EventBusURL != "" {
+		pub, err := eventbus.New(cfg.EventBusURL)
+		if err != nil {
+			return nil, err
+		}
+		s.busPub = pub
+		bridge := eventbus.NewBridge(pub)
+		s.manager.AddListener(bridge.EventListener())
+		s.manager.AddListener(bridge.OutputListener())
+	}
+	name := cfg.WorkerName
+	if name == "" {
+		name, _ = os.Hostname()
+	}
+	outputPolicy, err := job.ParseSubscriberPolicy(cfg.OutputBackpressurePolicy)
+	if err != nil {
+		return nil, err
+	}
+	var templates *template.Registry
+	if cfg.TemplatesFile != "" {
+		templates, err = template.LoadFile(cfg.TemplatesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limits *LimitPolicy
+	if cfg.LimitsFile != "" {
+		limits, err = LoadLimitPolicy(cfg.LimitsFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var quotaPolicy *QuotaPolicy
+	if cfg.QuotasFile != "" {
+		quotaPolicy, err = LoadQuotaPolicy(cfg.QuotasFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sharePolicy *SharePolicy
+	if cfg.SharesFile != "" {
+		sharePolicy, err = LoadSharePolicy(cfg.SharesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var redact *job.Redactor
+	if cfg.RedactFile != "" {
+		redact, err = LoadRedactRules(cfg.RedactFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var maintenancePolicy *MaintenancePolicy
+	if cfg.MaintenanceFile != "" {
+		maintenancePolicy, err = LoadMaintenancePolicy(cfg.MaintenanceFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Accounting runs even without a policy so usage stays observable.
+	quota := newQuotaAccountant(quotaPolicy)
+	s.manager.AddListener(quota.listener())
+	shares := newFairShare(sharePolicy)
+	if shares != nil {
+		s.manager.AddListener(shares.listener())
+	}
+	locks := newLockTable()
+	s.manager.AddListener(locks.listener())
+	var groupPolicy *GroupPolicy
+	if cfg.GroupsFile != "" {
+		groupPolicy, err = LoadGroupPolicy(cfg.GroupsFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	groups := newConcurrency(groupPolicy)
+	s.manager.AddListener(groups.listener())
+	var notifyPolicy *NotifyPolicy
+	if cfg.NotifyFile != "" {
+		notifyPolicy, err = LoadNotifyPolicy(cfg.NotifyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.manager.AddListener(sendNotifications(notifyPolicy))
+	var gpus *gpuAllocator
+	if len(cfg.GPUs) > 0 {
+		gpus = newGPUAllocator(cfg.GPUs)
+		s.gpus = gpus
+		s.manager.AddListener(releaseGPUs(gpus))
+	}
+	// A file-backed sink doubles as the store disk-usage reporting reads
+	// from.
+	logStore, _ := s.logSink.(*joblog.Store)
+	if logStore != nil && dataKey != nil {
+		logStore.SetKey(dataKey)
+	}
+	svc := &jobServiceServer{
+		manager:      s.manager,
+		admission:    &admissionGate{},
+		maintenance:  &maintenanceState{policy: maintenancePolicy},
+		webhooks:     webhooks,
+		templates:    templates,
+		logStore:     logStore,
+		jobStore:     s.jobStore,
+		artifacts:    artifacts,
+		uploads:      uploads,
+		limits:       limits,
+		quota:        quota,
+		shares:       shares,
+		locks:        locks,
+		groups:       groups,
+		secrets:      secrets.NewResolver(cfg.SecretsDir),
+		starts:       newStartRecorder(),
+		sweeps:       newSweepSet(),
+		redact:       redact,
+		gpus:         gpus,
+		node:         nodeInfo{name: name, addr: cfg.AdvertiseAddr, labels: cfg.Labels},
+		outputQueue:  cfg.OutputSubscriberQueue,
+		outputPolicy: outputPolicy,
+	}
+	svc.pipelines = newPipelineSet(svc)
+	s.manager.AddListener(svc.pipelines.listener())
+	switch cfg.Preemption {
+	case "", "never":
+	case "lower-priority":
+		if gpus == nil {
+			return nil, fmt.Errorf("preemption requires GPUs; set -gpus")
+		}
+		svc.preempt = newPreemptor(svc)
+		s.manager.AddListener(svc.preempt.listener())
+	default:
+		return nil, fmt.Errorf("unknown preemption policy %q", cfg.Preemption)
+	}
+	jobworkerv1.RegisterJobServiceServer(s.grpc, svc)
+	jobworkerv1.RegisterAdminServiceServer(s.grpc, &adminServer{svc: svc, cfg: cfg, certs: certs, audit: s.auditLog})
+	if cfg.HTTPAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", &gateway{svc: svc})
+		// Process metrics, including the joblog_* disk-usage counters.
+		mux.Handle("/debug/vars", expvar.Handler())
+		mux.Handle("/", uiHandler())
+		s.http = &http.Server{
+			Addr:      cfg.HTTPAddr,
+			Handler:   mux,
+			TLSConfig: tlsConfig,
+		}
+	}
+	return s, nil
+}
+
+// ListenAndServe listens on the configured address and serves until Stop.
+func (s *Server) ListenAndServe() error {
+	lis, err := net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", s.cfg.Addr, err)
+	}
+	return s.Serve(lis)
+}
+
+// Serve accepts connections on lis until Stop is called. If the HTTP
+// gateway is configured it is started alongside on its own listener.
+func (s *Server) Serve(lis net.Listener) error {
+	if s.cfg.CoordinatorAddr != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		s.registerCancel = cancel
+		go s.registerWithCoordinator(ctx)
+		if s.cfg.TunnelAddr != "" {
+			name := s.cfg.WorkerName
+			if name == "" {
+				name, _ = os.Hostname()
+			}
+			go s.maintainTunnel(ctx, name)
+		}
+	}
+	if s.http != nil {
+		go func() {
+			// Certificates come from TLSConfig; the file arguments are unused.
+			if err := s.http.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("http gateway: %v", err)
+			}
+		}()
+	}
+	return s.grpc.Serve(lis)
+}
+
+// Stop gracefully stops the gRPC server and HTTP gateway, draining
+// in-flight RPCs.
+func (s *Server) Stop() {
+	if s.registerCancel != nil {
+		s.registerCancel()
+	}
+	if s.http != nil {
+		_ = s.http.Shutdown(context.Background())
+	}
+	s.grpc.GracefulStop()
+	if s.logSink != nil {
+		_ = s.logSink.Close()
+	}
+	if s.busPub != nil {
+		_ = s.busPub.Close()
+	}
+	if s.jobStore != nil {
+		_ = s.jobStore.Close()
+	}
+	if s.auditLog != nil {
+		_ = s.auditLog.Close()
+	}
+}
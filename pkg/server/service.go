@@ -0,0 +1,1113 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/artifact"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/joblog"
+	"github.com/pmantica4/teleport/pkg/jobstore"
+	"github.com/pmantica4/teleport/pkg/notify"
+	"github.com/pmantica4/teleport/pkg/secrets"
+	"github.com/pmantica4/teleport/pkg/template"
+	"github.com/pmantica4/teleport/pkg/version"
+	"github.com/pmantica4/teleport/pkg/webhook"
+)
+
+// jobServiceServer implements jobworkerv1.JobServiceServer on top of the job
+// manager. Authorization is per job: only the owner may act on a job.
+type jobServiceServer struct {
+	jobworkerv1.UnimplementedJobServiceServer
+
+	manager  *job.Manager
+	webhooks *webhook.Registry
+
+	// admission gates job starts; the admin API can pause or drain it.
+	admission *admissionGate
+
+	// maintenance holds non-exempt starts while a configured window is
+	// open.
+	maintenance *maintenanceState
+
+	// reloadMu guards the policy fields ReloadConfig swaps at runtime:
+	// templates and limits. Read them through templatesNow and limitsNow.
+	reloadMu sync.RWMutex
+
+	// templates are the admin-registered job templates; a nil registry
+	// simply has no templates.
+	templates *template.Registry
+
+	// logStore is set when the log sink persists to disk, for disk-usage
+	// reporting; nil otherwise.
+	logStore *joblog.Store
+
+	// jobStore is the SQL mirror of job metadata, serving ListJobHistory;
+	// nil when no store is configured.
+	jobStore *jobstore.Store
+
+	// artifacts is where collected job artifacts live; nil when artifact
+	// collection is not configured.
+	artifacts artifact.Store
+
+	// uploads stages input files awaiting a start; nil without a
+	// workspace root.
+	uploads *uploadRegistry
+
+	// limits is the admin-configured resource limit policy; nil allows
+	// everything.
+	limits *LimitPolicy
+
+	// quota tracks cumulative per-owner consumption and enforces the
+	// configured quota policy at start time.
+	quota *quotaAccountant
+
+	// shares gates starts by the fair-share policy; nil admits every
+	// start immediately.
+	shares *fairShare
+
+	// locks enforces per-owner lock keys: one running job per key.
+	locks *lockTable
+
+	// groups caps how many jobs of a concurrency group run at once.
+	groups *concurrency
+
+	// secrets resolves secret_env references at start time.
+	secrets *secrets.Resolver
+
+	// redact holds the configured output redaction rules; nil redacts
+	// nothing beyond per-job secret values.
+	redact *job.Redactor
+
+	// gpus allocates GPU devices to jobs; nil when the worker has none.
+	gpus *gpuAllocator
+
+	// preempt makes room for high-priority GPU starts by stopping and
+	// requeueing lower-priority jobs; nil when preemption is disabled.
+	preempt *preemptor
+
+	// starts remembers the original request of every job started through
+	// StartJob, for RerunJob.
+	starts *startRecorder
+
+	// pipelines holds the submitted stage DAGs; see pipeline.go.
+	pipelines *pipelineSet
+
+	// sweeps holds the submitted parameter fan-outs; see sweep.go.
+	sweeps *sweepSet
+
+	// node describes this worker for ListNodes and selector matching.
+	node nodeInfo
+
+	// outputQueue and outputPolicy govern per-subscriber backpressure on
+	// output streams; see pkg/job/fanout.go.
+	outputQueue  int
+	outputPolicy job.SubscriberPolicy
+}
+
+// templatesNow returns the current template registry under the reload
+// lock.
+func (s *jobServiceServer) templatesNow() *template.Registry {
+	s.reloadMu.RLock()
+	defer s.reloadMu.RUnlock()
+	return s.templates
+}
+
+// limitsNow returns the current limit policy under the reload lock.
+func (s *jobServiceServer) limitsNow() *LimitPolicy {
+	s.reloadMu.RLock()
+	defer s.reloadMu.RUnlock()
+	return s.limits
+}
+
+// redactNow returns the current redaction rules under the reload lock.
+func (s *jobServiceServer) redactNow() *job.Redactor {
+	s.reloadMu.RLock()
+	defer s.reloadMu.RUnlock()
+	return s.redact
+}
+
+// nodeInfo is the worker's own inventory entry.
+type nodeInfo struct {
+	name   string
+	addr   string
+	labels map[string]string
+}
+
+// matchesSelector reports whether every selector entry matches this
+// worker's labels.
+func (n nodeInfo) matchesSelector(selector map[string]string) bool {
+	for k, v := range selector {
+		if n.labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// authorizedJob resolves a job reference (ID, name, or unique ID prefix)
+// and verifies the caller owns it. A job owned by someone else is reported
+// as not found rather than forbidden, to avoid leaking job IDs across
+// owners.
+func (s *jobServiceServer) authorizedJob(ctx context.Context, ref string) (*job.Job, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	j, err := s.manager.ResolveJob(identity, ref)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if j.Owner != identity {
+		return nil, status.Errorf(codes.NotFound, "job not found: %s", ref)
+	}
+	return j, nil
+}
+
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, job.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, job.ErrAmbiguous):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, job.ErrNameTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, job.ErrNotRunning):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, job.ErrNotPaused):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (s *jobServiceServer) StartJob(ctx context.Context, req *jobworkerv1.StartJobRequest) (*jobworkerv1.StartJobResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Command == "" {
+		return nil, status.Error(codes.InvalidArgument, "command is required")
+	}
+	if !s.node.matchesSelector(req.NodeSelector) {
+		return nil, status.Error(codes.FailedPrecondition, "this worker does not match the node selector")
+	}
+	if err := s.admission.check(); err != nil {
+		return nil, err
+	}
+	if err := s.maintenance.wait(ctx, identity, auth.RolesFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := s.quota.checkStart(identity); err != nil {
+		return nil, err
+	}
+	// Fair-share admission: with a share policy configured, the start
+	// queues here until the owner is entitled to a running-job slot. The
+	// slot is handed back unless a job actually starts; the finish
+	// listener releases it otherwise.
+	if err := s.shares.wait(ctx, identity); err != nil {
+		return nil, err
+	}
+	slotUsed := false
+	defer func() {
+		if !slotUsed {
+			s.shares.release(identity)
+		}
+	}()
+	// A lock key makes the job a singleton: the key is claimed here and
+	// handed back unless a job actually starts; the finish listener
+	// releases it otherwise.
+	if err := s.locks.acquire(ctx, identity, req.LockKey, req.LockWait); err != nil {
+		return nil, err
+	}
+	lockHeld := req.LockKey != ""
+	defer func() {
+		if lockHeld && !slotUsed {
+			s.locks.release(identity, req.LockKey)
+		}
+	}()
+	// Concurrency groups queue the start while the group is at its max
+	// parallelism; the slot follows the same hand-back rules as above.
+	if err := s.groups.wait(ctx, req.ConcurrencyGroup); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !slotUsed {
+			s.groups.release(req.ConcurrencyGroup)
+		}
+	}()
+	spec, err := specFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyLimits(ctx, &spec); err != nil {
+		return nil, err
+	}
+	if err := validateCacheOptions(req); err != nil {
+		return nil, err
+	}
+	// Secrets join the env before the cache key is derived, so runs with
+	// different secret values never alias each other.
+	secretEnv, secretValues, err := s.resolveSecretEnv(req.SecretEnv)
+	if err != nil {
+		return nil, err
+	}
+	spec.Env = append(spec.Env, secretEnv...)
+	spec.Redact = s.redactNow().WithValues(secretValues...)
+	start := job.StartRequest{
+		Owner:          identity,
+		Name:           req.Name,
+		IdempotencyKey: req.IdempotencyKey,
+		Cacheable:      req.Cacheable,
+		CacheKey:       req.CacheKey,
+		CacheTTL:       time.Duration(req.CacheTtlSeconds) * time.Second,
+		NoCache:        req.NoCache,
+		Spec:           spec,
+	}
+	// A cache hit reuses a finished job wholesale, so check before
+	// consuming uploads or allocating ports and GPUs.
+	if cached := s.manager.CachedResult(start); cached != nil {
+		log.Printf("job %s reused for cacheable start by %s", cached.ID, identity)
+		return &jobworkerv1.StartJobResponse{
+			JobId:            cached.ID,
+			CpuBudgetSeconds: int64(cached.Spec.CPUBudget / time.Second),
+			MaxOutputBytes:   cached.Spec.MaxOutputBytes,
+		}, nil
+	}
+	var inputDir string
+	if req.UploadId != "" {
+		if s.uploads == nil {
+			return nil, status.Error(codes.FailedPrecondition, "no workspace root configured; set -workspace-root")
+		}
+		inputDir, err = s.uploads.take(identity, req.UploadId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := allocatePortsFor(req.Ports, &spec); err != nil {
+		return nil, err
+	}
+	s.preempt.makeRoom(int(req.Gpus), int(req.Priority))
+	if err := s.acquireGPUsFor(ctx, req.Gpus, &spec); err != nil {
+		return nil, err
+	}
+	start.InputDir = inputDir
+	start.Spec = spec
+	j, err := s.manager.Start(start)
+	if err != nil {
+		s.releaseGPUsFor(&spec)
+		if errors.Is(err, job.ErrNameTaken) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	slotUsed = true
+	s.locks.bind(identity, req.LockKey, j.ID)
+	s.quota.recordStart(identity)
+	s.preempt.track(j.ID, req, identity, auth.RolesFromContext(ctx))
+	s.starts.record(j.ID, req)
+	return &jobworkerv1.StartJobResponse{
+		JobId:            j.ID,
+		CpuBudgetSeconds: int64(spec.CPUBudget / time.Second),
+		MaxOutputBytes:   spec.MaxOutputBytes,
+	}, nil
+}
+
+// specFromRequest builds and validates the job spec shared by StartJob and
+// StartBatch.
+func specFromRequest(req *jobworkerv1.StartJobRequest) (job.Spec, error) {
+	capture, err := job.ParseCaptureMode(req.CaptureMode)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limitPolicy, err := job.ParseOutputLimitPolicy(req.OutputLimitPolicy)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ioClass, err := job.ParseIOClass(req.IoClass)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Nice < -20 || req.Nice > 19 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "nice must be between -20 and 19")
+	}
+	if req.IoPriority < 0 || req.IoPriority > 7 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "io priority must be between 0 and 7")
+	}
+	if req.IoPriority != 0 && ioClass == job.IOClassNone {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "io priority requires an io class")
+	}
+	var cpus []int
+	for _, c := range req.CpuAffinity {
+		if c < 0 {
+			return job.Spec{}, status.Errorf(codes.InvalidArgument, "cpu %d out of range", c)
+		}
+		cpus = append(cpus, int(c))
+	}
+	if req.Priority < 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "priority must not be negative")
+	}
+	if req.MaxCoreBytes < 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "max core bytes must not be negative")
+	}
+	artifacts := req.Artifacts
+	if req.MaxCoreBytes > 0 {
+		// Core dumps land in the workspace; collect them with the rest of
+		// the job's artifacts unless the caller already asked for them.
+		hasCore := false
+		for _, p := range artifacts {
+			if p == corePattern {
+				hasCore = true
+				break
+			}
+		}
+		if !hasCore {
+			artifacts = append(append([]string(nil), artifacts...), corePattern)
+		}
+	}
+	budgetPolicy, err := job.ParseCPUBudgetPolicy(req.CpuBudgetPolicy)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.CpuBudgetPolicy != "" && req.CpuBudgetSeconds == 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "cpu budget policy requires a cpu budget")
+	}
+	restart, err := job.ParseRestartPolicy(req.RestartPolicy)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.MaxRestarts < 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "max restarts must not be negative")
+	}
+	if req.MaxRestarts > 0 && restart == job.RestartNever {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "max restarts requires a restart policy")
+	}
+	var exitStatuses map[int]string
+	if len(req.ExitStatuses) > 0 {
+		exitStatuses = make(map[int]string, len(req.ExitStatuses))
+		for code, label := range req.ExitStatuses {
+			if code < 0 || code > 255 {
+				return job.Spec{}, status.Errorf(codes.InvalidArgument, "exit code %d out of range", code)
+			}
+			if label == "" {
+				return job.Spec{}, status.Errorf(codes.InvalidArgument, "exit code %d has an empty status label", code)
+			}
+			exitStatuses[int(code)] = label
+		}
+	}
+	for _, t := range req.Notify {
+		if _, err := notify.ParseTarget(t); err != nil {
+			return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	probe, err := job.ParseProbe(req.Probe)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.MaxSilenceSeconds < 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "max silence must not be negative")
+	}
+	stallPolicy, err := job.ParseStallPolicy(req.StallPolicy)
+	if err != nil {
+		return job.Spec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.StallPolicy != "" && req.MaxSilenceSeconds == 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "stall policy requires a max silence")
+	}
+	if req.ProbeIntervalSeconds < 0 {
+		return job.Spec{}, status.Error(codes.InvalidArgument, "probe interval must not be negative")
+	}
+	if req.ProbeIntervalSeconds > 0 {
+		if probe == nil {
+			return job.Spec{}, status.Error(codes.InvalidArgument, "probe interval requires a probe")
+		}
+		probe.Interval = time.Duration(req.ProbeIntervalSeconds) * time.Second
+	}
+	spec := job.Spec{
+		Command:          req.Command,
+		Args:             req.Args,
+		Artifacts:        artifacts,
+		CPUBudget:        time.Duration(req.CpuBudgetSeconds) * time.Second,
+		CPUBudgetPolicy:  budgetPolicy,
+		CoreLimit:        req.MaxCoreBytes,
+		Capture:          capture,
+		StripANSI:        req.StripAnsi,
+		MaxOutputBytes:   req.MaxOutputBytes,
+		OutputLimit:      limitPolicy,
+		Nice:             int(req.Nice),
+		Priority:         int(req.Priority),
+		IOClass:          ioClass,
+		IOPriority:       int(req.IoPriority),
+		CPUAffinity:      cpus,
+		Restart:          restart,
+		MaxRestarts:      int(req.MaxRestarts),
+		Probe:            probe,
+		MaxSilence:       time.Duration(req.MaxSilenceSeconds) * time.Second,
+		OnStall:          stallPolicy,
+		RescheduledFrom:  req.RescheduledFrom,
+		Labels:           req.Labels,
+		LockKey:          req.LockKey,
+		ConcurrencyGroup: req.ConcurrencyGroup,
+		ExitStatuses:     exitStatuses,
+		OnSuccess:        req.OnSuccess,
+		OnFailure:        req.OnFailure,
+		Notify:           req.Notify,
+		JSONLogs:         req.JsonLogs,
+	}
+	if err := validateSpec(spec); err != nil {
+		return job.Spec{}, err
+	}
+	return spec, nil
+}
+
+// validateCacheOptions rejects cache fields that cannot take effect.
+func validateCacheOptions(req *jobworkerv1.StartJobRequest) error {
+	if req.CacheTtlSeconds < 0 {
+		return status.Error(codes.InvalidArgument, "cache ttl must not be negative")
+	}
+	if !req.Cacheable && (req.CacheKey != "" || req.CacheTtlSeconds > 0 || req.NoCache) {
+		return status.Error(codes.InvalidArgument, "cache options require cacheable")
+	}
+	return nil
+}
+
+func (s *jobServiceServer) StopJob(ctx context.Context, req *jobworkerv1.StopJobRequest) (*jobworkerv1.StopJobResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if isProtected(j) && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition, "job %s is protected; pass force to stop it", j.ID)
+	}
+	if err := j.StopBy(identity, req.Reason); err != nil {
+		return nil, toStatusError(err)
+	}
+	if req.Reason != "" {
+		log.Printf("job %s stopped by %s: %s", j.ID, identity, req.Reason)
+	} else {
+		log.Printf("job %s stopped by %s", j.ID, identity)
+	}
+	return &jobworkerv1.StopJobResponse{}, nil
+}
+
+// BatchStop stops every job of the caller's matching the request's
+// selector. Jobs that cannot be stopped — typically because they finished
+// between selection and stop — are reported per job instead of failing
+// the call.
+func (s *jobServiceServer) BatchStop(ctx context.Context, req *jobworkerv1.BatchStopRequest) (*jobworkerv1.BatchStopResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Labels) == 0 && req.Status == "" && !req.All {
+		return nil, status.Error(codes.InvalidArgument, "a selector is required; pass all to stop every job")
+	}
+	var wantStatus job.Status
+	switch strings.ToLower(req.Status) {
+	case "":
+	case "running":
+		wantStatus = job.StatusRunning
+	case "paused":
+		wantStatus = job.StatusPaused
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q; want running or paused", req.Status)
+	}
+	resp := &jobworkerv1.BatchStopResponse{}
+	for _, j := range s.manager.ListJobs() {
+		if j.Owner != identity || !matchesLabels(j.Spec.Labels, req.Labels) {
+			continue
+		}
+		st, _ := j.Status()
+		if wantStatus != job.StatusUnknown && st != wantStatus {
+			continue
+		}
+		if st != job.StatusRunning && st != job.StatusPaused {
+			continue
+		}
+		result := &jobworkerv1.BatchStopResult{JobId: j.ID}
+		if isProtected(j) && !req.Force {
+			result.Error = "job is protected; pass force to override"
+		} else if err := j.StopBy(identity, req.Reason); err != nil {
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	log.Printf("batch stop by %s matched %d jobs", identity, len(resp.Results))
+	return resp, nil
+}
+
+// protectedLabel guards jobs against accidental stops: a job started
+// with this label set to "true" is only stopped when the request says
+// force.
+const protectedLabel = "protected"
+
+// isProtected reports whether the job carries the protection label.
+func isProtected(j *job.Job) bool {
+	return j.Spec.Labels[protectedLabel] == "true"
+}
+
+// matchesLabels reports whether the job's labels carry every selector
+// entry.
+func matchesLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *jobServiceServer) PauseJob(ctx context.Context, req *jobworkerv1.PauseJobRequest) (*jobworkerv1.PauseJobResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.Pause(); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &jobworkerv1.PauseJobResponse{}, nil
+}
+
+func (s *jobServiceServer) ResumeJob(ctx context.Context, req *jobworkerv1.ResumeJobRequest) (*jobworkerv1.ResumeJobResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.Resume(); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &jobworkerv1.ResumeJobResponse{}, nil
+}
+
+func (s *jobServiceServer) GetJobStatus(ctx context.Context, req *jobworkerv1.GetJobStatusRequest) (*jobworkerv1.GetJobStatusResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &jobworkerv1.GetJobStatusResponse{Job: jobToProto(j)}, nil
+}
+
+func (s *jobServiceServer) ImportJob(ctx context.Context, req *jobworkerv1.ImportJobRequest) (*jobworkerv1.ImportJobResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Job == nil || req.Job.Command == "" {
+		return nil, status.Error(codes.InvalidArgument, "an exported job with a command is required")
+	}
+	st, ok := statusFromProto(req.Job.Status)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot import a job in state %s", req.Job.Status)
+	}
+	lines := make([]job.Line, len(req.Output))
+	for i, e := range req.Output {
+		lines[i] = job.Line{Text: e.Line, Stream: job.Stream(e.Stream)}
+	}
+	spec := job.Spec{Command: req.Job.Command, Args: req.Job.Args}
+	j, err := s.manager.Import(identity, spec, st, int(req.Job.ExitCode),
+		time.Unix(req.Job.StartedAtUnix, 0), time.Unix(req.Job.EndedAtUnix, 0), lines)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log.Printf("job %s imported by %s (originally %s)", j.ID, identity, req.Job.Id)
+	return &jobworkerv1.ImportJobResponse{JobId: j.ID}, nil
+}
+
+// statusFromProto maps a terminal proto status back to the job package's;
+// ok is false for non-terminal or unknown states.
+func statusFromProto(s jobworkerv1.JobStatus) (job.Status, bool) {
+	switch s {
+	case jobworkerv1.JobStatus_JOB_STATUS_COMPLETED:
+		return job.StatusCompleted, true
+	case jobworkerv1.JobStatus_JOB_STATUS_FAILED:
+		return job.StatusFailed, true
+	case jobworkerv1.JobStatus_JOB_STATUS_STOPPED:
+		return job.StatusStopped, true
+	case jobworkerv1.JobStatus_JOB_STATUS_OUTPUT_LIMIT_EXCEEDED:
+		return job.StatusOutputLimit, true
+	case jobworkerv1.JobStatus_JOB_STATUS_CPU_BUDGET_EXCEEDED:
+		return job.StatusCPUBudget, true
+	case jobworkerv1.JobStatus_JOB_STATUS_OOM_KILLED:
+		return job.StatusOOM, true
+	default:
+		return job.StatusUnknown, false
+	}
+}
+
+func (s *jobServiceServer) GetHistory(ctx context.Context, req *jobworkerv1.GetHistoryRequest) (*jobworkerv1.GetHistoryResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &jobworkerv1.GetHistoryResponse{}
+	for _, tr := range j.History() {
+		resp.Transitions = append(resp.Transitions, &jobworkerv1.JobTransition{
+			State:       tr.Status.String(),
+			AtUnixNanos: tr.At.UnixNano(),
+			Note:        tr.Note,
+		})
+	}
+	return resp, nil
+}
+
+// WaitJob blocks until the job finishes, the caller gives up, or the
+// requested timeout elapses.
+func (s *jobServiceServer) WaitJob(ctx context.Context, req *jobworkerv1.WaitJobRequest) (*jobworkerv1.WaitJobResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if req.TimeoutSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout_seconds must not be negative")
+	}
+	var timeout <-chan time.Time
+	if req.TimeoutSeconds > 0 {
+		timer := time.NewTimer(time.Duration(req.TimeoutSeconds) * time.Second)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-j.Done():
+		return &jobworkerv1.WaitJobResponse{Job: jobToProto(j)}, nil
+	case <-timeout:
+		return nil, status.Errorf(codes.DeadlineExceeded, "job %s still running after %ds", req.JobId, req.TimeoutSeconds)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *jobServiceServer) ListJobs(ctx context.Context, _ *jobworkerv1.ListJobsRequest) (*jobworkerv1.ListJobsResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []*jobworkerv1.Job
+	for _, j := range s.manager.ListJobs() {
+		if j.Owner == identity {
+			jobs = append(jobs, jobToProto(j))
+		}
+	}
+	return &jobworkerv1.ListJobsResponse{Jobs: jobs}, nil
+}
+
+func (s *jobServiceServer) GetOutput(ctx context.Context, req *jobworkerv1.GetOutputRequest) (*jobworkerv1.GetOutputResponse, error) {
+	j, err := s.authorizedJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	entries, _ := j.Output().Entries(0)
+	entries = entriesSince(entries, req.SinceUnixNanos)
+	entries, err = selectOutput(entries, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &jobworkerv1.GetOutputResponse{
+		Lines:   make([]string, len(entries)),
+		Entries: make([]*jobworkerv1.OutputLine, len(entries)),
+	}
+	for i, e := range entries {
+		resp.Lines[i] = e.Text
+		resp.Entries[i] = lineToProto(e)
+	}
+	return resp, nil
+}
+
+func lineToProto(l job.Line) *jobworkerv1.OutputLine {
+	return &jobworkerv1.OutputLine{
+		Line:              l.Text,
+		WallTimeUnixNanos: l.Time.UnixNano(),
+		MonoOffsetNanos:   int64(l.Mono),
+		Stream:            string(l.Stream),
+		Level:             lineLevel(l),
+		Msg:               l.Msg,
+	}
+}
+
+// lineLevel is the line's log level: the parsed JSON level when the job
+// runs in JSON-lines mode, otherwise whatever detectLevel finds in the
+// text.
+func lineLevel(l job.Line) string {
+	if l.Level != "" {
+		return l.Level
+	}
+	return detectLevel(l.Text)
+}
+
+// Log-level detection for OutputLine.Level: conventional severity tokens,
+// matched as whole words, case-insensitively.
+var (
+	errorLevelRE = regexp.MustCompile(`(?i)\b(error|err|fatal|panic|fail(ed|ure)?)\b`)
+	warnLevelRE  = regexp.MustCompile(`(?i)\bwarn(ing)?\b`)
+)
+
+// detectLevel classifies a line's log level from its text, so clients can
+// surface errors in a followed log without parsing every format. Lines
+// with no recognizable severity token report no level.
+func detectLevel(text string) string {
+	switch {
+	case errorLevelRE.MatchString(text):
+		return "error"
+	case warnLevelRE.MatchString(text):
+		return "warn"
+	default:
+		return ""
+	}
+}
+
+// entriesSince drops lines captured before the given wall-clock time; zero
+// keeps everything. Lines are stamped in order, so a binary search suffices.
+func entriesSince(entries []job.Line, sinceUnixNanos int64) []job.Line {
+	if sinceUnixNanos <= 0 {
+		return entries
+	}
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].Time.UnixNano() >= sinceUnixNanos
+	})
+	return entries[i:]
+}
+
+// selectOutput applies the request's windowing options to the full output:
+// tail_lines or offset/limit pick the lines, then max_bytes trims the
+// selection from the end.
+func selectOutput(entries []job.Line, req *jobworkerv1.GetOutputRequest) ([]job.Line, error) {
+	if req.TailLines < 0 || req.Offset < 0 || req.Limit < 0 || req.MaxBytes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "output window options must not be negative")
+	}
+	if req.TailLines > 0 && (req.Offset > 0 || req.Limit > 0) {
+		return nil, status.Error(codes.InvalidArgument, "tail_lines cannot be combined with offset or limit")
+	}
+	switch {
+	case req.TailLines > 0:
+		if n := int64(len(entries)); req.TailLines < n {
+			entries = entries[n-req.TailLines:]
+		}
+	default:
+		if req.Offset >= int64(len(entries)) {
+			entries = nil
+		} else {
+			entries = entries[req.Offset:]
+		}
+		if req.Limit > 0 && req.Limit < int64(len(entries)) {
+			entries = entries[:req.Limit]
+		}
+	}
+	if req.MaxBytes > 0 {
+		var size int64
+		for i, e := range entries {
+			size += int64(len(e.Text))
+			if size > req.MaxBytes {
+				entries = entries[:i]
+				break
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (s *jobServiceServer) StreamOutput(req *jobworkerv1.StreamOutputRequest, stream jobworkerv1.JobService_StreamOutputServer) error {
+	j, err := s.authorizedJob(stream.Context(), req.JobId)
+	if err != nil {
+		return err
+	}
+
+	var grep *regexp.Regexp
+	if req.Grep != "" {
+		grep, err = regexp.Compile(req.Grep)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid grep pattern: %v", err)
+		}
+	}
+
+	sub := j.Output().Subscribe(s.outputQueue, s.outputPolicy)
+	defer sub.Close()
+	skip := req.Offset
+	for {
+		entries, err := nextBatch(stream.Context(), sub)
+		if err != nil {
+			return err
+		}
+		if entries == nil {
+			if errors.Is(sub.Err(), job.ErrSlowSubscriber) {
+				return status.Errorf(codes.ResourceExhausted,
+					"%v (dropped %d lines)", sub.Err(), sub.Lag())
+			}
+			return nil
+		}
+		entries = entriesSince(entries, req.SinceUnixNanos)
+		if grep != nil || req.Level != "" {
+			matched := entries[:0:0]
+			for _, e := range entries {
+				if grep != nil && !grep.MatchString(e.Text) {
+					continue
+				}
+				if req.Level != "" && lineLevel(e) != req.Level {
+					continue
+				}
+				matched = append(matched, e)
+			}
+			entries = matched
+		}
+		if skip > 0 {
+			if int64(len(entries)) <= skip {
+				skip -= int64(len(entries))
+				continue
+			}
+			entries = entries[skip:]
+			skip = 0
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		chunk := &jobworkerv1.OutputChunk{
+			Lines:   make([]string, len(entries)),
+			Entries: make([]*jobworkerv1.OutputLine, len(entries)),
+		}
+		for i, e := range entries {
+			chunk.Lines[i] = e.Text
+			chunk.Entries[i] = lineToProto(e)
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// nextBatch blocks for one line from the subscription, then drains whatever
+// else is already queued so follows send chunks rather than line-at-a-time.
+// A nil batch with nil error means the subscription ended.
+func nextBatch(ctx context.Context, sub *job.Subscription) ([]job.Line, error) {
+	var entries []job.Line
+	select {
+	case e, ok := <-sub.Lines():
+		if !ok {
+			return nil, nil
+		}
+		entries = append(entries, e)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	for {
+		select {
+		case e, ok := <-sub.Lines():
+			if !ok {
+				return entries, nil
+			}
+			entries = append(entries, e)
+		default:
+			return entries, nil
+		}
+	}
+}
+
+// GetVersion reports the build's identity. It requires an authenticated
+// caller like everything else, but no job-level authorization.
+func (s *jobServiceServer) GetVersion(ctx context.Context, _ *jobworkerv1.GetVersionRequest) (*jobworkerv1.GetVersionResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return &jobworkerv1.GetVersionResponse{
+		Version:      version.Version,
+		Commit:       version.Commit,
+		BuildDate:    version.BuildDate,
+		Capabilities: version.Capabilities,
+	}, nil
+}
+
+// GetDiskUsage reports the on-disk log store's usage against its budget.
+func (s *jobServiceServer) GetDiskUsage(ctx context.Context, _ *jobworkerv1.GetDiskUsageRequest) (*jobworkerv1.GetDiskUsageResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if s.logStore == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no on-disk log store configured; set -log-sink to a file:// URL")
+	}
+	return &jobworkerv1.GetDiskUsageResponse{
+		UsedBytes:   s.logStore.Usage(),
+		BudgetBytes: s.logStore.Budget(),
+		EvictedJobs: s.logStore.Evictions(),
+	}, nil
+}
+
+// ListNodes on a standalone worker reports the worker itself.
+func (s *jobServiceServer) ListNodes(ctx context.Context, _ *jobworkerv1.ListNodesRequest) (*jobworkerv1.ListNodesResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return &jobworkerv1.ListNodesResponse{Nodes: []*jobworkerv1.Node{s.selfNode()}}, nil
+}
+
+// selfNode describes this worker as a Node; a standalone worker is its
+// own inventory.
+func (s *jobServiceServer) selfNode() *jobworkerv1.Node {
+	memTotal, memFree := memInfo()
+	node := &jobworkerv1.Node{
+		Name:              s.node.name,
+		Addr:              s.node.addr,
+		Labels:            s.node.labels,
+		RunningJobs:       int64(s.manager.RunningCount()),
+		Alive:             true,
+		Version:           version.Version,
+		LastHeartbeatUnix: time.Now().Unix(),
+		Cpus:              int64(runtime.NumCPU()),
+		MemTotalBytes:     memTotal,
+		MemFreeBytes:      memFree,
+	}
+	if s.gpus != nil {
+		total, free := s.gpus.counts()
+		node.GpusTotal = int32(total)
+		node.GpusFree = int32(free)
+	}
+	return node
+}
+
+// GetNode on a standalone worker answers for itself only.
+func (s *jobServiceServer) GetNode(ctx context.Context, req *jobworkerv1.GetNodeRequest) (*jobworkerv1.GetNodeResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if req.Name != "" && req.Name != s.node.name {
+		return nil, status.Errorf(codes.NotFound, "node %q not found", req.Name)
+	}
+	return &jobworkerv1.GetNodeResponse{Node: s.selfNode()}, nil
+}
+
+func (s *jobServiceServer) WatchJobs(req *jobworkerv1.WatchJobsRequest, stream jobworkerv1.JobService_WatchJobsServer) error {
+	identity, err := auth.IdentityFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	if req.JobId != "" {
+		if _, err := s.authorizedJob(stream.Context(), req.JobId); err != nil {
+			return err
+		}
+	}
+
+	events, cancel := s.manager.Watch()
+	defer cancel()
+	for {
+		select {
+		case ev := <-events:
+			if ev.Job.Owner != identity {
+				continue
+			}
+			if req.JobId != "" && ev.Job.ID != req.JobId {
+				continue
+			}
+			if err := stream.Send(&jobworkerv1.JobEvent{
+				Type: eventTypeToProto(ev.Type),
+				Job:  jobToProto(ev.Job),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func eventTypeToProto(t job.EventType) jobworkerv1.JobEventType {
+	switch t {
+	case job.EventStarted:
+		return jobworkerv1.JobEventType_JOB_EVENT_TYPE_STARTED
+	case job.EventFinished:
+		return jobworkerv1.JobEventType_JOB_EVENT_TYPE_FINISHED
+	case job.EventProgress:
+		return jobworkerv1.JobEventType_JOB_EVENT_TYPE_PROGRESS
+	default:
+		return jobworkerv1.JobEventType_JOB_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func jobToProto(j *job.Job) *jobworkerv1.Job {
+	st, exitCode := j.Status()
+	pb := &jobworkerv1.Job{
+		Id:               j.ID,
+		Name:             j.Name,
+		Owner:            j.Owner,
+		Command:          j.Spec.Command,
+		Args:             j.Spec.Args,
+		Status:           statusToProto(st),
+		ExitCode:         int32(exitCode),
+		StartedAtUnix:    j.StartedAt().Unix(),
+		Nice:             int32(j.Spec.Nice),
+		IoClass:          j.Spec.IOClass.String(),
+		IoPriority:       int32(j.Spec.IOPriority),
+		RestartPolicy:    j.Spec.Restart.String(),
+		Restarts:         int32(j.Restarts()),
+		RescheduledFrom:  j.Spec.RescheduledFrom,
+		Priority:         int32(j.Spec.Priority),
+		Labels:           j.Spec.Labels,
+		LockKey:          j.Spec.LockKey,
+		ConcurrencyGroup: j.Spec.ConcurrencyGroup,
+		StatusLabel:      j.StatusLabel(),
+		ParentId:         j.Spec.ParentID,
+		Stalled:          j.Stalled(),
+	}
+	if done, total, msg := j.Progress(); total > 0 {
+		pb.Progress = &jobworkerv1.JobProgress{Done: done, Total: total, Message: msg}
+	}
+	pb.CpuBudgetSeconds = int64(j.Spec.CPUBudget / time.Second)
+	pb.MaxOutputBytes = j.Spec.MaxOutputBytes
+	pb.CpuSeconds = j.CPUTime().Seconds()
+	pb.RssBytes = j.RSSBytes()
+	pb.PeakRssBytes = j.PeakRSSBytes()
+	for _, kv := range j.Spec.Env {
+		key, _, _ := strings.Cut(kv, "=")
+		pb.EnvKeys = append(pb.EnvKeys, key)
+	}
+	for _, c := range j.Spec.CPUAffinity {
+		pb.CpuAffinity = append(pb.CpuAffinity, int32(c))
+	}
+	pb.Gpus = j.Spec.GPUs
+	for _, p := range j.Spec.Ports {
+		pb.Ports = append(pb.Ports, int32(p))
+	}
+	if by, reason, at := j.StopInfo(); !at.IsZero() {
+		pb.StoppedBy = by
+		pb.StopReason = reason
+		pb.StopRequestedAtUnix = at.Unix()
+	}
+	if !j.EndedAt().IsZero() {
+		pb.EndedAtUnix = j.EndedAt().Unix()
+	}
+	return pb
+}
+
+func statusToProto(s job.Status) jobworkerv1.JobStatus {
+	switch s {
+	case job.StatusRunning:
+		return jobworkerv1.JobStatus_JOB_STATUS_RUNNING
+	case job.StatusCompleted:
+		return jobworkerv1.JobStatus_JOB_STATUS_COMPLETED
+	case job.StatusFailed:
+		return jobworkerv1.JobStatus_JOB_STATUS_FAILED
+	case job.StatusStopped:
+		return jobworkerv1.JobStatus_JOB_STATUS_STOPPED
+	case job.StatusPaused:
+		return jobworkerv1.JobStatus_JOB_STATUS_PAUSED
+	case job.StatusOutputLimit:
+		return jobworkerv1.JobStatus_JOB_STATUS_OUTPUT_LIMIT_EXCEEDED
+	case job.StatusCPUBudget:
+		return jobworkerv1.JobStatus_JOB_STATUS_CPU_BUDGET_EXCEEDED
+	case job.StatusOOM:
+		return jobworkerv1.JobStatus_JOB_STATUS_OOM_KILLED
+	default:
+		return jobworkerv1.JobStatus_JOB_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// GetRunStats aggregates the caller's jobs into per-group run statistics.
+// Groups are keyed by job name by default — repeated runs of "nightly-backup"
+// land in one group — with unnamed jobs falling back to their command.
+func (s *jobServiceServer) GetRunStats(ctx context.Context, req *jobworkerv1.GetRunStatsRequest) (*jobworkerv1.GetRunStatsResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byCommand := false
+	switch req.GroupBy {
+	case "", "name":
+	case "command":
+		byCommand = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown group_by %q; want name or command", req.GroupBy)
+	}
+	if req.LastRuns < 0 {
+		return nil, status.Error(codes.InvalidArgument, "last runs must not be negative")
+	}
+
+	groups := make(map[string][]*job.Job)
+	for _, j := range s.manager.ListJobs() {
+		if j.Owner != identity {
+			continue
+		}
+		key := j.Spec.Command
+		if !byCommand && j.Name != "" {
+			key = j.Name
+		}
+		groups[key] = append(groups[key], j)
+	}
+
+	resp := &jobworkerv1.GetRunStatsResponse{}
+	for key, jobs := range groups {
+		resp.Groups = append(resp.Groups, runStats(key, jobs, int(req.LastRuns)))
+	}
+	sort.Slice(resp.Groups, func(i, k int) bool { return resp.Groups[i].Group < resp.Groups[k].Group })
+	return resp, nil
+}
+
+// runStats computes one group's statistics over its lastRuns most recent
+// finished runs (all of them when zero). Unfinished jobs are counted as
+// running and contribute no duration.
+func runStats(key string, jobs []*job.Job, lastRuns int) *jobworkerv1.RunStats {
+	stats := &jobworkerv1.RunStats{Group: key}
+	var finished []*job.Job
+	for _, j := range jobs {
+		if at := j.StartedAt().Unix(); at > stats.LastStartedAtUnix {
+			stats.LastStartedAtUnix = at
+		}
+		if j.EndedAt().IsZero() {
+			stats.Running++
+			continue
+		}
+		finished = append(finished, j)
+	}
+	sort.Slice(finished, func(i, k int) bool {
+		return finished[i].StartedAt().After(finished[k].StartedAt())
+	})
+	if lastRuns > 0 && len(finished) > lastRuns {
+		finished = finished[:lastRuns]
+	}
+
+	var durations []int64
+	for _, j := range finished {
+		stats.Runs++
+		if st, code := j.Status(); st != job.StatusCompleted || code != 0 {
+			stats.Failures++
+		}
+		durations = append(durations, j.EndedAt().Sub(j.StartedAt()).Milliseconds())
+	}
+	if len(durations) > 0 {
+		sort.Slice(durations, func(i, k int) bool { return durations[i] < durations[k] })
+		stats.P50Millis = percentile(durations, 50)
+		stats.P90Millis = percentile(durations, 90)
+		stats.MaxMillis = durations[len(durations)-1]
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []int64, p int) int64 {
+	rank := (len(sorted)*p + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// WriteStdin streams the caller's bytes into a running job's standard
+// input, closing it when the stream ends unless the first message set
+// keep_open.
+func (s *jobServiceServer) WriteStdin(stream jobworkerv1.JobService_WriteStdinServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.JobId == "" {
+		return status.Error(codes.InvalidArgument, "the first message must carry a job_id")
+	}
+	j, err := s.authorizedJob(stream.Context(), first.JobId)
+	if err != nil {
+		return err
+	}
+
+	var written int64
+	write := func(data []byte) error {
+		if len(data) == 0 {
+			return nil
+		}
+		n, err := j.WriteStdin(data)
+		written += int64(n)
+		if err != nil {
+			return toStatusError(err)
+		}
+		return nil
+	}
+	if err := write(first.Data); err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := write(req.Data); err != nil {
+			return err
+		}
+	}
+	// A job that finished mid-stream already had its stdin torn down.
+	if !first.KeepOpen {
+		if err := j.CloseStdin(); err != nil && !errors.Is(err, job.ErrNotRunning) {
+			return toStatusError(err)
+		}
+	}
+	return stream.SendAndClose(&jobworkerv1.WriteStdinResponse{BytesWritten: written})
+}
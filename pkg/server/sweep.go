@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// sweepMaxChildren caps the cross product, so a fat-fingered range cannot
+// fan out into thousands of processes.
+const sweepMaxChildren = 1000
+
+// sweepChild is one expanded combination and the job running it.
+type sweepChild struct {
+	params map[string]string
+	job    *job.Job
+}
+
+// sweep is one fan-out of a template over a parameter matrix. Child
+// states are read live from the jobs, so no listener is needed.
+type sweep struct {
+	id       string
+	template string
+	owner    string
+	children []*sweepChild
+}
+
+// sweepSet is the registry of submitted sweeps.
+type sweepSet struct {
+	mu   sync.Mutex
+	byID map[string]*sweep
+}
+
+func newSweepSet() *sweepSet {
+	return &sweepSet{byID: make(map[string]*sweep)}
+}
+
+// expandMatrixValues parses one matrix entry: an inclusive lo..hi integer
+// range, or a comma-separated list.
+func expandMatrixValues(v string) ([]string, error) {
+	if lo, hi, ok := strings.Cut(v, ".."); ok {
+		l, errLo := strconv.Atoi(lo)
+		h, errHi := strconv.Atoi(hi)
+		if errLo != nil || errHi != nil {
+			return nil, fmt.Errorf("invalid range %q, want lo..hi", v)
+		}
+		if h < l {
+			return nil, fmt.Errorf("invalid range %q: %d is below %d", v, h, l)
+		}
+		var out []string
+		for i := l; i <= h; i++ {
+			out = append(out, strconv.Itoa(i))
+		}
+		return out, nil
+	}
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("empty value in %q", v)
+		}
+		out = append(out, part)
+	}
+	return out, nil
+}
+
+// expandMatrix builds the cross product of every matrix entry, keys in
+// sorted order so the children come out deterministically.
+func expandMatrix(matrix map[string]string) ([]map[string]string, error) {
+	keys := make([]string, 0, len(matrix))
+	for k := range matrix {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	combos := []map[string]string{{}}
+	for _, k := range keys {
+		values, err := expandMatrixValues(matrix[k])
+		if err != nil {
+			return nil, fmt.Errorf("matrix %s: %w", k, err)
+		}
+		if len(combos)*len(values) > sweepMaxChildren {
+			return nil, fmt.Errorf("sweep expands to more than %d children", sweepMaxChildren)
+		}
+		next := make([]map[string]string, 0, len(combos)*len(values))
+		for _, combo := range combos {
+			for _, v := range values {
+				c := make(map[string]string, len(combo)+1)
+				for ck, cv := range combo {
+					c[ck] = cv
+				}
+				c[k] = v
+				next = append(next, c)
+			}
+		}
+		combos = next
+	}
+	return combos, nil
+}
+
+// StartSweep expands the matrix and starts one child per combination,
+// each through the template path a direct RunTemplate would take. The
+// fan-out is atomic: if any child cannot start, the ones already started
+// are stopped and the call fails.
+func (s *jobServiceServer) StartSweep(ctx context.Context, req *jobworkerv1.StartSweepRequest) (*jobworkerv1.StartSweepResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, ok := s.templatesNow().Get(req.Template)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "template not found: %s", req.Template)
+	}
+	if len(req.Matrix) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sweep has no matrix")
+	}
+	combos, err := expandMatrix(req.Matrix)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.admission.check(); err != nil {
+		return nil, err
+	}
+	if err := s.maintenance.wait(ctx, identity, auth.RolesFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	sw := &sweep{
+		id:       uuid.NewString(),
+		template: req.Template,
+		owner:    identity,
+	}
+	fail := func(err error) (*jobworkerv1.StartSweepResponse, error) {
+		for _, ch := range sw.children {
+			_ = ch.job.StopBy("server", fmt.Sprintf("sweep %s failed to start", sw.id))
+		}
+		return nil, err
+	}
+	for _, combo := range combos {
+		params := make(map[string]string, len(req.Params)+len(combo))
+		for k, v := range req.Params {
+			params[k] = v
+		}
+		for k, v := range combo {
+			params[k] = v
+		}
+		if err := s.quota.checkStart(identity); err != nil {
+			return fail(err)
+		}
+		expanded, err := tmpl.Expand(params)
+		if err != nil {
+			return fail(status.Error(codes.InvalidArgument, err.Error()))
+		}
+		spec := job.Spec{
+			Command: expanded.Command,
+			Args:    expanded.Args,
+			Env:     expanded.EnvList(),
+			Redact:  s.redactNow(),
+		}
+		if err := validateSpec(spec); err != nil {
+			return fail(err)
+		}
+		j, err := s.manager.StartJob(identity, spec)
+		if err != nil {
+			return fail(status.Error(codes.Internal, err.Error()))
+		}
+		s.quota.recordStart(identity)
+		sw.children = append(sw.children, &sweepChild{params: combo, job: j})
+	}
+
+	s.sweeps.mu.Lock()
+	s.sweeps.byID[sw.id] = sw
+	s.sweeps.mu.Unlock()
+	return &jobworkerv1.StartSweepResponse{
+		SweepId: sw.id,
+		Started: int32(len(sw.children)),
+	}, nil
+}
+
+// GetSweep reports aggregate progress, with child states read live from
+// the jobs.
+func (s *jobServiceServer) GetSweep(ctx context.Context, req *jobworkerv1.GetSweepRequest) (*jobworkerv1.GetSweepResponse, error) {
+	sw, err := s.authorizedSweep(ctx, req.SweepId)
+	if err != nil {
+		return nil, err
+	}
+	pb := &jobworkerv1.Sweep{
+		Id:       sw.id,
+		Template: sw.template,
+		Total:    int32(len(sw.children)),
+	}
+	settled := true
+	for _, ch := range sw.children {
+		st, _ := ch.job.Status()
+		pb.Children = append(pb.Children, &jobworkerv1.SweepChild{
+			JobId:  ch.job.ID,
+			Params: ch.params,
+			State:  st.String(),
+		})
+		switch st {
+		case job.StatusRunning, job.StatusPaused:
+			settled = false
+		case job.StatusCompleted:
+			pb.Completed++
+		default:
+			pb.Failed++
+		}
+	}
+	switch {
+	case !settled:
+		pb.Status = "RUNNING"
+	case pb.Failed > 0:
+		pb.Status = "FAILED"
+	default:
+		pb.Status = "COMPLETED"
+	}
+	return &jobworkerv1.GetSweepResponse{Sweep: pb}, nil
+}
+
+// StopSweep stops every child still running.
+func (s *jobServiceServer) StopSweep(ctx context.Context, req *jobworkerv1.StopSweepRequest) (*jobworkerv1.StopSweepResponse, error) {
+	sw, err := s.authorizedSweep(ctx, req.SweepId)
+	if err != nil {
+		return nil, err
+	}
+	var stopped int32
+	for _, ch := range sw.children {
+		if st, _ := ch.job.Status(); st != job.StatusRunning && st != job.StatusPaused {
+			continue
+		}
+		if err := ch.job.StopBy("server", fmt.Sprintf("sweep %s stopped", sw.id)); err == nil {
+			stopped++
+		}
+	}
+	return &jobworkerv1.StopSweepResponse{Stopped: stopped}, nil
+}
+
+func (s *jobServiceServer) authorizedSweep(ctx context.Context, id string) (*sweep, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.sweeps.mu.Lock()
+	defer s.sweeps.mu.Unlock()
+	sw, ok := s.sweeps.byID[id]
+	if !ok || sw.owner != identity {
+		return nil, status.Errorf(codes.NotFound, "sweep not found: %s", id)
+	}
+	return sw, nil
+}
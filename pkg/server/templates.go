@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/template"
+)
+
+// RunTemplate starts a job from a registered template. The template fixes
+// the command; the caller only supplies declared parameter values, which are
+// validated before substitution.
+func (s *jobServiceServer) RunTemplate(ctx context.Context, req *jobworkerv1.RunTemplateRequest) (*jobworkerv1.RunTemplateResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, ok := s.templatesNow().Get(req.Name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "template not found: %s", req.Name)
+	}
+	if !s.node.matchesSelector(req.NodeSelector) {
+		return nil, status.Error(codes.FailedPrecondition, "this worker does not match the node selector")
+	}
+	if err := s.admission.check(); err != nil {
+		return nil, err
+	}
+	if err := s.maintenance.wait(ctx, identity, auth.RolesFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	if err := s.quota.checkStart(identity); err != nil {
+		return nil, err
+	}
+	expanded, err := tmpl.Expand(req.Params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	spec := job.Spec{
+		Command: expanded.Command,
+		Args:    expanded.Args,
+		Env:     expanded.EnvList(),
+		Redact:  s.redactNow(),
+	}
+	if err := validateSpec(spec); err != nil {
+		return nil, err
+	}
+	j, err := s.manager.StartJob(identity, spec)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.quota.recordStart(identity)
+	return &jobworkerv1.RunTemplateResponse{JobId: j.ID}, nil
+}
+
+// ListTemplates reports every registered template.
+func (s *jobServiceServer) ListTemplates(ctx context.Context, _ *jobworkerv1.ListTemplatesRequest) (*jobworkerv1.ListTemplatesResponse, error) {
+	if _, err := auth.IdentityFromContext(ctx); err != nil {
+		return nil, err
+	}
+	var out jobworkerv1.ListTemplatesResponse
+	for _, t := range s.templatesNow().List() {
+		out.Templates = append(out.Templates, templateToProto(t))
+	}
+	return &out, nil
+}
+
+func templateToProto(t template.Template) *jobworkerv1.Template {
+	pb := &jobworkerv1.Template{
+		Name:    t.Name,
+		Command: t.Command,
+		Args:    t.Args,
+		Env:     t.Env,
+	}
+	for _, p := range t.Params {
+		pb.Params = append(pb.Params, &jobworkerv1.TemplateParam{
+			Name:     p.Name,
+			Required: p.Required,
+			Default:  p.Default,
+			Pattern:  p.Pattern,
+		})
+	}
+	return pb
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/yamux"
+)
+
+// tunnelRetryDelay is how long the worker waits before re-establishing a
+// dropped reverse tunnel.
+const tunnelRetryDelay = 5 * time.Second
+
+// maintainTunnel keeps a reverse tunnel to the coordinator open: the worker
+// dials out, identifies itself, and then serves its gRPC API on streams the
+// coordinator opens back over the same connection. This lets workers in
+// private networks join without any inbound ports.
+func (s *Server) maintainTunnel(ctx context.Context, name string) {
+	for {
+		if err := s.runTunnel(ctx, name); err != nil {
+			log.Printf("tunnel to coordinator: %v", err)
+		}
+		select {
+		case <-time.After(tunnelRetryDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Server) runTunnel(ctx context.Context, name string) error {
+	tlsConfig, err := s.clientTLSConfig()
+	if err != nil {
+		return err
+	}
+	dialer := &tls.Dialer{Config: tlsConfig}
+	conn, err := dialer.DialContext(ctx, "tcp", s.cfg.TunnelAddr)
+	if err != nil {
+		return fmt.Errorf("dialing tunnel %s: %w", s.cfg.TunnelAddr, err)
+	}
+	if _, err := fmt.Fprintf(conn, "%s\n", name); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("tunnel handshake: %w", err)
+	}
+
+	sess, err := yamux.Server(conn, nil)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("starting yamux session: %w", err)
+	}
+	defer sess.Close()
+
+	go func() {
+		<-ctx.Done()
+		_ = sess.Close()
+	}()
+
+	log.Printf("reverse tunnel to %s established", s.cfg.TunnelAddr)
+	// The session is a net.Listener; each coordinator-opened stream is an
+	// inner TLS connection served by the normal gRPC server.
+	return s.grpc.Serve(sess)
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// uiFiles is the embedded single-page UI served by the HTTP gateway. It sits
+// behind the same mutual-TLS requirement as the API and talks to the /v1
+// JSON endpoints from the browser.
+//
+//go:embed ui
+var uiFiles embed.FS
+
+// uiHandler serves the embedded UI at the root path.
+func uiHandler() http.Handler {
+	sub, err := fs.Sub(uiFiles, "ui")
+	if err != nil {
+		// The embedded tree is fixed at compile time; this cannot fail.
+		panic(err)
+	}
+	return http.FileServer(http.FS(sub))
+}
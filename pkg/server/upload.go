@@ -0,0 +1,209 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+)
+
+// stagingDirName is the directory under the workspace root holding uploads
+// that have not yet been claimed by a start.
+const stagingDirName = ".staging"
+
+// uploadTTL is how long an unclaimed upload is kept before being pruned.
+const uploadTTL = time.Hour
+
+// uploadRegistry tracks staged input uploads awaiting a StartJob.
+type uploadRegistry struct {
+	root     string // the workspace root
+	maxBytes int64
+
+	mu      sync.Mutex
+	uploads map[string]*stagedUpload
+}
+
+type stagedUpload struct {
+	owner   string
+	dir     string
+	bytes   int64
+	created time.Time
+}
+
+func newUploadRegistry(root string, maxBytes int64) *uploadRegistry {
+	return &uploadRegistry{
+		root:     root,
+		maxBytes: maxBytes,
+		uploads:  make(map[string]*stagedUpload),
+	}
+}
+
+// begin creates a fresh staging directory and registers it, pruning expired
+// uploads as it goes.
+func (r *uploadRegistry) begin(owner string) (string, *stagedUpload, error) {
+	id := uuid.NewString()
+	dir := filepath.Join(r.root, stagingDirName, id)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", nil, fmt.Errorf("creating staging directory: %w", err)
+	}
+	u := &stagedUpload{owner: owner, dir: dir, created: time.Now()}
+	r.mu.Lock()
+	now := time.Now()
+	for k, stale := range r.uploads {
+		if now.Sub(stale.created) > uploadTTL {
+			delete(r.uploads, k)
+			_ = os.RemoveAll(stale.dir)
+		}
+	}
+	r.uploads[id] = u
+	r.mu.Unlock()
+	return id, u, nil
+}
+
+// abort discards a registered upload and its staged files.
+func (r *uploadRegistry) abort(id string) {
+	r.mu.Lock()
+	u, ok := r.uploads[id]
+	delete(r.uploads, id)
+	r.mu.Unlock()
+	if ok {
+		_ = os.RemoveAll(u.dir)
+	}
+}
+
+// take claims an upload for a start, removing it from the registry. Uploads
+// belonging to someone else are reported as not found.
+func (r *uploadRegistry) take(owner, id string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.uploads[id]
+	if !ok || u.owner != owner {
+		return "", status.Errorf(codes.NotFound, "upload not found: %s", id)
+	}
+	delete(r.uploads, id)
+	return u.dir, nil
+}
+
+// checkUploadPath rejects destinations that could escape the workspace.
+func checkUploadPath(relPath string) error {
+	clean := path.Clean(relPath)
+	if relPath == "" || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") || path.IsAbs(clean) {
+		return fmt.Errorf("invalid upload path %q", relPath)
+	}
+	return nil
+}
+
+// UploadInput stages input files for a later StartJob. The whole upload is
+// discarded on any error: a job should never start with partial input.
+func (s *jobServiceServer) UploadInput(stream jobworkerv1.JobService_UploadInputServer) error {
+	identity, err := auth.IdentityFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	if s.uploads == nil {
+		return status.Error(codes.FailedPrecondition, "no workspace root configured; set -workspace-root")
+	}
+	id, up, err := s.uploads.begin(identity)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var (
+		cur      *os.File
+		curMeta  *jobworkerv1.UploadFile
+		curBytes int64
+		curHash  hash.Hash
+	)
+	finishFile := func() error {
+		if cur == nil {
+			return nil
+		}
+		if err := cur.Close(); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if curMeta.Sha256 != "" {
+			got := hex.EncodeToString(curHash.Sum(nil))
+			if !strings.EqualFold(got, curMeta.Sha256) {
+				return status.Errorf(codes.InvalidArgument,
+					"checksum mismatch for %s: got %s, want %s", curMeta.Path, got, curMeta.Sha256)
+			}
+		}
+		cur = nil
+		return nil
+	}
+	fail := func(err error) error {
+		if cur != nil {
+			_ = cur.Close()
+		}
+		s.uploads.abort(id)
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+		switch msg := req.Msg.(type) {
+		case *jobworkerv1.UploadInputRequest_File:
+			if err := finishFile(); err != nil {
+				return fail(err)
+			}
+			if err := checkUploadPath(msg.File.Path); err != nil {
+				return fail(status.Error(codes.InvalidArgument, err.Error()))
+			}
+			dst := filepath.Join(up.dir, filepath.FromSlash(msg.File.Path))
+			if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+				return fail(status.Error(codes.Internal, err.Error()))
+			}
+			f, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return fail(status.Error(codes.Internal, err.Error()))
+			}
+			cur, curMeta, curBytes, curHash = f, msg.File, 0, sha256.New()
+		case *jobworkerv1.UploadInputRequest_Data:
+			if cur == nil {
+				return fail(status.Error(codes.InvalidArgument, "data chunk before any file header"))
+			}
+			curBytes += int64(len(msg.Data))
+			if curMeta.SizeBytes > 0 && curBytes > curMeta.SizeBytes {
+				return fail(status.Errorf(codes.InvalidArgument,
+					"file %s exceeds its declared size of %d bytes", curMeta.Path, curMeta.SizeBytes))
+			}
+			up.bytes += int64(len(msg.Data))
+			if s.uploads.maxBytes > 0 && up.bytes > s.uploads.maxBytes {
+				return fail(status.Errorf(codes.ResourceExhausted,
+					"upload exceeds the %d byte limit", s.uploads.maxBytes))
+			}
+			if _, err := cur.Write(msg.Data); err != nil {
+				return fail(status.Error(codes.Internal, err.Error()))
+			}
+			curHash.Write(msg.Data)
+		}
+	}
+	if err := finishFile(); err != nil {
+		return fail(err)
+	}
+	return stream.SendAndClose(&jobworkerv1.UploadInputResponse{
+		UploadId:   id,
+		TotalBytes: up.bytes,
+	})
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pmantica4/teleport/pkg/job"
+)
+
+// Bounds on what a start request may hand to exec. They are generous —
+// well past what the kernel accepts on one command line — and exist so
+// malformed or hostile input fails with a clear error instead of
+// whatever exec makes of it.
+const (
+	maxArgs     = 1024
+	maxArgBytes = 128 << 10
+)
+
+// envNameRE is the POSIX portable environment variable name.
+var envNameRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateSpec checks the command, arguments, and environment of a spec
+// about to be launched: UTF-8 only, no NUL bytes, bounded argument
+// count and size, well-formed env entries. All violations are collected
+// into one InvalidArgument carrying a BadRequest detail with a field
+// violation per problem.
+func validateSpec(spec job.Spec) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	add := func(field, desc string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: desc})
+	}
+	checkText := func(field, s string) {
+		if !utf8.ValidString(s) {
+			add(field, "not valid UTF-8")
+		}
+		if strings.IndexByte(s, 0) >= 0 {
+			add(field, "contains a NUL byte")
+		}
+	}
+	if spec.Command == "" {
+		add("command", "is required")
+	} else {
+		checkText("command", spec.Command)
+	}
+	if len(spec.Args) > maxArgs {
+		add("args", fmt.Sprintf("%d arguments exceed the limit of %d", len(spec.Args), maxArgs))
+	}
+	for i, a := range spec.Args {
+		field := fmt.Sprintf("args[%d]", i)
+		if len(a) > maxArgBytes {
+			add(field, fmt.Sprintf("%d bytes exceed the limit of %d", len(a), maxArgBytes))
+			continue
+		}
+		checkText(field, a)
+	}
+	for i, e := range spec.Env {
+		field := fmt.Sprintf("env[%d]", i)
+		name, value, ok := strings.Cut(e, "=")
+		if !ok {
+			add(field, "missing '='; want NAME=value")
+			continue
+		}
+		if !envNameRE.MatchString(name) {
+			add(field, fmt.Sprintf("invalid variable name %q", name))
+		}
+		checkText(field, value)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Field + ": " + v.Description
+	}
+	st := status.New(codes.InvalidArgument, "invalid start request: "+strings.Join(msgs, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jobworkerv1 "github.com/pmantica4/teleport/pkg/api/jobworker/v1"
+	"github.com/pmantica4/teleport/pkg/auth"
+	"github.com/pmantica4/teleport/pkg/job"
+	"github.com/pmantica4/teleport/pkg/webhook"
+)
+
+// notifyWebhooks is the manager listener translating job state transitions
+// into webhook payloads.
+func notifyWebhooks(registry *webhook.Registry) job.Listener {
+	return func(ev job.Event) {
+		j := ev.Job
+		p := webhook.Payload{
+			JobID:    j.ID,
+			Command:  j.Spec.Command,
+			UnixTime: j.StartedAt().Unix(),
+		}
+		switch ev.Type {
+		case job.EventStarted:
+			p.Event = "started"
+		case job.EventFinished:
+			st, exitCode := j.Status()
+			p.Event = eventName(st)
+			p.ExitCode = &exitCode
+			p.UnixTime = j.EndedAt().Unix()
+		default:
+			return
+		}
+		registry.Notify(j.Owner, p)
+	}
+}
+
+// eventName maps a terminal status onto the webhook event name.
+func eventName(st job.Status) string {
+	switch st {
+	case job.StatusCompleted:
+		return "completed"
+	case job.StatusFailed:
+		return "failed"
+	case job.StatusStopped:
+		return "stopped"
+	default:
+		return "finished"
+	}
+}
+
+func (s *jobServiceServer) RegisterWebhook(ctx context.Context, req *jobworkerv1.RegisterWebhookRequest) (*jobworkerv1.RegisterWebhookResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, status.Error(codes.InvalidArgument, "url must be http or https")
+	}
+	if req.JobId != "" {
+		// Scoped hooks must reference a job the caller owns.
+		if _, err := s.authorizedJob(ctx, req.JobId); err != nil {
+			return nil, err
+		}
+	}
+	h := s.webhooks.Register(identity, req.Url, req.Secret, req.JobId)
+	return &jobworkerv1.RegisterWebhookResponse{WebhookId: h.ID}, nil
+}
+
+func (s *jobServiceServer) UnregisterWebhook(ctx context.Context, req *jobworkerv1.UnregisterWebhookRequest) (*jobworkerv1.UnregisterWebhookResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.webhooks.Unregister(identity, req.WebhookId) {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %s", req.WebhookId)
+	}
+	return &jobworkerv1.UnregisterWebhookResponse{}, nil
+}
+
+func (s *jobServiceServer) ListWebhookDeliveries(ctx context.Context, _ *jobworkerv1.ListWebhookDeliveriesRequest) (*jobworkerv1.ListWebhookDeliveriesResponse, error) {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []*jobworkerv1.WebhookDelivery
+	for _, d := range s.webhooks.Deliveries(identity) {
+		out = append(out, &jobworkerv1.WebhookDelivery{
+			WebhookId:     d.HookID,
+			JobId:         d.JobID,
+			Event:         d.Event,
+			Attempts:      int32(d.Attempts),
+			LastStatus:    d.LastStatus,
+			Delivered:     d.Delivered,
+			UpdatedAtUnix: d.UpdatedAt.Unix(),
+		})
+	}
+	return &jobworkerv1.ListWebhookDeliveriesResponse{Deliveries: out}, nil
+}
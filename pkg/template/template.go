@@ -0,0 +1,200 @@
+// Package template implements named, admin-registered job templates.
+// Operators declare the command, argument placeholders, and allowed
+// parameters up front; callers then supply only parameter values, which are
+// validated before substitution. This is a safer surface than free-form
+// command execution.
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Param declares one substitutable template parameter.
+type Param struct {
+	Name string `json:"name"`
+	// Required rejects runs that omit the parameter.
+	Required bool `json:"required"`
+	// Default fills the parameter when the caller omits it.
+	Default string `json:"default"`
+	// Pattern is an RE2 regular expression values must match; empty
+	// accepts anything.
+	Pattern string `json:"pattern"`
+}
+
+// Template is a named job specification with placeholders. Placeholders are
+// written {{name}} and may appear in args and env values; the command itself
+// is fixed so callers cannot redirect what binary runs.
+type Template struct {
+	Name    string            `json:"name"`
+	Command string            `json:"command"`
+	Args    []string          `json:"args"`
+	Env     map[string]string `json:"env"`
+	Params  []Param           `json:"params"`
+}
+
+// placeholderRE matches {{name}} placeholders.
+var placeholderRE = regexp.MustCompile(`\{\{(\w+)\}\}`)
+
+// validate checks the template definition itself: a command, well-formed
+// patterns, and no placeholder referencing an undeclared parameter.
+func (t Template) validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("template has no name")
+	}
+	if t.Command == "" {
+		return fmt.Errorf("template %s has no command", t.Name)
+	}
+	declared := map[string]bool{}
+	for _, p := range t.Params {
+		if p.Name == "" {
+			return fmt.Errorf("template %s declares a parameter with no name", t.Name)
+		}
+		if declared[p.Name] {
+			return fmt.Errorf("template %s declares parameter %s twice", t.Name, p.Name)
+		}
+		declared[p.Name] = true
+		if p.Pattern != "" {
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				return fmt.Errorf("template %s parameter %s: invalid pattern: %w", t.Name, p.Name, err)
+			}
+		}
+	}
+	check := func(s string) error {
+		for _, m := range placeholderRE.FindAllStringSubmatch(s, -1) {
+			if !declared[m[1]] {
+				return fmt.Errorf("template %s references undeclared parameter %s", t.Name, m[1])
+			}
+		}
+		return nil
+	}
+	for _, a := range t.Args {
+		if err := check(a); err != nil {
+			return err
+		}
+	}
+	for _, v := range t.Env {
+		if err := check(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expand validates the caller's parameters against the declaration and
+// returns a copy of the template with every placeholder substituted.
+func (t Template) Expand(params map[string]string) (Template, error) {
+	values := map[string]string{}
+	for _, p := range t.Params {
+		v, ok := params[p.Name]
+		if !ok {
+			if p.Required {
+				return Template{}, fmt.Errorf("parameter %s is required", p.Name)
+			}
+			v = p.Default
+		}
+		if p.Pattern != "" {
+			ok, err := regexp.MatchString(p.Pattern, v)
+			if err != nil {
+				return Template{}, fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			if !ok {
+				return Template{}, fmt.Errorf("parameter %s does not match %s", p.Name, p.Pattern)
+			}
+		}
+		values[p.Name] = v
+	}
+	for name := range params {
+		if _, ok := values[name]; !ok {
+			return Template{}, fmt.Errorf("parameter %s is not declared by template %s", name, t.Name)
+		}
+	}
+
+	substitute := func(s string) string {
+		return placeholderRE.ReplaceAllStringFunc(s, func(m string) string {
+			return values[strings.Trim(m, "{}")]
+		})
+	}
+	out := Template{Name: t.Name, Command: t.Command, Params: t.Params}
+	out.Args = make([]string, len(t.Args))
+	for i, a := range t.Args {
+		out.Args[i] = substitute(a)
+	}
+	if len(t.Env) > 0 {
+		out.Env = make(map[string]string, len(t.Env))
+		for k, v := range t.Env {
+			out.Env[k] = substitute(v)
+		}
+	}
+	return out, nil
+}
+
+// EnvList returns the template's env map as KEY=VALUE pairs in sorted order.
+func (t Template) EnvList() []string {
+	env := make([]string, 0, len(t.Env))
+	for k, v := range t.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	return env
+}
+
+// Registry holds the templates loaded at startup. It is immutable after
+// load and therefore safe for concurrent use.
+type Registry struct {
+	templates map[string]Template
+}
+
+// templatesFile is the on-disk layout of a templates config file.
+type templatesFile struct {
+	Templates []Template `json:"templates"`
+}
+
+// LoadFile reads a JSON templates file and validates every entry.
+func LoadFile(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading templates file: %w", err)
+	}
+	var file templatesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	r := &Registry{templates: make(map[string]Template, len(file.Templates))}
+	for _, t := range file.Templates {
+		if err := t.validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := r.templates[t.Name]; ok {
+			return nil, fmt.Errorf("duplicate template %s", t.Name)
+		}
+		r.templates[t.Name] = t
+	}
+	return r, nil
+}
+
+// Get returns the named template.
+func (r *Registry) Get(name string) (Template, bool) {
+	if r == nil {
+		return Template{}, false
+	}
+	t, ok := r.templates[name]
+	return t, ok
+}
+
+// List returns every template sorted by name.
+func (r *Registry) List() []Template {
+	if r == nil {
+		return nil
+	}
+	out := make([]Template, 0, len(r.templates))
+	for _, t := range r.templates {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
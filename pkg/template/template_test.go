@@ -0,0 +1,81 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTemplates(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "templates.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFileAndExpand(t *testing.T) {
+	path := writeTemplates(t, `{
+		"templates": [{
+			"name": "backup",
+			"command": "/usr/local/bin/backup.sh",
+			"args": ["--date", "{{date}}"],
+			"env": {"TARGET": "{{target}}"},
+			"params": [
+				{"name": "date", "required": true, "pattern": "^\\d{4}-\\d{2}-\\d{2}$"},
+				{"name": "target", "default": "s3"}
+			]
+		}]
+	}`)
+	r, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	tmpl, ok := r.Get("backup")
+	if !ok {
+		t.Fatal("template backup not found")
+	}
+	got, err := tmpl.Expand(map[string]string{"date": "2024-01-01"})
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if got.Args[1] != "2024-01-01" {
+		t.Errorf("args[1] = %q, want 2024-01-01", got.Args[1])
+	}
+	if env := got.EnvList(); len(env) != 1 || env[0] != "TARGET=s3" {
+		t.Errorf("env = %q, want [TARGET=s3]", env)
+	}
+}
+
+func TestExpandRejectsBadParams(t *testing.T) {
+	tmpl := Template{
+		Name:    "backup",
+		Command: "backup.sh",
+		Args:    []string{"{{date}}"},
+		Params:  []Param{{Name: "date", Required: true, Pattern: `^\d{4}-\d{2}-\d{2}$`}},
+	}
+	if _, err := tmpl.Expand(nil); err == nil {
+		t.Error("Expand without required param succeeded, want error")
+	}
+	if _, err := tmpl.Expand(map[string]string{"date": "nope"}); err == nil {
+		t.Error("Expand with non-matching value succeeded, want error")
+	}
+	if _, err := tmpl.Expand(map[string]string{"date": "2024-01-01", "extra": "x"}); err == nil {
+		t.Error("Expand with undeclared param succeeded, want error")
+	}
+}
+
+func TestLoadFileRejectsUndeclaredPlaceholder(t *testing.T) {
+	path := writeTemplates(t, `{
+		"templates": [{
+			"name": "bad",
+			"command": "run.sh",
+			"args": ["{{mystery}}"]
+		}]
+	}`)
+	if _, err := LoadFile(path); err == nil {
+		t.Error("LoadFile with undeclared placeholder succeeded, want error")
+	}
+}
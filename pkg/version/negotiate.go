@@ -0,0 +1,90 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIVersion is the proto package generation this build speaks. It only
+// changes on incompatible API revisions; additive changes are advertised
+// through Capabilities instead.
+const APIVersion = "v1"
+
+// Metadata keys for the version handshake. Clients announce their API
+// version on every RPC; servers answer with a deprecation warning in the
+// response headers when the caller should upgrade.
+const (
+	APIVersionHeader  = "x-api-version"
+	DeprecationHeader = "x-deprecation-warning"
+)
+
+// deprecatedMethods maps full gRPC method names to the warning returned to
+// callers. Add entries here when an RPC is superseded; the method keeps
+// working for at least one more release.
+var deprecatedMethods = map[string]string{}
+
+// warningFor says what, if anything, the caller should be told about the
+// call it just made.
+func warningFor(ctx context.Context, fullMethod string) string {
+	if w, ok := deprecatedMethods[fullMethod]; ok {
+		return w
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	announced := md.Get(APIVersionHeader)
+	if len(announced) == 0 {
+		// Pre-handshake clients predate this negotiation; let them know
+		// once per call rather than breaking them.
+		return fmt.Sprintf("client did not announce an API version; server speaks %s", APIVersion)
+	}
+	if announced[0] != APIVersion {
+		return fmt.Sprintf("client API version %s differs from server %s; upgrade before the next major release", announced[0], APIVersion)
+	}
+	return ""
+}
+
+// ServerInterceptors returns unary and stream interceptors implementing the
+// server half of the handshake: deprecation warnings are attached to the
+// response headers, never injected into payloads, so old clients that
+// ignore them keep working unchanged.
+func ServerInterceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if w := warningFor(ctx, info.FullMethod); w != "" {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(DeprecationHeader, w))
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if w := warningFor(ss.Context(), info.FullMethod); w != "" {
+			_ = ss.SetHeader(metadata.Pairs(DeprecationHeader, w))
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
+
+// ClientInterceptors returns unary and stream interceptors implementing the
+// client half: every call announces this build's API version, and any
+// deprecation warning in the response headers is handed to warn. A nil warn
+// discards warnings.
+func ClientInterceptors(warn func(string)) (grpc.UnaryClientInterceptor, grpc.StreamClientInterceptor) {
+	if warn == nil {
+		warn = func(string) {}
+	}
+	unary := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, APIVersionHeader, APIVersion)
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		for _, w := range header.Get(DeprecationHeader) {
+			warn(w)
+		}
+		return err
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, APIVersionHeader, APIVersion)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	return unary, stream
+}
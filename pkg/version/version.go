@@ -0,0 +1,73 @@
+// Package version carries the build's identity, stamped at link time:
+//
+//	go build -ldflags "-X github.com/pmantica4/teleport/pkg/version.Version=v1.2.3 ..."
+//
+// plus the list of API capabilities this build supports, so clients can
+// detect what a server offers without probing individual RPCs.
+package version
+
+var (
+	// Version is the semantic version of this build.
+	Version = "dev"
+	// Commit is the git commit the build was made from.
+	Commit = "unknown"
+	// BuildDate is when the build was made, RFC 3339.
+	BuildDate = "unknown"
+)
+
+// Capabilities names the optional API features compiled into this build.
+// Entries are only ever added; clients test for membership, not order.
+var Capabilities = []string{
+	"admin",
+	"annotations",
+	"ansi-strip",
+	"artifacts",
+	"attach",
+	"audit",
+	"batch",
+	"batch-stop",
+	"cache",
+	"capture-modes",
+	"concurrency-groups",
+	"core-dumps",
+	"disk-usage",
+	"encryption-at-rest",
+	"exit-statuses",
+	"fair-share",
+	"gpus",
+	"history",
+	"hooks",
+	"identity-env",
+	"import",
+	"json-logs",
+	"locks",
+	"maintenance",
+	"manifests",
+	"names",
+	"notify",
+	"oom-detection",
+	"output-filter",
+	"output-limits",
+	"output-search",
+	"output-timestamps",
+	"pause",
+	"pipelines",
+	"ports",
+	"preemption",
+	"priority",
+	"progress",
+	"quotas",
+	"redaction",
+	"replay",
+	"rerun",
+	"role-limits",
+	"run-stats",
+	"secrets",
+	"stall-detection",
+	"stdin",
+	"supervisor",
+	"sweeps",
+	"templates",
+	"uploads",
+	"wait",
+}
@@ -0,0 +1,179 @@
+// Package webhook delivers signed JSON notifications about job state
+// transitions to registered HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SignatureHeader carries the hex-encoded HMAC-SHA256 of the request body,
+// keyed with the webhook's secret.
+const SignatureHeader = "X-Webhook-Signature"
+
+// deliveryAttempts is how many times a payload is posted before giving up;
+// the backoff doubles from deliveryBaseDelay between attempts.
+const (
+	deliveryAttempts  = 4
+	deliveryBaseDelay = time.Second
+)
+
+// Hook is a registered webhook endpoint.
+type Hook struct {
+	ID     string
+	Owner  string
+	URL    string
+	Secret string
+	// JobID scopes the hook to one job; empty means all of the owner's jobs.
+	JobID string
+}
+
+// Delivery records one notification's delivery outcome.
+type Delivery struct {
+	HookID     string
+	JobID      string
+	Event      string
+	Attempts   int
+	LastStatus string
+	Delivered  bool
+	UpdatedAt  time.Time
+}
+
+// Payload is the JSON body posted to webhook endpoints.
+type Payload struct {
+	JobID    string `json:"job_id"`
+	Event    string `json:"event"`
+	Command  string `json:"command"`
+	ExitCode *int   `json:"exit_code,omitempty"`
+	UnixTime int64  `json:"unix_time"`
+}
+
+// Registry tracks hooks and their delivery history.
+type Registry struct {
+	client *http.Client
+
+	mu         sync.Mutex
+	hooks      map[string]*Hook
+	deliveries []Delivery
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		client: &http.Client{Timeout: 10 * time.Second},
+		hooks:  make(map[string]*Hook),
+	}
+}
+
+// Register adds a hook for owner and returns its ID. jobID may be empty to
+// receive events for all of the owner's jobs.
+func (r *Registry) Register(owner, url, secret, jobID string) *Hook {
+	h := &Hook{ID: uuid.NewString(), Owner: owner, URL: url, Secret: secret, JobID: jobID}
+	r.mu.Lock()
+	r.hooks[h.ID] = h
+	r.mu.Unlock()
+	return h
+}
+
+// Unregister removes a hook if it exists and belongs to owner.
+func (r *Registry) Unregister(owner, hookID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, ok := r.hooks[hookID]
+	if !ok || h.Owner != owner {
+		return false
+	}
+	delete(r.hooks, hookID)
+	return true
+}
+
+// Notify posts the payload to every hook matching the owner and job,
+// retrying with backoff in the background.
+func (r *Registry) Notify(owner string, p Payload) {
+	r.mu.Lock()
+	var matched []*Hook
+	for _, h := range r.hooks {
+		if h.Owner == owner && (h.JobID == "" || h.JobID == p.JobID) {
+			matched = append(matched, h)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, h := range matched {
+		go r.deliver(h, p)
+	}
+}
+
+// deliver posts the payload with retries and records the outcome.
+func (r *Registry) deliver(h *Hook, p Payload) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return
+	}
+	d := Delivery{HookID: h.ID, JobID: p.JobID, Event: p.Event}
+
+	delay := deliveryBaseDelay
+	for attempt := 0; attempt < deliveryAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+		d.Attempts++
+		d.LastStatus, d.Delivered = r.post(h, body)
+		if d.Delivered {
+			break
+		}
+	}
+	d.UpdatedAt = time.Now()
+
+	r.mu.Lock()
+	r.deliveries = append(r.deliveries, d)
+	r.mu.Unlock()
+}
+
+// post sends one request and reports the response status and success.
+func (r *Registry) post(h *Hook, body []byte) (string, bool) {
+	req, err := http.NewRequest(http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err.Error(), false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(h.Secret, body))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err.Error(), false
+	}
+	defer resp.Body.Close()
+	return resp.Status, resp.StatusCode >= 200 && resp.StatusCode < 300
+}
+
+// Deliveries returns the delivery history for the owner's hooks.
+func (r *Registry) Deliveries(owner string) []Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Delivery
+	for _, d := range r.deliveries {
+		if h, ok := r.hooks[d.HookID]; ok && h.Owner == owner {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// Sign returns the hex HMAC-SHA256 of body under secret, the value carried
+// in SignatureHeader. Receivers should recompute it to authenticate
+// payloads.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func waitDeliveries(t *testing.T, r *Registry, owner string, want int) []Delivery {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if ds := r.Deliveries(owner); len(ds) >= want {
+			return ds
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("never saw %d deliveries", want)
+	return nil
+}
+
+func TestNotifyDeliversSignedPayload(t *testing.T) {
+	var gotBody []byte
+	var gotSig string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get(SignatureHeader)
+	}))
+	defer srv.Close()
+
+	r := NewRegistry()
+	r.Register("alice", srv.URL, "topsecret", "")
+	r.Notify("alice", Payload{JobID: "j1", Event: "completed", Command: "true"})
+
+	ds := waitDeliveries(t, r, "alice", 1)
+	if !ds[0].Delivered || ds[0].Attempts != 1 {
+		t.Errorf("delivery = %+v, want delivered on first attempt", ds[0])
+	}
+	if gotSig != Sign("topsecret", gotBody) {
+		t.Error("signature does not verify against the body")
+	}
+	var p Payload
+	if err := json.Unmarshal(gotBody, &p); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if p.JobID != "j1" || p.Event != "completed" {
+		t.Errorf("payload = %+v", p)
+	}
+}
+
+func TestNotifyScopedToJobAndOwner(t *testing.T) {
+	hits := make(chan struct{}, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits <- struct{}{}
+	}))
+	defer srv.Close()
+
+	r := NewRegistry()
+	r.Register("alice", srv.URL, "s", "job-a")
+	r.Notify("alice", Payload{JobID: "job-b", Event: "started"})
+	r.Notify("bob", Payload{JobID: "job-a", Event: "started"})
+
+	select {
+	case <-hits:
+		t.Fatal("hook fired for a non-matching job or owner")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	r.Notify("alice", Payload{JobID: "job-a", Event: "started"})
+	select {
+	case <-hits:
+	case <-time.After(5 * time.Second):
+		t.Fatal("hook never fired for the matching job")
+	}
+}
+
+func TestUnregister(t *testing.T) {
+	r := NewRegistry()
+	h := r.Register("alice", "http://example.invalid", "s", "")
+	if r.Unregister("bob", h.ID) {
+		t.Error("Unregister succeeded for the wrong owner")
+	}
+	if !r.Unregister("alice", h.ID) {
+		t.Error("Unregister failed for the owner")
+	}
+}